@@ -17,6 +17,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -29,6 +30,7 @@ import (
 	"istio.io/operator/pkg/apis"
 	"istio.io/operator/pkg/controller"
 	"istio.io/operator/pkg/controller/istiocontrolplane"
+	"istio.io/operator/pkg/webhook"
 	"istio.io/pkg/ctrlz"
 	"istio.io/pkg/log"
 )
@@ -39,6 +41,9 @@ const (
 	metricsPort int32 = 8383
 )
 
+// webhookCertDir is the directory the webhook server reads the certificates generated by the operator from.
+var webhookCertDir = filepath.Join(os.TempDir(), "istio-operator", "webhook-certs")
+
 func serverCmd() *cobra.Command {
 	loggingOptions := log.DefaultOptions()
 	introspectionOptions := ctrlz.DefaultOptions()
@@ -79,6 +84,11 @@ func getWatchNamespace() (string, error) {
 	return ns, nil
 }
 
+// getOperatorNamespace returns the namespace the operator runs in, which is where the webhook certificates are stored.
+func getOperatorNamespace() (string, bool) {
+	return os.LookupEnv("POD_NAMESPACE")
+}
+
 // getLeaderElectionNamespace returns the namespace in which the leader election configmap will be created
 func getLeaderElectionNamespace() (string, bool) {
 	return os.LookupEnv("LEADER_ELECTION_NAMESPACE")
@@ -108,6 +118,8 @@ func run() {
 		LeaderElection:          leaderElectionEnabled,
 		LeaderElectionNamespace: leaderElectionNS,
 		LeaderElectionID:        "istio-operator-lock",
+		Port:                    webhook.Port,
+		CertDir:                 webhookCertDir,
 	})
 	if err != nil {
 		log.Fatalf("Could not create a controller manager: %v", err)
@@ -125,6 +137,15 @@ func run() {
 		log.Fatalf("Could not add all controllers to operator manager: %v", err)
 	}
 
+	// Setup the admission webhooks, which need the namespace of the operator for their certificates.
+	if operatorNS, ok := getOperatorNamespace(); ok {
+		if err := webhook.AddToManager(mgr, operatorNS); err != nil {
+			log.Fatalf("Could not add the admission webhooks to operator manager: %v", err)
+		}
+	} else {
+		log.Warn("Operator namespace not set. The IstioOperator admission webhooks are disabled.")
+	}
+
 	log.Info("Starting the Cmd.")
 
 	// Start the Cmd
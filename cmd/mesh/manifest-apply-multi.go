@@ -0,0 +1,363 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/operator/pkg/manifest"
+)
+
+const (
+	// clusterRolePrimary clusters run a control plane.
+	clusterRolePrimary = "primary"
+	// clusterRoleRemote clusters are managed by the control plane of a primary cluster.
+	clusterRoleRemote = "remote"
+
+	defaultIstioNamespace = "istio-system"
+	remoteProfile         = "remote"
+)
+
+// getRemoteAddresses returns the control plane addresses of a primary cluster. It is replaced in tests.
+var getRemoteAddresses = manifest.RemoteAddresses
+
+// meshTopology describes the clusters of a multi-cluster mesh.
+type meshTopology struct {
+	Clusters []*clusterTopology `json:"clusters"`
+}
+
+// clusterTopology describes a cluster of a multi-cluster mesh.
+type clusterTopology struct {
+	// Name is the name of the cluster in the mesh.
+	Name string `json:"name"`
+	// Kubeconfig and Context select the cluster.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	Context    string `json:"context,omitempty"`
+	// Role is primary or remote.
+	Role string `json:"role"`
+	// Filename is the path of the IstioOperator overlay of the cluster, relative to the topology file.
+	Filename string `json:"filename,omitempty"`
+	// Set are additional values in --set format.
+	Set []string `json:"set,omitempty"`
+	// Namespace is the namespace Istio is installed in, istio-system by default.
+	Namespace string `json:"namespace,omitempty"`
+	// Primary is the name of the primary cluster a remote cluster connects to. It may be omitted if there is only
+	// one primary.
+	Primary string `json:"primary,omitempty"`
+	// DiscoveryAddress overrides the pilot address of the primary injected into a remote cluster. It is required if
+	// neither pilot nor the ingress gateway of the primary has a load balancer address.
+	DiscoveryAddress string `json:"discoveryAddress,omitempty"`
+	// APIServer overrides the API server address in the remote secrets for the cluster, e.g. if the address used
+	// by this command is not reachable from the primary clusters.
+	APIServer string `json:"apiServer,omitempty"`
+}
+
+type manifestApplyMultiArgs struct {
+	// topologyFilename is the path to the topology file.
+	topologyFilename string
+	// readinessTimeout is maximum time to wait for all Istio resources to be ready.
+	readinessTimeout time.Duration
+	// wait is flag that indicates whether to wait resources ready before continuing with the next cluster.
+	wait bool
+	// skipConfirmation determines whether the user is prompted for confirmation.
+	skipConfirmation bool
+	// force proceeds even if there are validation errors
+	force bool
+	// useKubectl applies manifests with kubectl instead of the built-in server-side apply client.
+	useKubectl bool
+	// serverDryRun validates all objects with the API server without persisting them.
+	serverDryRun bool
+}
+
+func addManifestApplyMultiFlags(cmd *cobra.Command, args *manifestApplyMultiArgs) {
+	cmd.PersistentFlags().StringVarP(&args.topologyFilename, "topology", "t", "", "Path to the mesh topology file")
+	cmd.PersistentFlags().BoolVar(&args.skipConfirmation, "skip-confirmation", false, skipConfirmationFlagHelpStr)
+	cmd.PersistentFlags().BoolVar(&args.force, "force", false, "Proceed even with validation errors")
+	cmd.PersistentFlags().DurationVar(&args.readinessTimeout, "readiness-timeout", 300*time.Second,
+		"Maximum seconds to wait for the Istio resources of each cluster to be ready.")
+	cmd.PersistentFlags().BoolVarP(&args.wait, "wait", "w", true, "Wait until the Istio resources of each cluster "+
+		"are ready before continuing with the next one. Remote clusters can only be pointed at a primary with a ready "+
+		"control plane")
+	cmd.PersistentFlags().BoolVar(&args.useKubectl, "use-kubectl", false, useKubectlFlagHelpStr)
+	cmd.PersistentFlags().BoolVar(&args.serverDryRun, "server-dry-run", false, serverDryRunFlagHelpStr)
+}
+
+func manifestApplyMultiCmd(rootArgs *rootArgs, mamArgs *manifestApplyMultiArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "apply-multi",
+		Short: "Generates and applies Istio install manifests to the clusters of a multi-cluster mesh.",
+		Long: "The apply-multi subcommand installs Istio into all clusters listed in a topology file. Primary " +
+			"clusters are installed first, then remote clusters are installed with the control plane addresses of " +
+			"their primary. Finally, the remote secrets which allow primaries to discover the services of the other " +
+			"clusters are created.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			if !rootArgs.dryRun && !mamArgs.serverDryRun && !mamArgs.skipConfirmation {
+				if !confirm("This will install Istio into all clusters of the topology. Proceed? (y/N)", cmd.OutOrStdout()) {
+					cmd.Print("Cancelled.\n")
+					os.Exit(1)
+				}
+			}
+			return manifestApplyMulti(rootArgs, mamArgs, l)
+		}}
+}
+
+func manifestApplyMulti(args *rootArgs, mamArgs *manifestApplyMultiArgs, l *Logger) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
+	if mamArgs.topologyFilename == "" {
+		return fmt.Errorf("a topology file must be set with --topology")
+	}
+	topo, err := readTopology(mamArgs.topologyFilename)
+	if err != nil {
+		return err
+	}
+	// Nothing is persisted in either dry run mode, so primaries cannot be queried for their addresses.
+	dryRun := args.dryRun || mamArgs.serverDryRun
+
+	for _, c := range topo.installOrder() {
+		l.logAndPrintf("\nInstalling Istio into %s cluster %s...", c.Role, c.Name)
+		set, err := topo.clusterSet(c, dryRun, l)
+		if err != nil {
+			return err
+		}
+		_, _, err = genApplyManifests(&applyManifestsArgs{
+			set:            set,
+			inFilename:     c.Filename,
+			force:          mamArgs.force,
+			dryRun:         args.dryRun,
+			serverDryRun:   mamArgs.serverDryRun,
+			verbose:        args.verbose,
+			kubeConfigPath: c.Kubeconfig,
+			context:        c.Context,
+			wait:           mamArgs.wait,
+			waitTimeout:    mamArgs.readinessTimeout,
+			useKubectl:     mamArgs.useKubectl,
+		}, l)
+		if err != nil {
+			return fmt.Errorf("failed to install cluster %s: %v", c.Name, err)
+		}
+	}
+
+	for _, p := range topo.primaries() {
+		for _, c := range topo.discoveredBy(p) {
+			if dryRun {
+				l.logAndPrintf("Dry run: would create the remote secret of cluster %s in cluster %s.", c.Name, p.Name)
+				continue
+			}
+			secret, err := manifest.RemoteSecret(c.Kubeconfig, c.Context, c.Name, c.Namespace, c.APIServer)
+			if err != nil {
+				return err
+			}
+			secret.Namespace = p.Namespace
+			if err := manifest.ApplyRemoteSecret(p.Kubeconfig, p.Context, secret, false); err != nil {
+				return err
+			}
+			l.logAndPrintf("Created the remote secret of cluster %s in cluster %s.", c.Name, p.Name)
+		}
+	}
+	l.logAndPrint("\n✔ Multi-cluster installation complete\n")
+	return nil
+}
+
+// readTopology reads and validates the topology file at path. Relative overlay filenames are resolved against the
+// directory of the topology file.
+func readTopology(path string) (*meshTopology, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read topology file %s: %s", path, err)
+	}
+	topo := &meshTopology{}
+	if err := yaml.UnmarshalStrict(b, topo); err != nil {
+		return nil, fmt.Errorf("could not parse topology file %s: %s", path, err)
+	}
+	for _, c := range topo.Clusters {
+		if c.Namespace == "" {
+			c.Namespace = defaultIstioNamespace
+		}
+		if c.Filename != "" && !filepath.IsAbs(c.Filename) {
+			c.Filename = filepath.Join(filepath.Dir(path), c.Filename)
+		}
+	}
+	if err := topo.validate(); err != nil {
+		return nil, fmt.Errorf("invalid topology file %s: %s", path, err)
+	}
+	return topo, nil
+}
+
+func (t *meshTopology) validate() error {
+	names := make(map[string]bool)
+	for _, c := range t.Clusters {
+		if c.Name == "" {
+			return fmt.Errorf("cluster name must be set")
+		}
+		if names[c.Name] {
+			return fmt.Errorf("duplicate cluster %s", c.Name)
+		}
+		names[c.Name] = true
+		if c.Role != clusterRolePrimary && c.Role != clusterRoleRemote {
+			return fmt.Errorf("cluster %s has role %q, must be %s or %s", c.Name, c.Role, clusterRolePrimary,
+				clusterRoleRemote)
+		}
+		if c.Role == clusterRolePrimary && (c.Primary != "" || c.DiscoveryAddress != "") {
+			return fmt.Errorf("primary cluster %s cannot set primary or discoveryAddress", c.Name)
+		}
+	}
+	primaries := t.primaries()
+	if len(primaries) == 0 {
+		return fmt.Errorf("at least one primary cluster is required")
+	}
+	for _, c := range t.Clusters {
+		if c.Role != clusterRoleRemote {
+			continue
+		}
+		switch {
+		case c.Primary == "" && len(primaries) > 1:
+			return fmt.Errorf("remote cluster %s must select one of several primaries", c.Name)
+		case c.Primary == "":
+			c.Primary = primaries[0].Name
+		case t.cluster(c.Primary) == nil || t.cluster(c.Primary).Role != clusterRolePrimary:
+			return fmt.Errorf("primary %s of remote cluster %s is not a primary cluster", c.Primary, c.Name)
+		}
+	}
+	return nil
+}
+
+// installOrder returns the clusters in the order they must be installed in: primaries first, followed by remotes.
+func (t *meshTopology) installOrder() []*clusterTopology {
+	out := append([]*clusterTopology{}, t.Clusters...)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Role == clusterRolePrimary && out[j].Role != clusterRolePrimary
+	})
+	return out
+}
+
+func (t *meshTopology) primaries() []*clusterTopology {
+	var out []*clusterTopology
+	for _, c := range t.Clusters {
+		if c.Role == clusterRolePrimary {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (t *meshTopology) cluster(name string) *clusterTopology {
+	for _, c := range t.Clusters {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// discoveredBy returns the clusters whose services primary p discovers: its remotes and all other primaries.
+func (t *meshTopology) discoveredBy(p *clusterTopology) []*clusterTopology {
+	var out []*clusterTopology
+	for _, c := range t.Clusters {
+		if c != p && (c.Role == clusterRolePrimary || c.Primary == p.Name) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// clusterSet returns the set overlay of cluster c. Remote clusters use the remote profile, unless their overlay
+// selects another one, and are pointed at the control plane of their primary, which must already be installed.
+func (t *meshTopology) clusterSet(c *clusterTopology, dryRun bool, l *Logger) ([]string, error) {
+	set := []string{
+		"values.global.multiCluster.enabled=true",
+		"values.global.multiCluster.clusterName=" + c.Name,
+	}
+	if c.Role == clusterRoleRemote {
+		hasProfile, err := overlayHasProfile(c)
+		if err != nil {
+			return nil, err
+		}
+		if !hasProfile {
+			set = append(set, "profile="+remoteProfile)
+		}
+		addrs, err := t.remoteAddresses(c, dryRun, l)
+		if err != nil {
+			return nil, err
+		}
+		var values []string
+		for v := range addrs {
+			values = append(values, v)
+		}
+		sort.Strings(values)
+		for _, v := range values {
+			set = append(set, fmt.Sprintf("values.global.%s=%s", v, addrs[v]))
+		}
+	}
+	return append(set, c.Set...), nil
+}
+
+// remoteAddresses returns the control plane addresses of the primary of remote cluster c.
+func (t *meshTopology) remoteAddresses(c *clusterTopology, dryRun bool, l *Logger) (map[string]string, error) {
+	p := t.cluster(c.Primary)
+	addrs, err := getRemoteAddresses(p.Kubeconfig, p.Context, p.Namespace)
+	if err != nil {
+		if !dryRun {
+			return nil, fmt.Errorf("could not get the control plane addresses of primary %s: %s", p.Name, err)
+		}
+		l.logAndPrintf("Dry run: could not get the control plane addresses of primary %s: %s", p.Name, err)
+		addrs = make(map[string]string)
+	}
+	if c.DiscoveryAddress != "" {
+		addrs["remotePilotAddress"] = c.DiscoveryAddress
+	}
+	if addrs["remotePilotAddress"] == "" && !dryRun {
+		return nil, fmt.Errorf("pilot of primary %s is not exposed with a load balancer for remote cluster %s, set "+
+			"discoveryAddress to an address of pilot the remote cluster can reach", p.Name, c.Name)
+	}
+	return addrs, nil
+}
+
+// overlayHasProfile reports whether the overlay of c selects a profile.
+func overlayHasProfile(c *clusterTopology) (bool, error) {
+	for _, s := range c.Set {
+		if strings.HasPrefix(s, "profile=") {
+			return true, nil
+		}
+	}
+	if c.Filename == "" {
+		return false, nil
+	}
+	b, err := ioutil.ReadFile(c.Filename)
+	if err != nil {
+		return false, fmt.Errorf("could not read values from file %s: %s", c.Filename, err)
+	}
+	iop := struct {
+		Spec struct {
+			Profile string `json:"profile"`
+		} `json:"spec"`
+	}{}
+	if err := yaml.Unmarshal(b, &iop); err != nil {
+		return false, fmt.Errorf("could not parse %s: %s", c.Filename, err)
+	}
+	return iop.Spec.Profile != "", nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadTopology(t *testing.T) {
+	tests := []struct {
+		desc           string
+		topology       string
+		wantErr        bool
+		wantOrder      []string
+		wantDiscovered map[string][]string
+	}{
+		{
+			desc: "shared control plane",
+			topology: `
+clusters:
+- name: remote1
+  role: remote
+- name: primary
+  role: primary
+- name: remote2
+  role: remote
+`,
+			wantOrder:      []string{"primary", "remote1", "remote2"},
+			wantDiscovered: map[string][]string{"primary": {"remote1", "remote2"}},
+		},
+		{
+			desc: "multiple primaries",
+			topology: `
+clusters:
+- name: primary1
+  role: primary
+- name: primary2
+  role: primary
+- name: remote
+  role: remote
+  primary: primary2
+`,
+			wantOrder: []string{"primary1", "primary2", "remote"},
+			wantDiscovered: map[string][]string{
+				"primary1": {"primary2"},
+				"primary2": {"primary1", "remote"},
+			},
+		},
+		{
+			desc: "ambiguous primary",
+			topology: `
+clusters:
+- {name: primary1, role: primary}
+- {name: primary2, role: primary}
+- {name: remote, role: remote}
+`,
+			wantErr: true,
+		},
+		{
+			desc: "remote primary",
+			topology: `
+clusters:
+- {name: primary, role: primary}
+- {name: remote1, role: remote}
+- {name: remote2, role: remote, primary: remote1}
+`,
+			wantErr: true,
+		},
+		{
+			desc:     "no primary",
+			topology: `clusters: [{name: remote, role: remote}]`,
+			wantErr:  true,
+		},
+		{
+			desc:     "duplicate name",
+			topology: `clusters: [{name: c, role: primary}, {name: c, role: remote}]`,
+			wantErr:  true,
+		},
+		{
+			desc:     "unknown role",
+			topology: `clusters: [{name: c, role: secondary}]`,
+			wantErr:  true,
+		},
+		{
+			desc:     "unknown field",
+			topology: `clusters: [{name: c, role: primary, contxt: c}]`,
+			wantErr:  true,
+		},
+	}
+	dir, err := ioutil.TempDir("", "topology")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			path := filepath.Join(dir, "topology.yaml")
+			if err := ioutil.WriteFile(path, []byte(tt.topology), 0644); err != nil {
+				t.Fatal(err)
+			}
+			topo, err := readTopology(path)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := clusterNames(topo.installOrder()); !reflect.DeepEqual(got, tt.wantOrder) {
+				t.Errorf("got install order %v, want %v", got, tt.wantOrder)
+			}
+			for _, p := range topo.primaries() {
+				if got := clusterNames(topo.discoveredBy(p)); !reflect.DeepEqual(got, tt.wantDiscovered[p.Name]) {
+					t.Errorf("got clusters discovered by %s %v, want %v", p.Name, got, tt.wantDiscovered[p.Name])
+				}
+			}
+		})
+	}
+}
+
+func TestClusterSet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "topology")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	overlay := filepath.Join(dir, "remote.yaml")
+	if err := ioutil.WriteFile(overlay, []byte("spec:\n  profile: minimal\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(f func(string, string, string) (map[string]string, error)) { getRemoteAddresses = f }(getRemoteAddresses)
+	getRemoteAddresses = func(_, context, _ string) (map[string]string, error) {
+		if context != "primary" {
+			return nil, fmt.Errorf("cluster %s is unreachable", context)
+		}
+		return map[string]string{"remotePilotAddress": "10.0.0.1", "remotePolicyAddress": "10.0.0.2"}, nil
+	}
+	topo := &meshTopology{Clusters: []*clusterTopology{
+		{Name: "primary", Context: "primary", Role: clusterRolePrimary, Set: []string{"hub=foo"}},
+		{Name: "unreachable", Context: "unreachable", Role: clusterRolePrimary},
+		{Name: "remote1", Role: clusterRoleRemote, Primary: "primary", Filename: overlay},
+		{Name: "remote2", Role: clusterRoleRemote, Primary: "primary", DiscoveryAddress: "10.0.1.1",
+			Set: []string{"profile=empty"}},
+		{Name: "remote3", Role: clusterRoleRemote, Primary: "unreachable", DiscoveryAddress: "10.0.1.1"},
+		{Name: "remote4", Role: clusterRoleRemote, Primary: "unreachable"},
+	}}
+	tests := []struct {
+		cluster string
+		dryRun  bool
+		want    []string
+		wantErr bool
+	}{
+		{
+			cluster: "primary",
+			want: []string{
+				"values.global.multiCluster.enabled=true",
+				"values.global.multiCluster.clusterName=primary",
+				"hub=foo",
+			},
+		},
+		{
+			cluster: "remote1",
+			want: []string{
+				"values.global.multiCluster.enabled=true",
+				"values.global.multiCluster.clusterName=remote1",
+				"values.global.remotePilotAddress=10.0.0.1",
+				"values.global.remotePolicyAddress=10.0.0.2",
+			},
+		},
+		{
+			cluster: "remote2",
+			want: []string{
+				"values.global.multiCluster.enabled=true",
+				"values.global.multiCluster.clusterName=remote2",
+				"values.global.remotePilotAddress=10.0.1.1",
+				"values.global.remotePolicyAddress=10.0.0.2",
+				"profile=empty",
+			},
+		},
+		{
+			cluster: "remote3",
+			wantErr: true,
+		},
+		{
+			cluster: "remote4",
+			dryRun:  true,
+			want: []string{
+				"values.global.multiCluster.enabled=true",
+				"values.global.multiCluster.clusterName=remote4",
+				"profile=remote",
+			},
+		},
+		{
+			cluster: "remote4",
+			wantErr: true,
+		},
+	}
+	l := NewLogger(true, ioutil.Discard, ioutil.Discard)
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s dry run %v", tt.cluster, tt.dryRun), func(t *testing.T) {
+			got, err := topo.clusterSet(topo.cluster(tt.cluster), tt.dryRun, l)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func clusterNames(clusters []*clusterTopology) []string {
+	var out []string
+	for _, c := range clusters {
+		out = append(out, c.Name)
+	}
+	return out
+}
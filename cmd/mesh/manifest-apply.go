@@ -17,7 +17,6 @@ package mesh
 import (
 	"fmt"
 	"io"
-	"os"
 	"strings"
 	"time"
 
@@ -43,6 +42,31 @@ type manifestApplyArgs struct {
 	// set is a string with element format "path=value" where path is an IstioOperator path and the value is a
 	// value to set the node at that path to.
 	set []string
+	// useKubectl applies manifests with kubectl instead of the built-in server-side apply client.
+	useKubectl bool
+	// revision is the control plane revision to install.
+	revision string
+	// bundle selects the bundle to render from.
+	bundle bundleArgs
+	// serverDryRun validates all objects with the API server without persisting them.
+	serverDryRun bool
+	// output is the format the result is printed in, if set.
+	output string
+	// prune controls how objects which are no longer rendered are pruned.
+	prune pruneArgs
+}
+
+// pruneArgs holds the flags controlling pruning.
+type pruneArgs struct {
+	// preview reports the objects which would be pruned instead of deleting them.
+	preview bool
+	// max is the maximum number of objects pruned per component, 0 meaning no limit.
+	max int
+}
+
+func addPruneFlags(cmd *cobra.Command, args *pruneArgs) {
+	cmd.PersistentFlags().BoolVar(&args.preview, "prune-preview", false, prunePreviewFlagHelpStr)
+	cmd.PersistentFlags().IntVar(&args.max, "max-prune", 0, maxPruneFlagHelpStr)
 }
 
 func addManifestApplyFlags(cmd *cobra.Command, args *manifestApplyArgs) {
@@ -56,6 +80,12 @@ func addManifestApplyFlags(cmd *cobra.Command, args *manifestApplyArgs) {
 	cmd.PersistentFlags().BoolVarP(&args.wait, "wait", "w", false, "Wait, if set will wait until all Pods, Services, and minimum number of Pods "+
 		"of a Deployment are in a ready state before the command exits. It will wait for a maximum duration of --readiness-timeout seconds")
 	cmd.PersistentFlags().StringSliceVarP(&args.set, "set", "s", nil, SetFlagHelpStr)
+	cmd.PersistentFlags().BoolVar(&args.useKubectl, "use-kubectl", false, useKubectlFlagHelpStr)
+	cmd.PersistentFlags().StringVar(&args.revision, "revision", "", revisionFlagHelpStr)
+	addBundleFlags(cmd, &args.bundle)
+	cmd.PersistentFlags().BoolVar(&args.serverDryRun, "server-dry-run", false, serverDryRunFlagHelpStr)
+	addOutputFlag(cmd, &args.output)
+	addPruneFlags(cmd, &args.prune)
 }
 
 func manifestApplyCmd(rootArgs *rootArgs, maArgs *manifestApplyArgs) *cobra.Command {
@@ -65,24 +95,48 @@ func manifestApplyCmd(rootArgs *rootArgs, maArgs *manifestApplyArgs) *cobra.Comm
 		Long:  "The apply subcommand generates an Istio install manifest and applies it to a cluster.",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			if err := validateOutputFormat(maArgs.output); err != nil {
+				return err
+			}
+			l := newCommandLogger(rootArgs, maArgs.output, cmd)
+			result := &CommandResult{Command: "manifest apply"}
 			// Warn users before starting to install Istio
-			if !rootArgs.dryRun && !maArgs.skipConfirmation {
-				if !confirm("This will install Istio into the cluster. Proceed? (y/N)", cmd.OutOrStdout()) {
-					cmd.Print("Cancelled.\n")
-					os.Exit(1)
+			if !rootArgs.dryRun && !maArgs.serverDryRun && !maArgs.skipConfirmation {
+				if !confirm("This will install Istio into the cluster. Proceed? (y/N)", l.stdOut) {
+					return writeResult(cmd.OutOrStdout(), maArgs.output, result, fmt.Errorf("installation cancelled"))
 				}
 			}
-			return manifestApply(rootArgs, maArgs, l)
+			err := manifestApply(rootArgs, maArgs, result, l)
+			return writeResult(cmd.OutOrStdout(), maArgs.output, result, err)
 		}}
 }
 
-func manifestApply(args *rootArgs, maArgs *manifestApplyArgs, l *Logger) error {
+// manifestApply generates and applies the manifests, and records the result of each component in result.
+func manifestApply(args *rootArgs, maArgs *manifestApplyArgs, result *CommandResult, l *Logger) error {
 	if err := configLogs(args.logToStdErr); err != nil {
 		return fmt.Errorf("could not configure logs: %s", err)
 	}
-	if err := genApplyManifests(maArgs.set, maArgs.inFilename, maArgs.force, args.dryRun, args.verbose,
-		maArgs.kubeConfigPath, maArgs.context, maArgs.wait, maArgs.readinessTimeout, l); err != nil {
+	set, cleanup, err := setFromBundle(&maArgs.bundle, setWithRevision(maArgs.set, maArgs.revision))
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	result.Components, _, err = genApplyManifests(&applyManifestsArgs{
+		set:            set,
+		inFilename:     maArgs.inFilename,
+		force:          maArgs.force,
+		dryRun:         args.dryRun,
+		serverDryRun:   maArgs.serverDryRun,
+		verbose:        args.verbose,
+		kubeConfigPath: maArgs.kubeConfigPath,
+		context:        maArgs.context,
+		wait:           maArgs.wait,
+		waitTimeout:    maArgs.readinessTimeout,
+		useKubectl:     maArgs.useKubectl,
+		prune:          maArgs.prune,
+		imageHub:       maArgs.bundle.hub,
+	}, l)
+	if err != nil {
 		return fmt.Errorf("failed to generate and apply manifests, error: %v", err)
 	}
 
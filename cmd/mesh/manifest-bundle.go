@@ -0,0 +1,167 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/bundle"
+	binversion "istio.io/operator/version"
+)
+
+const (
+	defaultBundleFilename = "istio-bundle.tar.gz"
+
+	fromBundleFlagHelpStr = `Path to a bundle created with "manifest bundle". The charts and profile are read from the ` +
+		`bundle and the files and values passed with -f and --set are overlaid on the bundled profile`
+	bundleHubFlagHelpStr = `Registry the bundle images were mirrored to, e.g. registry.example.com/istio. The ` +
+		`images in the generated manifests are rewritten to it. Requires --from-bundle`
+)
+
+type manifestBundleArgs struct {
+	// inFilename is the path to the input IstioOperator CR.
+	inFilename string
+	// outFilename is the path of the bundle to write.
+	outFilename string
+	// set is a string with element format "path=value" where path is an IstioOperator path and the value is a
+	// value to set the node at that path to.
+	set []string
+	// force proceeds even if there are validation errors
+	force bool
+	// versionsURI is a URI pointing to a YAML formatted versions mapping.
+	versionsURI string
+}
+
+// bundleArgs are the flags of commands which can render manifests from a bundle.
+type bundleArgs struct {
+	// fromBundle is the path to the bundle.
+	fromBundle string
+	// hub is the registry the bundle images were mirrored to.
+	hub string
+}
+
+func addManifestBundleFlags(cmd *cobra.Command, args *manifestBundleArgs) {
+	cmd.PersistentFlags().StringVarP(&args.inFilename, "filename", "f", "", filenameFlagHelpStr)
+	cmd.PersistentFlags().StringVarP(&args.outFilename, "output", "o", defaultBundleFilename, "Bundle output file path")
+	cmd.PersistentFlags().StringSliceVarP(&args.set, "set", "s", nil, SetFlagHelpStr)
+	cmd.PersistentFlags().BoolVar(&args.force, "force", false, "Proceed even with validation errors")
+	cmd.PersistentFlags().StringVarP(&args.versionsURI, "versionsURI", "u", versionsMapURL,
+		"URI for operator versions to Istio versions map")
+}
+
+func addBundleFlags(cmd *cobra.Command, args *bundleArgs) {
+	cmd.PersistentFlags().StringVar(&args.fromBundle, "from-bundle", "", fromBundleFlagHelpStr)
+	cmd.PersistentFlags().StringVar(&args.hub, "hub", "", bundleHubFlagHelpStr)
+}
+
+func manifestBundleCmd(rootArgs *rootArgs, mbArgs *manifestBundleArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bundle",
+		Short: "Creates a self-contained installation bundle for air-gapped clusters",
+		Long: "The bundle subcommand resolves the charts and profile of an installation, and writes them together " +
+			"with the list of required container images and the versions map to a tarball. The tarball can be used " +
+			"with --from-bundle to generate or apply manifests without network access.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return manifestBundle(rootArgs, mbArgs, l)
+		}}
+}
+
+func manifestBundle(args *rootArgs, mbArgs *manifestBundleArgs, l *Logger) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
+	overlayFromSet, err := MakeTreeFromSetList(mbArgs.set, mbArgs.force, l)
+	if err != nil {
+		return err
+	}
+	manifests, iops, err := GenManifests(mbArgs.inFilename, overlayFromSet, mbArgs.force, l)
+	if err != nil {
+		return err
+	}
+	versions, err := loadCompatibleMapFile(mbArgs.versionsURI, l)
+	if err != nil {
+		return fmt.Errorf("failed to load the versions map: %s", err)
+	}
+	b, err := bundle.NewBundle(manifests, iops, binversion.OperatorVersionString, versions)
+	if err != nil {
+		return err
+	}
+	if args.dryRun {
+		l.logAndPrintf("Dry run: would write bundle %s with %d images:", mbArgs.outFilename, len(b.Images))
+		for _, image := range b.Images {
+			l.logAndPrintf("  %s", image)
+		}
+		return nil
+	}
+
+	f, err := os.Create(mbArgs.outFilename)
+	if err != nil {
+		return err
+	}
+	if err := bundle.Write(f, b); err != nil {
+		f.Close()
+		os.Remove(mbArgs.outFilename)
+		return fmt.Errorf("failed to write bundle %s: %s", mbArgs.outFilename, err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	l.logAndPrintf("Wrote bundle %s with %d images, listed in %s.", mbArgs.outFilename, len(b.Images),
+		bundle.ImagesFile)
+	return nil
+}
+
+// setFromBundle extracts the bundle selected by ba, if any, and returns setOverlay with the values required to
+// render from it prepended, so that they can be overridden by the user. The returned function removes the extracted
+// bundle.
+func setFromBundle(ba *bundleArgs, setOverlay []string) ([]string, func(), error) {
+	if ba.fromBundle == "" {
+		if ba.hub != "" {
+			return nil, nil, fmt.Errorf("--hub requires --from-bundle")
+		}
+		return setOverlay, func() {}, nil
+	}
+	dir, err := ioutil.TempDir("", "istio-bundle")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+	md, err := bundle.Extract(ba.fromBundle, dir)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	out := []string{
+		"profile=" + filepath.Join(dir, bundle.ProfileFile),
+		"installPackagePath=" + filepath.Join(dir, bundle.ChartsDir),
+	}
+	if md.Tag != "" {
+		out = append(out, "tag="+md.Tag)
+	}
+	switch {
+	case ba.hub != "":
+		out = append(out, "hub="+ba.hub)
+	case md.Hub != "":
+		out = append(out, "hub="+md.Hub)
+	}
+	return append(out, setOverlay...), cleanup, nil
+}
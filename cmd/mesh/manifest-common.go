@@ -16,7 +16,6 @@ package mesh
 
 import (
 	"fmt"
-	"path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -24,6 +23,7 @@ import (
 	"github.com/ghodss/yaml"
 
 	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/bundle"
 	"istio.io/operator/pkg/component/controlplane"
 	"istio.io/operator/pkg/helm"
 	"istio.io/operator/pkg/kubectlcmd"
@@ -43,28 +43,85 @@ var (
 	}
 )
 
-func genApplyManifests(setOverlay []string, inFilename string, force bool, dryRun bool, verbose bool,
-	kubeConfigPath string, context string, wait bool, waitTimeout time.Duration, l *Logger) error {
-	overlayFromSet, err := MakeTreeFromSetList(setOverlay, force, l)
+// applyManifestsArgs holds the arguments of genApplyManifests.
+type applyManifestsArgs struct {
+	// set is a list of "path=value" overlays of the IstioOperator read from inFilename.
+	set []string
+	// inFilename is the path to the input IstioOperator CR.
+	inFilename string
+	// force proceeds even if there are validation errors.
+	force bool
+	// dryRun prints what would be applied without changing the cluster.
+	dryRun bool
+	// serverDryRun validates all objects with the API server without persisting them.
+	serverDryRun bool
+	// verbose prints the objects of each component.
+	verbose bool
+	// kubeConfigPath is the path to kube config file.
+	kubeConfigPath string
+	// context is the cluster context in the kube config.
+	context string
+	// wait waits up to waitTimeout for the applied objects to become ready.
+	wait        bool
+	waitTimeout time.Duration
+	// useKubectl applies with kubectl instead of the built-in client.
+	useKubectl bool
+	// prune holds the pruning options.
+	prune pruneArgs
+	// imageHub, if set, is the registry all images are rewritten to.
+	imageHub string
+}
+
+// genApplyManifests generates the manifests and applies them to the cluster. Before applying, the live state of
+// all affected components is recorded in the install history, and restored if the apply fails. The snapshot is
+// returned so that callers can roll back after later failures. It is nil in dry run mode. In server dry run mode,
+// all objects are validated by the API server without being persisted, and the result for each object is reported.
+// The result of each component is returned once the manifests have been applied.
+func genApplyManifests(args *applyManifestsArgs, l *Logger) ([]*ComponentResult, *manifest.Snapshot, error) {
+	overlayFromSet, err := MakeTreeFromSetList(args.set, args.force, l)
 	if err != nil {
-		return fmt.Errorf("failed to generate tree from the set overlay, error: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate tree from the set overlay, error: %v", err)
 	}
 
-	manifests, iops, err := GenManifests(inFilename, overlayFromSet, force, l)
+	manifests, iops, err := GenManifests(args.inFilename, overlayFromSet, args.force, l)
 	if err != nil {
-		return fmt.Errorf("failed to generate manifest: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate manifest: %v", err)
+	}
+	if args.imageHub != "" {
+		if manifests, err = bundle.RewriteImages(manifests, args.imageHub); err != nil {
+			return nil, nil, fmt.Errorf("failed to rewrite images: %v", err)
+		}
 	}
 	opts := &kubectlcmd.Options{
-		DryRun:      dryRun,
-		Verbose:     verbose,
-		Wait:        wait,
-		WaitTimeout: waitTimeout,
-		Kubeconfig:  kubeConfigPath,
-		Context:     context,
+		DryRun:       args.dryRun,
+		ServerDryRun: args.serverDryRun,
+		Verbose:      args.verbose,
+		Wait:         args.wait,
+		WaitTimeout:  args.waitTimeout,
+		Kubeconfig:   args.kubeConfigPath,
+		Context:      args.context,
+		UseKubectl:   args.useKubectl,
+		PrunePreview: args.prune.preview,
+		MaxPrune:     args.prune.max,
 	}
+
+	var snapshot *manifest.Snapshot
+	historyNamespace, err := name.Namespace(name.IstioBaseComponentName, iops)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !args.dryRun && !args.serverDryRun {
+		if snapshot, err = snapshotInstall(manifests, historyNamespace, opts, l); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	out, err := manifest.ApplyAll(manifests, version.OperatorBinaryVersion, opts)
 	if err != nil {
-		return fmt.Errorf("failed to apply manifest with kubectl client: %v", err)
+		if rerr := rollbackInstall(historyNamespace, snapshot, l); rerr != nil {
+			return nil, snapshot, fmt.Errorf("failed to apply manifest: %v, and failed to roll back: %v", err, rerr)
+		}
+		return nil, snapshot, fmt.Errorf("failed to apply manifest: %v", err)
 	}
 	gotError := false
 	skippedComponentMap := map[name.ComponentName]bool{}
@@ -79,6 +136,7 @@ func genApplyManifests(setOverlay []string, inFilename string, force bool, dryRu
 			skippedComponentMap[cn] = true
 		}
 	}
+	results := componentResults(out, skippedComponentMap)
 
 	for cn := range manifests {
 		if out[cn].Err != nil {
@@ -89,6 +147,9 @@ func genApplyManifests(setOverlay []string, inFilename string, force bool, dryRu
 		} else if skippedComponentMap[cn] {
 			continue
 		}
+		if (args.verbose || args.serverDryRun || args.prune.preview) && len(out[cn].Objects) != 0 {
+			l.logAndPrintf("Component %s objects:\n%s", cn, out[cn].Objects)
+		}
 
 		if !ignoreError(out[cn].Stderr) {
 			l.logAndPrint("Error detail:\n", out[cn].Stderr, "\n", out[cn].Stdout, "\n")
@@ -96,12 +157,74 @@ func genApplyManifests(setOverlay []string, inFilename string, force bool, dryRu
 		}
 	}
 
+	if gotError && args.serverDryRun {
+		l.logAndPrint("\n\n✘ Errors were returned by the API server during the server dry run. Please check component " +
+			"installation logs above.\n")
+		return results, nil, fmt.Errorf("errors were returned by the API server during the server dry run")
+	}
+	if args.serverDryRun {
+		l.logAndPrint("\n\n✔ Server dry run complete, the API server accepted all objects\n")
+		return results, nil, nil
+	}
 	if gotError {
 		l.logAndPrint("\n\n✘ Errors were logged during apply operation. Please check component installation logs above.\n")
-		return fmt.Errorf("errors were logged during apply operation")
+		if err := rollbackInstall(historyNamespace, snapshot, l); err != nil {
+			return results, snapshot, fmt.Errorf("errors were logged during apply operation, and failed to roll back: %v", err)
+		}
+		return results, snapshot, fmt.Errorf("errors were logged during apply operation")
 	}
 
 	l.logAndPrint("\n\n✔ Installation complete\n")
+	return results, snapshot, nil
+}
+
+// snapshotInstall records the live state of all components in manifests in the install history. If the history
+// cannot be saved, e.g. because its namespace does not exist yet, the snapshot is kept in memory only.
+func snapshotInstall(manifests name.ManifestMap, historyNamespace string, opts *kubectlcmd.Options, l *Logger) (*manifest.Snapshot, error) {
+	if err := manifest.InitK8SRestClient(opts.Kubeconfig, opts.Context); err != nil {
+		return nil, err
+	}
+	h, err := manifest.NewDefaultHistory(historyNamespace)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := h.Snapshot(manifests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot the live state of the installation: %v", err)
+	}
+	if err := h.Save(snapshot); err != nil {
+		l.logAndPrintf("Warning: install snapshot could not be saved, it can only be used to roll back this apply: %v", err)
+		return snapshot, nil
+	}
+	l.logAndPrintf("Saved the live state of the installation as revision %d.", snapshot.Revision)
+	return snapshot, nil
+}
+
+// rollbackInstall restores the installation to the state captured in snapshot. It is a no-op if snapshot is nil.
+func rollbackInstall(historyNamespace string, snapshot *manifest.Snapshot, l *Logger) error {
+	if snapshot == nil {
+		return nil
+	}
+	if snapshot.Revision != 0 {
+		l.logAndPrintf("\nRolling back to revision %d...", snapshot.Revision)
+	} else {
+		l.logAndPrint("\nRolling back to the state before apply...")
+	}
+	h, err := manifest.NewDefaultHistory(historyNamespace)
+	if err != nil {
+		return err
+	}
+	out, err := h.Restore(snapshot)
+	for cn, o := range out {
+		if o.Err != nil {
+			l.logAndPrintf("Component %s - rollback returned the following errors:\n%s", cn, o.Err)
+		}
+	}
+	if err != nil {
+		l.logAndPrint("✘ Rollback failed.")
+		return err
+	}
+	l.logAndPrint("✔ Rollback complete.")
 	return nil
 }
 
@@ -150,9 +273,22 @@ func ignoreError(stderr string) bool {
 	return trimmedStdErr == ""
 }
 
-// fetchInstallPackageFromURL downloads installation packages from specified URL.
+// fetchInstallPackageFromURL downloads installation packages from specified URL, which may be an installation package
+// tarball URL or a reference to a chart source like an OCI registry or Helm repository.
 func fetchInstallPackageFromURL(mergedIOPS *v1alpha1.IstioOperatorSpec) error {
-	if util.IsHTTPURL(mergedIOPS.InstallPackagePath) {
+	switch {
+	case helm.IsChartSourceRef(mergedIOPS.InstallPackagePath):
+		verifier, err := packageVerifier()
+		if err != nil {
+			return err
+		}
+		helm.SetChartVerifier(verifier)
+		pkgPath, err := helm.FetchCharts(mergedIOPS.InstallPackagePath)
+		if err != nil {
+			return err
+		}
+		mergedIOPS.InstallPackagePath = pkgPath
+	case util.IsHTTPURL(mergedIOPS.InstallPackagePath):
 		pkgPath, err := fetchInstallPackage(mergedIOPS.InstallPackagePath)
 		if err != nil {
 			return err
@@ -163,19 +299,39 @@ func fetchInstallPackageFromURL(mergedIOPS *v1alpha1.IstioOperatorSpec) error {
 	return nil
 }
 
-// fetchInstallPackage downloads installation packages from the given url.
+// fetchInstallPackage downloads installation packages from the given url. The package signature is verified unless
+// verification is explicitly skipped.
 func fetchInstallPackage(url string) (string, error) {
 	uf, err := helm.NewURLFetcher(url, "")
 	if err != nil {
 		return "", err
 	}
+	verifier, err := packageVerifier()
+	if err != nil {
+		return "", err
+	}
+	uf.SetSignatureVerifier(verifier)
 	if err := uf.FetchBundles().ToError(); err != nil {
 		return "", err
 	}
-	isp := path.Base(url)
-	// get rid of the suffix, installation package is untared to folder name istio-{version}, e.g. istio-1.3.0
-	idx := strings.LastIndex(isp, "-")
-	return filepath.Join(uf.DestDir(), isp[:idx]), nil
+	return uf.PackageDir(), nil
+}
+
+// packageVerifier returns the verifier of installation package signatures configured by the command line flags.
+func packageVerifier() (*helm.SignatureVerifier, error) {
+	verifier, err := helm.NewSignatureVerifier(packageVerificationArgs.keysPath, packageVerificationArgs.insecure)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load package verification keys: %s", err)
+	}
+	return verifier, nil
+}
+
+// setWithRevision returns setOverlay with values.revision set to rev, if rev is not empty.
+func setWithRevision(setOverlay []string, rev string) []string {
+	if rev == "" {
+		return setOverlay
+	}
+	return append(append([]string{}, setOverlay...), "values.revision="+rev)
 }
 
 // MakeTreeFromSetList creates a YAML tree from a string slice containing key-value pairs in the format key=value.
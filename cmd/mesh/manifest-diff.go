@@ -17,14 +17,15 @@ package mesh
 import (
 	"fmt"
 	"io/ioutil"
-	"os"
 	"path/filepath"
 
 	"istio.io/operator/pkg/compare"
 
 	"github.com/spf13/cobra"
 
+	"istio.io/operator/pkg/manifest"
 	"istio.io/operator/pkg/util"
+	"istio.io/operator/version"
 )
 
 // YAMLSuffix is the suffix of a YAML file.
@@ -45,6 +46,21 @@ type manifestDiffArgs struct {
 	// The format of each renaming pair is A->B, all renaming pairs are comma separated.
 	// e.g. Service:*:istio-pilot->Service:*:istio-control - rename istio-pilot service into istio-control
 	renameResources string
+	// cluster compares the manifest generated from inFilename and set with the live objects in the cluster.
+	cluster bool
+	// inFilename is the path to the input IstioOperator CR.
+	inFilename string
+	// set is a string with element format "path=value" where path is an IstioOperator path and the value is a
+	// value to set the node at that path to.
+	set []string
+	// force proceeds even if there are validation errors
+	force bool
+	// kubeConfigPath is the path to kube config file.
+	kubeConfigPath string
+	// context is the cluster context in the kube config
+	context string
+	// output is the format the result is printed in, if set.
+	output string
 }
 
 func addManifestDiffFlags(cmd *cobra.Command, diffArgs *manifestDiffArgs) {
@@ -62,73 +78,86 @@ func addManifestDiffFlags(cmd *cobra.Command, diffArgs *manifestDiffArgs) {
 		"renameResources identifies renamed resources before comparison.\n"+
 			"The format of each renaming pair is A->B, all renaming pairs are comma separated.\n"+
 			"e.g. Service:*:istio-pilot->Service:*:istio-control - rename istio-pilot service into istio-control")
+	cmd.PersistentFlags().BoolVar(&diffArgs.cluster, "cluster", false,
+		"Compare the manifest generated from --filename and --set with the live objects in the cluster")
+	cmd.PersistentFlags().StringVarP(&diffArgs.inFilename, "filename", "f", "", filenameFlagHelpStr)
+	cmd.PersistentFlags().StringSliceVarP(&diffArgs.set, "set", "s", nil, SetFlagHelpStr)
+	cmd.PersistentFlags().BoolVar(&diffArgs.force, "force", false, "Proceed even with validation errors")
+	cmd.PersistentFlags().StringVarP(&diffArgs.kubeConfigPath, "kubeconfig", "c", "", "Path to kube config")
+	cmd.PersistentFlags().StringVar(&diffArgs.context, "context", "", "The name of the kubeconfig context to use")
+	addOutputFlag(cmd, &diffArgs.output)
 }
 
 func manifestDiffCmd(rootArgs *rootArgs, diffArgs *manifestDiffArgs) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "diff <file|dir> <file|dir>",
 		Short: "Compare manifests and generate diff",
-		Long:  "The diff subcommand compares manifests from two files or directories.",
+		Long: "The diff subcommand compares manifests from two files or directories. With --cluster, it compares " +
+			"the manifest generated from --filename and --set with the live objects in the cluster.",
 		Args: func(cmd *cobra.Command, args []string) error {
+			if diffArgs.cluster {
+				if len(args) != 0 {
+					return fmt.Errorf("diff --cluster does not take files or directories")
+				}
+				return nil
+			}
 			if len(args) != 2 {
 				return fmt.Errorf("diff requires two files or directories")
 			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutputFormat(diffArgs.output); err != nil {
+				return err
+			}
+			result := &CommandResult{Command: "manifest diff"}
 			var err error
-			var equal bool
-			if diffArgs.compareDir {
-				equal, err = compareManifestsFromDirs(rootArgs, args[0], args[1], diffArgs.renameResources,
+			switch {
+			case diffArgs.cluster:
+				l := newCommandLogger(rootArgs, diffArgs.output, cmd)
+				result.Diff, err = compareManifestsWithCluster(rootArgs, diffArgs, l)
+			case diffArgs.compareDir:
+				result.Diff, err = compareManifestsFromDirs(rootArgs, args[0], args[1], diffArgs.renameResources,
+					diffArgs.selectResources, diffArgs.ignoreResources)
+			default:
+				result.Diff, err = compareManifestsFromFiles(rootArgs, args, diffArgs.renameResources,
 					diffArgs.selectResources, diffArgs.ignoreResources)
-				if err != nil {
-					return err
-				}
-				if !equal {
-					os.Exit(1)
-				}
-				return nil
 			}
-
-			equal, err = compareManifestsFromFiles(rootArgs, args, diffArgs.renameResources,
-				diffArgs.selectResources, diffArgs.ignoreResources)
 			if err != nil {
-				return err
+				return writeResult(cmd.OutOrStdout(), diffArgs.output, result, err)
 			}
-			if !equal {
-				os.Exit(1)
+			if diffArgs.output == "" {
+				printDiff(result.Diff, diffArgs.cluster)
 			}
-			return nil
+			if !result.Diff.Identical {
+				// Differences fail the command so that it exits with a non-zero code, like diff.
+				err = fmt.Errorf("manifests differ")
+			}
+			return writeResult(cmd.OutOrStdout(), diffArgs.output, result, err)
 		}}
 	return cmd
 }
 
 //compareManifestsFromFiles compares two manifest files
 func compareManifestsFromFiles(rootArgs *rootArgs, args []string,
-	renameResources, selectResources, ignoreResources string) (bool, error) {
+	renameResources, selectResources, ignoreResources string) (*DiffResult, error) {
 	initLogsOrExit(rootArgs)
 
 	a, err := ioutil.ReadFile(args[0])
 	if err != nil {
-		return false, fmt.Errorf("could not read %q: %v", args[0], err)
+		return nil, fmt.Errorf("could not read %q: %v", args[0], err)
 	}
 	b, err := ioutil.ReadFile(args[1])
 	if err != nil {
-		return false, fmt.Errorf("could not read %q: %v", args[1], err)
+		return nil, fmt.Errorf("could not read %q: %v", args[1], err)
 	}
 
-	diff, err := compare.ManifestDiffWithRenameSelectIgnore(string(a), string(b), renameResources, selectResources,
+	diff, summary, err := compare.ManifestDiffWithSummary(string(a), string(b), renameResources, selectResources,
 		ignoreResources, rootArgs.verbose)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	if diff != "" {
-		fmt.Printf("Differences in manifests are:\n%s\n", diff)
-		return false, nil
-	}
-
-	fmt.Println("Manifests are identical")
-	return true, nil
+	return newDiffResult(diff, summary), nil
 }
 
 func yamlFileFilter(path string) bool {
@@ -137,28 +166,90 @@ func yamlFileFilter(path string) bool {
 
 //compareManifestsFromDirs compares manifests from two directories
 func compareManifestsFromDirs(rootArgs *rootArgs, dirName1, dirName2,
-	renameResources, selectResources, ignoreResources string) (bool, error) {
+	renameResources, selectResources, ignoreResources string) (*DiffResult, error) {
 	initLogsOrExit(rootArgs)
 
 	mf1, err := util.ReadFilesWithFilter(dirName1, yamlFileFilter)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	mf2, err := util.ReadFilesWithFilter(dirName2, yamlFileFilter)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	diff, err := compare.ManifestDiffWithRenameSelectIgnore(mf1, mf2, renameResources, selectResources,
+	diff, summary, err := compare.ManifestDiffWithSummary(mf1, mf2, renameResources, selectResources,
 		ignoreResources, rootArgs.verbose)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	if diff != "" {
-		fmt.Printf("Differences in manifests are:\n%s\n", diff)
-		return false, nil
+	return newDiffResult(diff, summary), nil
+}
+
+// compareManifestsWithCluster compares the manifest generated from the input file and set values with the live
+// objects in the cluster. Fields populated by the API server are not compared.
+func compareManifestsWithCluster(rootArgs *rootArgs, diffArgs *manifestDiffArgs, l *Logger) (*DiffResult, error) {
+	initLogsOrExit(rootArgs)
+
+	overlayFromSet, err := MakeTreeFromSetList(diffArgs.set, diffArgs.force, l)
+	if err != nil {
+		return nil, err
+	}
+	manifests, _, err := GenManifests(diffArgs.inFilename, overlayFromSet, diffArgs.force, l)
+	if err != nil {
+		return nil, err
+	}
+	ver := version.OperatorBinaryVersion.String()
+	rendered, err := manifest.RenderedObjects(manifests, ver)
+	if err != nil {
+		return nil, err
+	}
+	live, err := manifest.LiveObjects(diffArgs.kubeConfigPath, diffArgs.context, manifests, ver)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the live objects: %v", err)
+	}
+	a, err := rendered.YAMLManifest()
+	if err != nil {
+		return nil, err
+	}
+	b, err := live.YAMLManifest()
+	if err != nil {
+		return nil, err
 	}
 
-	fmt.Println("Manifests are identical")
-	return true, nil
+	diff, summary, err := compare.ManifestDiffWithSummary(a, b, diffArgs.renameResources, diffArgs.selectResources,
+		diffArgs.ignoreResources, rootArgs.verbose)
+	if err != nil {
+		return nil, err
+	}
+	return newDiffResult(diff, summary), nil
+}
+
+// printDiff prints res in human readable form. For a cluster diff, the objects which would be created, changed or
+// pruned by an apply are listed after the diff.
+func printDiff(res *DiffResult, cluster bool) {
+	if !cluster {
+		if res.Identical {
+			fmt.Println("Manifests are identical")
+			return
+		}
+		fmt.Printf("Differences in manifests are:\n%s\n", res.Diff)
+		return
+	}
+	if res.Identical {
+		fmt.Println("Manifests are identical to the cluster")
+		return
+	}
+	fmt.Printf("Differences between the manifests (A) and the cluster (B) are:\n%s\n", res.Diff)
+	fmt.Printf("\nApplying the manifests would:\n")
+	printDiffSummary("create", res.OnlyInA)
+	printDiffSummary("change", res.Changed)
+	printDiffSummary("prune", res.OnlyInB)
+}
+
+func printDiffSummary(action string, objects []string) {
+	fmt.Printf("  %s %d objects\n", action, len(objects))
+	for _, o := range objects {
+		fmt.Printf("    %s\n", o)
+	}
 }
@@ -24,8 +24,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"istio.io/operator/pkg/bundle"
 	"istio.io/operator/pkg/manifest"
 	"istio.io/operator/pkg/name"
+	"istio.io/operator/version"
 )
 
 type manifestGenerateArgs struct {
@@ -38,6 +40,10 @@ type manifestGenerateArgs struct {
 	set []string
 	// force proceeds even if there are validation errors
 	force bool
+	// revision is the control plane revision to generate.
+	revision string
+	// bundle selects the bundle to render from.
+	bundle bundleArgs
 }
 
 func addManifestGenerateFlags(cmd *cobra.Command, args *manifestGenerateArgs) {
@@ -45,6 +51,8 @@ func addManifestGenerateFlags(cmd *cobra.Command, args *manifestGenerateArgs) {
 	cmd.PersistentFlags().StringVarP(&args.outFilename, "output", "o", "", "Manifest output directory path")
 	cmd.PersistentFlags().StringSliceVarP(&args.set, "set", "s", nil, SetFlagHelpStr)
 	cmd.PersistentFlags().BoolVar(&args.force, "force", false, "Proceed even with validation errors")
+	cmd.PersistentFlags().StringVar(&args.revision, "revision", "", revisionFlagHelpStr)
+	addBundleFlags(cmd, &args.bundle)
 }
 
 func manifestGenerateCmd(rootArgs *rootArgs, mgArgs *manifestGenerateArgs) *cobra.Command {
@@ -70,7 +78,12 @@ func manifestGenerate(args *rootArgs, mgArgs *manifestGenerateArgs, l *Logger) e
 		return fmt.Errorf("could not configure logs: %s", err)
 	}
 
-	overlayFromSet, err := MakeTreeFromSetList(mgArgs.set, mgArgs.force, l)
+	set, cleanup, err := setFromBundle(&mgArgs.bundle, setWithRevision(mgArgs.set, mgArgs.revision))
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	overlayFromSet, err := MakeTreeFromSetList(set, mgArgs.force, l)
 	if err != nil {
 		return err
 	}
@@ -78,6 +91,11 @@ func manifestGenerate(args *rootArgs, mgArgs *manifestGenerateArgs, l *Logger) e
 	if err != nil {
 		return err
 	}
+	if mgArgs.bundle.hub != "" {
+		if manifests, err = bundle.RewriteImages(manifests, mgArgs.bundle.hub); err != nil {
+			return err
+		}
+	}
 
 	if mgArgs.outFilename == "" {
 		for _, m := range orderedManifests(manifests) {
@@ -87,7 +105,7 @@ func manifestGenerate(args *rootArgs, mgArgs *manifestGenerateArgs, l *Logger) e
 		if err := os.MkdirAll(mgArgs.outFilename, os.ModePerm); err != nil {
 			return err
 		}
-		if err := manifest.RenderToDir(manifests, mgArgs.outFilename, args.dryRun); err != nil {
+		if err := manifest.RenderToDir(manifests, version.OperatorBinaryVersion, mgArgs.outFilename, args.dryRun); err != nil {
 			return err
 		}
 	}
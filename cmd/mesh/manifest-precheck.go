@@ -0,0 +1,122 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/precheck"
+	binversion "istio.io/operator/version"
+)
+
+type manifestPrecheckArgs struct {
+	// inFilename is the path to the input IstioOperator CR.
+	inFilename string
+	// set is a string with element format "path=value" where path is an IstioOperator path and the value is a
+	// value to set the node at that path to.
+	set []string
+	// force proceeds even if there are validation errors
+	force bool
+	// kubeConfigPath is the path to kube config file.
+	kubeConfigPath string
+	// context is the cluster context in the kube config
+	context string
+	// versionsURI is a URI pointing to a YAML formatted versions mapping, holding the supported Kubernetes versions.
+	versionsURI string
+	// output is the format the result is printed in, if set.
+	output string
+}
+
+func addManifestPrecheckFlags(cmd *cobra.Command, args *manifestPrecheckArgs) {
+	cmd.PersistentFlags().StringVarP(&args.inFilename, "filename", "f", "", filenameFlagHelpStr)
+	cmd.PersistentFlags().StringSliceVarP(&args.set, "set", "s", nil, SetFlagHelpStr)
+	cmd.PersistentFlags().BoolVar(&args.force, "force", false, "Proceed even with validation errors")
+	cmd.PersistentFlags().StringVarP(&args.kubeConfigPath, "kubeconfig", "c", "", "Path to kube config")
+	cmd.PersistentFlags().StringVar(&args.context, "context", "", "The name of the kubeconfig context to use")
+	cmd.PersistentFlags().StringVarP(&args.versionsURI, "versionsURI", "u",
+		versionsMapURL, "URI for operator versions to Istio versions map")
+	addOutputFlag(cmd, &args.output)
+}
+
+func manifestPrecheckCmd(rootArgs *rootArgs, pcArgs *manifestPrecheckArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "precheck",
+		Short: "Checks that a cluster is compatible with an Istio install",
+		Long: "The precheck subcommand checks that the cluster is compatible with the install generated from " +
+			"--filename and --set, before it is applied with manifest apply, operator init or upgrade. It checks the " +
+			"Kubernetes version, the permissions of the user for each resource of the install, the CNI network plugin " +
+			"if Istio CNI is enabled, and CRDs or webhooks already owned by a non-operator install.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutputFormat(pcArgs.output); err != nil {
+				return err
+			}
+			l := newCommandLogger(rootArgs, pcArgs.output, cmd)
+			result := &CommandResult{Command: "manifest precheck"}
+			err := manifestPrecheck(rootArgs, pcArgs, result, l)
+			return writeResult(cmd.OutOrStdout(), pcArgs.output, result, err)
+		}}
+}
+
+// manifestPrecheck runs the pre-flight checks against the cluster and records them in result. An error is returned
+// if any check failed.
+func manifestPrecheck(rootArgs *rootArgs, args *manifestPrecheckArgs, result *CommandResult, l *Logger) error {
+	initLogsOrExit(rootArgs)
+
+	overlayFromSet, err := MakeTreeFromSetList(args.set, args.force, l)
+	if err != nil {
+		return err
+	}
+	manifests, iops, err := GenManifests(args.inFilename, overlayFromSet, args.force, l)
+	if err != nil {
+		return err
+	}
+	versionMap, err := getVersionCompatibleMap(args.versionsURI, binversion.OperatorBinaryGoVersion, l)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve version map, error: %v", err)
+	}
+
+	p := &precheck.Params{
+		Namespace:          iops.MeshConfig.RootNamespace,
+		KubernetesVersions: versionMap.SupportedKubernetesVersions,
+	}
+	checks, err := manifest.Precheck(args.kubeConfigPath, args.context, manifests, binversion.OperatorBinaryVersion.String(), p)
+	if err != nil {
+		return fmt.Errorf("failed to run the checks: %v", err)
+	}
+	result.Checks = checks
+	printChecks(checks, l)
+	if checks.Failed() {
+		return fmt.Errorf("precheck failed, the cluster is not compatible with the install")
+	}
+	l.logAndPrint("✔ Precheck passed.")
+	return nil
+}
+
+func printChecks(checks precheck.Results, l *Logger) {
+	for _, c := range checks {
+		symbol := "✔"
+		switch c.Status {
+		case precheck.StatusWarn:
+			symbol = "!"
+		case precheck.StatusFail:
+			symbol = "✘"
+		}
+		l.logAndPrintf("%s %s: %s", symbol, c.Check, c.Message)
+	}
+}
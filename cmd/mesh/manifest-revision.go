@@ -0,0 +1,127 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/manifest"
+)
+
+type manifestRevisionArgs struct {
+	// kubeConfigPath is the path to kube config file.
+	kubeConfigPath string
+	// context is the cluster context in the kube config
+	context string
+	// revision is the control plane revision to act on.
+	revision string
+	// force retires a revision even if it is the default or still injects namespaces.
+	force bool
+	// skipConfirmation determines whether the user is prompted for confirmation.
+	skipConfirmation bool
+}
+
+func addManifestRevisionFlags(cmd *cobra.Command, args *manifestRevisionArgs) {
+	cmd.PersistentFlags().StringVarP(&args.kubeConfigPath, "kubeconfig", "c", "", "Path to kube config")
+	cmd.PersistentFlags().StringVar(&args.context, "context", "", "The name of the kubeconfig context to use")
+	cmd.PersistentFlags().StringVar(&args.revision, "revision", "", "The control plane revision")
+	cmd.PersistentFlags().BoolVar(&args.skipConfirmation, "skip-confirmation", false, skipConfirmationFlagHelpStr)
+}
+
+func addManifestRetireFlags(cmd *cobra.Command, args *manifestRevisionArgs) {
+	addManifestRevisionFlags(cmd, args)
+	cmd.PersistentFlags().BoolVar(&args.force, "force", false,
+		"Retire the revision even if it is the default revision or namespaces are still labeled with it")
+}
+
+func manifestPromoteCmd(rootArgs *rootArgs, mrArgs *manifestRevisionArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "promote",
+		Short: "Makes a control plane revision the default.",
+		Long: "The promote subcommand points the default sidecar injector, used by namespaces labeled " +
+			"istio-injection=enabled, at the given control plane revision.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			if mrArgs.revision == "" {
+				return fmt.Errorf("--revision must be set")
+			}
+			if !rootArgs.dryRun && !mrArgs.skipConfirmation {
+				if !confirm(fmt.Sprintf("This will make revision %s the default. Proceed? (y/N)", mrArgs.revision),
+					cmd.OutOrStdout()) {
+					cmd.Print("Cancelled.\n")
+					os.Exit(1)
+				}
+			}
+			return manifestPromote(rootArgs, mrArgs, l)
+		}}
+}
+
+func manifestRetireCmd(rootArgs *rootArgs, mrArgs *manifestRevisionArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retire",
+		Short: "Removes a control plane revision from the cluster.",
+		Long:  "The retire subcommand deletes all components of the given control plane revision.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			if mrArgs.revision == "" {
+				return fmt.Errorf("--revision must be set")
+			}
+			if !rootArgs.dryRun && !mrArgs.skipConfirmation {
+				if !confirm(fmt.Sprintf("This will delete revision %s. Proceed? (y/N)", mrArgs.revision),
+					cmd.OutOrStdout()) {
+					cmd.Print("Cancelled.\n")
+					os.Exit(1)
+				}
+			}
+			return manifestRetire(rootArgs, mrArgs, l)
+		}}
+}
+
+func manifestPromote(args *rootArgs, mrArgs *manifestRevisionArgs, l *Logger) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
+	if err := manifest.PromoteRevision(mrArgs.kubeConfigPath, mrArgs.context, mrArgs.revision, args.dryRun); err != nil {
+		return fmt.Errorf("failed to promote revision %s: %s", mrArgs.revision, err)
+	}
+	if !args.dryRun {
+		l.logAndPrintf("Revision %s is now the default.", mrArgs.revision)
+	}
+	return nil
+}
+
+func manifestRetire(args *rootArgs, mrArgs *manifestRevisionArgs, l *Logger) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
+	results, err := manifest.RetireRevision(mrArgs.kubeConfigPath, mrArgs.context, mrArgs.revision, mrArgs.force, args.dryRun)
+	if err != nil {
+		return fmt.Errorf("failed to retire revision %s: %s", mrArgs.revision, err)
+	}
+	if args.dryRun {
+		l.logAndPrintf("Dry run: would delete:\n%s", results)
+		return nil
+	}
+	l.logAndPrint(results.String())
+	if errs := results.Errors(); errs != nil {
+		return fmt.Errorf("failed to retire revision %s: %s", mrArgs.revision, errs)
+	}
+	return nil
+}
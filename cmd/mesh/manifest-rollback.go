@@ -0,0 +1,126 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/manifest"
+)
+
+type manifestRollbackArgs struct {
+	// kubeConfigPath is the path to kube config file.
+	kubeConfigPath string
+	// context is the cluster context in the kube config
+	context string
+	// namespace is the namespace holding the install history.
+	namespace string
+	// revision is the install history revision to roll back to. 0 means the latest revision.
+	revision int
+	// list lists the available revisions instead of rolling back.
+	list bool
+	// skipConfirmation determines whether the user is prompted for confirmation.
+	skipConfirmation bool
+}
+
+func addManifestRollbackFlags(cmd *cobra.Command, args *manifestRollbackArgs) {
+	cmd.PersistentFlags().StringVarP(&args.kubeConfigPath, "kubeconfig", "c", "", "Path to kube config")
+	cmd.PersistentFlags().StringVar(&args.context, "context", "", "The name of the kubeconfig context to use")
+	cmd.PersistentFlags().StringVarP(&args.namespace, "namespace", "n", defaultNamespace,
+		"The namespace where the install history is kept, the same as the Istio control plane namespace")
+	cmd.PersistentFlags().IntVar(&args.revision, "revision", 0, "The install history revision to roll back to. "+
+		"Defaults to the latest revision, which is the state before the last apply")
+	cmd.PersistentFlags().BoolVar(&args.list, "list", false, "List the available revisions")
+	cmd.PersistentFlags().BoolVar(&args.skipConfirmation, "skip-confirmation", false, skipConfirmationFlagHelpStr)
+}
+
+func manifestRollbackCmd(rootArgs *rootArgs, mrArgs *manifestRollbackArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback",
+		Short: "Restores an Istio installation to a previous revision.",
+		Long: "The rollback subcommand restores the Istio components in a cluster to the state recorded before " +
+			"a previous apply or upgrade.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			if !mrArgs.list && !rootArgs.dryRun && !mrArgs.skipConfirmation {
+				if !confirm("This will roll back the Istio installation in the cluster. Proceed? (y/N)", cmd.OutOrStdout()) {
+					cmd.Print("Cancelled.\n")
+					os.Exit(1)
+				}
+			}
+			return manifestRollback(rootArgs, mrArgs, l)
+		}}
+}
+
+func manifestRollback(args *rootArgs, mrArgs *manifestRollbackArgs, l *Logger) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
+	if err := manifest.InitK8SRestClient(mrArgs.kubeConfigPath, mrArgs.context); err != nil {
+		return err
+	}
+	h, err := manifest.NewDefaultHistory(mrArgs.namespace)
+	if err != nil {
+		return err
+	}
+
+	if mrArgs.list {
+		snapshots, err := h.List()
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			l.logAndPrintf("No install history found in namespace %s.", mrArgs.namespace)
+			return nil
+		}
+		for _, s := range snapshots {
+			l.logAndPrintf("%d\t%s\t%s", s.Revision, s.Timestamp.Format(time.RFC3339), snapshotComponentsString(s))
+		}
+		return nil
+	}
+
+	snapshot, err := h.Load(mrArgs.revision)
+	if err != nil {
+		return err
+	}
+	if args.dryRun {
+		l.logAndPrintf("Dry run: would roll back components %s to revision %d.",
+			snapshotComponentsString(snapshot), snapshot.Revision)
+		return nil
+	}
+	return rollbackInstall(mrArgs.namespace, snapshot, l)
+}
+
+// snapshotComponentsString returns a sorted, comma separated list of the components in s.
+func snapshotComponentsString(s *manifest.Snapshot) string {
+	var cs []string
+	for c, objs := range s.Components {
+		if len(objs) != 0 {
+			cs = append(cs, string(c))
+		}
+	}
+	sort.Strings(cs)
+	if len(cs) == 0 {
+		return "<none>"
+	}
+	return strings.Join(cs, ",")
+}
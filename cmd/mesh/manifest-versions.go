@@ -36,11 +36,14 @@ const (
 type manifestVersionsArgs struct {
 	// versionsURI is a URI pointing to a YAML formatted versions mapping.
 	versionsURI string
+	// output is the format the result is printed in, if set.
+	output string
 }
 
 func addManifestVersionsFlags(cmd *cobra.Command, mvArgs *manifestVersionsArgs) {
 	cmd.PersistentFlags().StringVarP(&mvArgs.versionsURI, "versionsURI", "u",
 		versionsMapURL, "URI for operator versions to Istio versions map")
+	addOutputFlag(cmd, &mvArgs.output)
 }
 
 func manifestVersionsCmd(rootArgs *rootArgs, versionsArgs *manifestVersionsArgs) *cobra.Command {
@@ -56,12 +59,17 @@ func manifestVersionsCmd(rootArgs *rootArgs, versionsArgs *manifestVersionsArgs)
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
-			return manifestVersions(rootArgs, versionsArgs, l)
+			if err := validateOutputFormat(versionsArgs.output); err != nil {
+				return err
+			}
+			l := newCommandLogger(rootArgs, versionsArgs.output, cmd)
+			result := &CommandResult{Command: "manifest versions"}
+			err := manifestVersions(rootArgs, versionsArgs, result, l)
+			return writeResult(cmd.OutOrStdout(), versionsArgs.output, result, err)
 		}}
 }
 
-func manifestVersions(args *rootArgs, mvArgs *manifestVersionsArgs, l *Logger) error {
+func manifestVersions(args *rootArgs, mvArgs *manifestVersionsArgs, result *CommandResult, l *Logger) error {
 	initLogsOrExit(args)
 
 	myVersionMap, err := getVersionCompatibleMap(mvArgs.versionsURI, binversion.OperatorBinaryGoVersion, l)
@@ -69,6 +77,17 @@ func manifestVersions(args *rootArgs, mvArgs *manifestVersionsArgs, l *Logger) e
 		return fmt.Errorf("failed to retrieve version map, error: %v", err)
 	}
 
+	result.Versions = &VersionsResult{Operator: binversion.OperatorBinaryGoVersion.String()}
+	for _, v := range myVersionMap.RecommendedIstioVersions {
+		result.Versions.Recommended = append(result.Versions.Recommended, v.String())
+	}
+	for _, v := range myVersionMap.SupportedIstioVersions {
+		result.Versions.Supported = append(result.Versions.Supported, v.String())
+	}
+	if mvArgs.output != "" {
+		return nil
+	}
+
 	fmt.Print("\nOperator version is ", binversion.OperatorBinaryGoVersion.String(), ".\n\n")
 	fmt.Println("The following installation package versions are recommended for use with this version of the operator:")
 	for _, v := range myVersionMap.RecommendedIstioVersions {
@@ -23,7 +23,8 @@ func ManifestCmd() *cobra.Command {
 	mc := &cobra.Command{
 		Use:   "manifest",
 		Short: "Commands related to Istio manifests",
-		Long:  "The manifest subcommand generates, applies, diffs or migrates Istio manifests.",
+		Long: "The manifest subcommand generates, applies, diffs, migrates, bundles or rolls back Istio manifests, " +
+			"applies them to multi-cluster meshes, checks clusters before an install, and promotes or retires control plane revisions.",
 	}
 
 	mgcArgs := &manifestGenerateArgs{}
@@ -31,6 +32,12 @@ func ManifestCmd() *cobra.Command {
 	macArgs := &manifestApplyArgs{}
 	mvArgs := &manifestVersionsArgs{}
 	mmcArgs := &manifestMigrateArgs{}
+	mrcArgs := &manifestRollbackArgs{}
+	mpcArgs := &manifestRevisionArgs{}
+	mrtcArgs := &manifestRevisionArgs{}
+	mbcArgs := &manifestBundleArgs{}
+	mamcArgs := &manifestApplyMultiArgs{}
+	mpccArgs := &manifestPrecheckArgs{}
 
 	args := &rootArgs{}
 
@@ -39,6 +46,12 @@ func ManifestCmd() *cobra.Command {
 	mac := manifestApplyCmd(args, macArgs)
 	mvc := manifestVersionsCmd(args, mvArgs)
 	mmc := manifestMigrateCmd(args, mmcArgs)
+	mrc := manifestRollbackCmd(args, mrcArgs)
+	mpc := manifestPromoteCmd(args, mpcArgs)
+	mrtc := manifestRetireCmd(args, mrtcArgs)
+	mbc := manifestBundleCmd(args, mbcArgs)
+	mamc := manifestApplyMultiCmd(args, mamcArgs)
+	mpcc := manifestPrecheckCmd(args, mpccArgs)
 
 	addFlags(mc, args)
 	addFlags(mgc, args)
@@ -46,18 +59,36 @@ func ManifestCmd() *cobra.Command {
 	addFlags(mac, args)
 	addFlags(mvc, args)
 	addFlags(mmc, args)
+	addFlags(mrc, args)
+	addFlags(mpc, args)
+	addFlags(mrtc, args)
+	addFlags(mbc, args)
+	addFlags(mamc, args)
+	addFlags(mpcc, args)
 
 	addManifestGenerateFlags(mgc, mgcArgs)
 	addManifestDiffFlags(mdc, mdcArgs)
 	addManifestApplyFlags(mac, macArgs)
 	addManifestVersionsFlags(mvc, mvArgs)
 	addManifestMigrateFlags(mmc, mmcArgs)
+	addManifestRollbackFlags(mrc, mrcArgs)
+	addManifestRevisionFlags(mpc, mpcArgs)
+	addManifestRetireFlags(mrtc, mrtcArgs)
+	addManifestBundleFlags(mbc, mbcArgs)
+	addManifestApplyMultiFlags(mamc, mamcArgs)
+	addManifestPrecheckFlags(mpcc, mpccArgs)
 
 	mc.AddCommand(mgc)
 	mc.AddCommand(mdc)
 	mc.AddCommand(mac)
 	mc.AddCommand(mmc)
 	mc.AddCommand(mvc)
+	mc.AddCommand(mrc)
+	mc.AddCommand(mpc)
+	mc.AddCommand(mrtc)
+	mc.AddCommand(mbc)
+	mc.AddCommand(mamc)
+	mc.AddCommand(mpcc)
 
 	return mc
 }
@@ -55,6 +55,8 @@ type operatorInitArgs struct {
 	readinessTimeout time.Duration
 	// wait is flag that indicates whether to wait resources ready before exiting.
 	wait bool
+	// output is the format the result is printed in, if set.
+	output string
 }
 
 const (
@@ -92,6 +94,7 @@ func addOperatorInitFlags(cmd *cobra.Command, args *operatorInitArgs) {
 		"The namespace the operator controller is installed into")
 	cmd.PersistentFlags().StringVar(&args.istioNamespace, "istioNamespace", "istio-system",
 		"The namespace Istio is installed into")
+	addOutputFlag(cmd, &args.output)
 }
 
 func operatorInitCmd(rootArgs *rootArgs, oiArgs *operatorInitArgs) *cobra.Command {
@@ -101,14 +104,24 @@ func operatorInitCmd(rootArgs *rootArgs, oiArgs *operatorInitArgs) *cobra.Comman
 		Long:  "The init subcommand installs the Istio operator controller in the cluster.",
 		Args:  cobra.ExactArgs(0),
 		Run: func(cmd *cobra.Command, args []string) {
-			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.OutOrStderr())
-			operatorInit(rootArgs, oiArgs, l, defaultManifestApplier)
+			l := newCommandLogger(rootArgs, oiArgs.output, cmd)
+			if err := validateOutputFormat(oiArgs.output); err != nil {
+				l.logAndFatal(err)
+			}
+			result := &CommandResult{Command: "operator init"}
+			err := operatorInit(rootArgs, oiArgs, l, defaultManifestApplier, result)
+			if err := writeResult(cmd.OutOrStdout(), oiArgs.output, result, err); err != nil {
+				l.logAndFatal(err)
+			}
 		}}
 }
 
-// operatorInit installs the Istio operator controller into the cluster.
-func operatorInit(args *rootArgs, oiArgs *operatorInitArgs, l *Logger, apply manifestApplier) {
-	initLogsOrExit(args)
+// operatorInit installs the Istio operator controller into the cluster. The result of each component is recorded in
+// result.
+func operatorInit(args *rootArgs, oiArgs *operatorInitArgs, l *Logger, apply manifestApplier, result *CommandResult) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
 
 	// Error here likely indicates Deployment is missing. If some other K8s error, we will hit it again later.
 	already, _ := isControllerInstalled(oiArgs.kubeConfigPath, oiArgs.context, oiArgs.operatorNamespace)
@@ -120,7 +133,7 @@ func operatorInit(args *rootArgs, oiArgs *operatorInitArgs, l *Logger, apply man
 
 	mstr, err := renderOperatorManifest(args, oiArgs, l)
 	if err != nil {
-		l.logAndFatal(err)
+		return err
 	}
 
 	log.Infof("Using the following manifest to install operator:\n%s\n", mstr)
@@ -128,7 +141,7 @@ func operatorInit(args *rootArgs, oiArgs *operatorInitArgs, l *Logger, apply man
 	// If CR was passed, we must create a namespace for it and install CR into it.
 	customResource, istioNamespace, err := getCRAndNamespaceFromFile(oiArgs.inFilename, l)
 	if err != nil {
-		l.logAndFatal(err)
+		return err
 	}
 
 	opts := &kubectlcmd.Options{
@@ -140,22 +153,28 @@ func operatorInit(args *rootArgs, oiArgs *operatorInitArgs, l *Logger, apply man
 	}
 
 	if err := manifest.InitK8SRestClient(opts.Kubeconfig, opts.Context); err != nil {
-		l.logAndFatal(err)
+		return err
 	}
 
-	success := apply(mstr, istioControllerComponentName, opts, args.verbose, l)
+	applyComponent := func(manifestStr, componentName string) bool {
+		ok := apply(manifestStr, componentName, opts, args.verbose, l)
+		result.Components = append(result.Components, newComponentResult(componentName, ComponentStatusApplied, ok))
+		return ok
+	}
+	success := applyComponent(mstr, istioControllerComponentName)
 
 	if customResource != "" {
-		success = success && apply(genNamespaceResource(istioNamespace), istioNamespaceComponentName, opts, args.verbose, l)
-		success = success && apply(customResource, istioOperatorCRComponentName, opts, args.verbose, l)
+		success = success && applyComponent(genNamespaceResource(istioNamespace), istioNamespaceComponentName)
+		success = success && applyComponent(customResource, istioOperatorCRComponentName)
 	}
 
 	if !success {
 		l.logAndPrint("\n*** Errors were logged during apply operation. Please check component installation logs above. ***\n")
-		return
+		return fmt.Errorf("errors were logged during apply operation")
 	}
 
 	l.logAndPrint("\n*** Success. ***\n")
+	return nil
 }
 
 func applyManifest(manifestStr, componentName string, opts *kubectlcmd.Options, verbose bool, l *Logger) bool {
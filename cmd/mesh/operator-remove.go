@@ -51,23 +51,33 @@ func operatorRemoveCmd(rootArgs *rootArgs, orArgs *operatorRemoveArgs) *cobra.Co
 		Long:  "The remove subcommand removes the Istio operator controller from the cluster.",
 		Args:  cobra.ExactArgs(0),
 		Run: func(cmd *cobra.Command, args []string) {
-			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.OutOrStderr())
-			operatorRemove(rootArgs, orArgs, l, defaultManifestDeleter)
+			l := newCommandLogger(rootArgs, orArgs.output, cmd)
+			if err := validateOutputFormat(orArgs.output); err != nil {
+				l.logAndFatal(err)
+			}
+			result := &CommandResult{Command: "operator remove"}
+			err := operatorRemove(rootArgs, orArgs, l, defaultManifestDeleter, result)
+			if err := writeResult(cmd.OutOrStdout(), orArgs.output, result, err); err != nil {
+				l.logAndFatal(err)
+			}
 		}}
 }
 
-// operatorRemove removes the Istio operator controller from the cluster.
-func operatorRemove(args *rootArgs, orArgs *operatorRemoveArgs, l *Logger, deleteManifestFunc manifestDeleter) {
-	initLogsOrExit(args)
+// operatorRemove removes the Istio operator controller from the cluster. The result is recorded in result.
+func operatorRemove(args *rootArgs, orArgs *operatorRemoveArgs, l *Logger, deleteManifestFunc manifestDeleter,
+	result *CommandResult) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
 
 	installed, err := isControllerInstalled(orArgs.kubeConfigPath, orArgs.context, orArgs.operatorNamespace)
 	if installed && err != nil {
-		l.logAndFatal(err)
+		return err
 	}
 	if !installed {
 		l.logAndPrintf("Operator controller is not installed in %s namespace (no Deployment detected).", orArgs.operatorNamespace)
 		if !orArgs.force {
-			l.logAndFatal("Aborting, use --force to override.")
+			return fmt.Errorf("aborting, use --force to override")
 		}
 	}
 
@@ -75,7 +85,7 @@ func operatorRemove(args *rootArgs, orArgs *operatorRemoveArgs, l *Logger, delet
 
 	mstr, err := renderOperatorManifest(args, &orArgs.operatorInitArgs, l)
 	if err != nil {
-		l.logAndFatal(err)
+		return err
 	}
 
 	log.Infof("Using the following manifest to install operator:\n%s\n", mstr)
@@ -89,16 +99,18 @@ func operatorRemove(args *rootArgs, orArgs *operatorRemoveArgs, l *Logger, delet
 	}
 
 	if err := manifest.InitK8SRestClient(opts.Kubeconfig, opts.Context); err != nil {
-		l.logAndFatal(err)
+		return err
 	}
 
 	success := deleteManifestFunc(mstr, "Operator", opts, l)
+	result.Components = append(result.Components, newComponentResult("Operator", ComponentStatusDeleted, success))
 	if !success {
 		l.logAndPrint("\n*** Errors were logged during deleteManifestFunc operation. Please check logs above. ***\n")
-		return
+		return fmt.Errorf("errors were logged during delete operation")
 	}
 
 	l.logAndPrint("\n*** Success. ***\n")
+	return nil
 }
 
 func deleteManifest(manifestStr, componentName string, opts *kubectlcmd.Options, l *Logger) bool {
@@ -52,7 +52,10 @@ func TestOperatorInit(t *testing.T) {
 		istioNamespace:    "istio-test-namespace",
 	}
 
-	operatorInit(rootArgs, oiArgs, NewLogger(rootArgs.logToStdErr, os.Stdout, os.Stderr), mockApplyManifest)
+	if err := operatorInit(rootArgs, oiArgs, NewLogger(rootArgs.logToStdErr, os.Stdout, os.Stderr), mockApplyManifest,
+		&CommandResult{}); err != nil {
+		t.Fatal(err)
+	}
 	gotYAML := ""
 	for _, ao := range applyOutput {
 		gotYAML += ao.manifest
@@ -129,7 +132,10 @@ func TestOperatorRemove(t *testing.T) {
 		force: true,
 	}
 
-	operatorRemove(rootArgs, orArgs, NewLogger(rootArgs.logToStdErr, os.Stdout, os.Stderr), mockDeleteManifest)
+	if err := operatorRemove(rootArgs, orArgs, NewLogger(rootArgs.logToStdErr, os.Stdout, os.Stderr), mockDeleteManifest,
+		&CommandResult{}); err != nil {
+		t.Fatal(err)
+	}
 	gotYAML := deleteOutput
 
 	fmt.Println(gotYAML)
@@ -0,0 +1,215 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/compare"
+	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/precheck"
+)
+
+const (
+	// outputJSON and outputYAML are the supported values of the --output flag.
+	outputJSON = "json"
+	outputYAML = "yaml"
+
+	outputFlagHelpStr = `Print the result of the command in a machine-readable format, either json or yaml. ` +
+		`All other output is sent to stderr.`
+)
+
+// ComponentStatus is the outcome of a command for a single component.
+type ComponentStatus string
+
+const (
+	// ComponentStatusApplied means all objects of the component were applied.
+	ComponentStatusApplied ComponentStatus = "applied"
+	// ComponentStatusDeleted means all objects of the component were deleted.
+	ComponentStatusDeleted ComponentStatus = "deleted"
+	// ComponentStatusSkipped means the component is disabled and was neither applied nor pruned.
+	ComponentStatusSkipped ComponentStatus = "skipped"
+	// ComponentStatusFailed means errors were returned for the component.
+	ComponentStatusFailed ComponentStatus = "failed"
+)
+
+// CommandResult is the result of a mesh command, printed to stdout when --output is set. Only the fields which apply
+// to the command are set.
+type CommandResult struct {
+	// Command is the name of the command, e.g. "manifest apply".
+	Command string `json:"command"`
+	// Success is false if the command failed.
+	Success bool `json:"success"`
+	// Error is the error which caused the command to fail.
+	Error string `json:"error,omitempty"`
+	// Components holds the result for each component applied or deleted by the command.
+	Components []*ComponentResult `json:"components,omitempty"`
+	// Diff is the result of manifest diff.
+	Diff *DiffResult `json:"diff,omitempty"`
+	// Versions holds the versions reported by manifest versions and upgrade.
+	Versions *VersionsResult `json:"versions,omitempty"`
+	// Profiles holds the profiles listed by profile list.
+	Profiles []string `json:"profiles,omitempty"`
+	// Checks holds the results of manifest precheck.
+	Checks precheck.Results `json:"checks,omitempty"`
+	// Hooks holds the results of the hooks run by upgrade.
+	Hooks precheck.Results `json:"hooks,omitempty"`
+}
+
+// ComponentResult is the result of a command for a single component.
+type ComponentResult struct {
+	Name   string          `json:"name"`
+	Status ComponentStatus `json:"status"`
+	// Objects holds the result for each object of the component. It is only set when the built-in server-side apply
+	// client is used.
+	Objects []*ObjectResult `json:"objects,omitempty"`
+	Errors  []string        `json:"errors,omitempty"`
+}
+
+// ObjectResult is the result of a command for a single object.
+type ObjectResult struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Result is one of created, configured, unchanged, deleted, skipped, protected, would be pruned or failed.
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// DiffResult is the result of manifest diff. With --cluster, A is the generated manifest and B the live objects, so
+// the objects only in A would be created, and the objects only in B would be pruned.
+type DiffResult struct {
+	Identical bool     `json:"identical"`
+	Diff      string   `json:"diff,omitempty"`
+	OnlyInA   []string `json:"onlyInA,omitempty"`
+	OnlyInB   []string `json:"onlyInB,omitempty"`
+	Changed   []string `json:"changed,omitempty"`
+}
+
+// VersionsResult holds the versions reported by a command.
+type VersionsResult struct {
+	// Operator is the version of the operator binary.
+	Operator string `json:"operator,omitempty"`
+	// Recommended and Supported are the installation package versions recommended for use or supported for upgrade.
+	Recommended []string `json:"recommended,omitempty"`
+	Supported   []string `json:"supported,omitempty"`
+	// Current and Target are the control plane versions before and after an upgrade.
+	Current string `json:"current,omitempty"`
+	Target  string `json:"target,omitempty"`
+}
+
+func addOutputFlag(cmd *cobra.Command, output *string) {
+	cmd.PersistentFlags().StringVarP(output, "output", "o", "", outputFlagHelpStr)
+}
+
+func validateOutputFormat(format string) error {
+	switch format {
+	case "", outputJSON, outputYAML:
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q, must be one of %s or %s", format, outputJSON, outputYAML)
+}
+
+// newCommandLogger returns the logger for cmd. If an output format is set, stdout only holds the result of the
+// command, so all other output, including the progress output of applies, is sent to stderr.
+func newCommandLogger(rootArgs *rootArgs, format string, cmd *cobra.Command) *Logger {
+	if format == "" {
+		return NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+	}
+	manifest.SetProgressWriter(cmd.ErrOrStderr())
+	return NewLogger(rootArgs.logToStdErr, cmd.ErrOrStderr(), cmd.ErrOrStderr())
+}
+
+// writeResult completes result with err and prints it to w in format. err is returned so that the command still
+// fails. It is a no-op returning err if no format is set.
+func writeResult(w io.Writer, format string, result *CommandResult, err error) error {
+	if format == "" {
+		return err
+	}
+	result.Success = err == nil
+	if err != nil {
+		result.Error = err.Error()
+	}
+	var b []byte
+	var merr error
+	if format == outputJSON {
+		b, merr = json.MarshalIndent(result, "", "  ")
+		b = append(b, '\n')
+	} else {
+		b, merr = yaml.Marshal(result)
+	}
+	if merr != nil {
+		return fmt.Errorf("could not marshal the result: %s", merr)
+	}
+	if _, werr := w.Write(b); werr != nil {
+		return werr
+	}
+	return err
+}
+
+// componentResults returns the results for the components in out, sorted by name.
+func componentResults(out manifest.CompositeOutput, skipped map[name.ComponentName]bool) []*ComponentResult {
+	var results []*ComponentResult
+	for cn, o := range out {
+		r := &ComponentResult{Name: string(cn), Status: ComponentStatusApplied}
+		switch {
+		case o.Err != nil:
+			r.Status = ComponentStatusFailed
+			r.Errors = append(r.Errors, o.Err.Error())
+		case skipped[cn]:
+			r.Status = ComponentStatusSkipped
+		}
+		if !ignoreError(o.Stderr) {
+			r.Status = ComponentStatusFailed
+			r.Errors = append(r.Errors, o.Stderr)
+		}
+		for _, or := range o.Objects {
+			res := &ObjectResult{Kind: or.Kind, Namespace: or.Namespace, Name: or.Name, Result: string(or.Result)}
+			if or.Err != nil {
+				res.Error = or.Err.Error()
+			}
+			r.Objects = append(r.Objects, res)
+		}
+		results = append(results, r)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return results
+}
+
+// newComponentResult returns the result of a component which has status if ok, and failed otherwise. The errors are
+// only logged.
+func newComponentResult(componentName string, status ComponentStatus, ok bool) *ComponentResult {
+	if !ok {
+		status = ComponentStatusFailed
+	}
+	return &ComponentResult{Name: componentName, Status: status}
+}
+
+func newDiffResult(diff string, summary *compare.ManifestDiffSummary) *DiffResult {
+	return &DiffResult{
+		Identical: diff == "",
+		Diff:      diff,
+		OnlyInA:   summary.OnlyInA,
+		OnlyInB:   summary.OnlyInB,
+		Changed:   summary.Changed,
+	}
+}
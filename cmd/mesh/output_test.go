@@ -0,0 +1,117 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/util"
+)
+
+func TestWriteResult(t *testing.T) {
+	out := manifest.CompositeOutput{
+		name.PilotComponentName: {
+			Objects: manifest.ObjectApplyResults{
+				{Kind: "ConfigMap", Namespace: "istio-system", Name: "istio", Result: manifest.ApplyResultCreated},
+			},
+		},
+		name.PolicyComponentName: {Err: fmt.Errorf("timed out")},
+		name.CNIComponentName:    {},
+	}
+	result := &CommandResult{
+		Command:    "manifest apply",
+		Components: componentResults(out, map[name.ComponentName]bool{name.CNIComponentName: true}),
+	}
+	want := `
+command: manifest apply
+success: false
+error: errors were logged during apply operation
+components:
+- name: Cni
+  status: skipped
+- name: Pilot
+  status: applied
+  objects:
+  - kind: ConfigMap
+    namespace: istio-system
+    name: istio
+    result: created
+- name: Policy
+  status: failed
+  errors:
+  - timed out
+`
+	var b bytes.Buffer
+	err := writeResult(&b, outputYAML, result, fmt.Errorf("errors were logged during apply operation"))
+	if err == nil {
+		t.Error("got no error, want the error of the command")
+	}
+	if diff := util.YAMLDiff(want, b.String()); diff != "" {
+		t.Errorf("got result with diffs:\n%s", diff)
+	}
+
+	b.Reset()
+	if err := writeResult(&b, "", result, nil); err != nil {
+		t.Fatal(err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("got output %s without an output format", b.String())
+	}
+}
+
+func TestValidateOutputFormat(t *testing.T) {
+	for _, f := range []string{"", outputJSON, outputYAML} {
+		if err := validateOutputFormat(f); err != nil {
+			t.Errorf("got error %v for format %q", err, f)
+		}
+	}
+	if err := validateOutputFormat("table"); err == nil {
+		t.Error("got no error for unknown format table")
+	}
+}
+
+func TestManifestApplyCancelledResult(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(old *os.File) { os.Stdin = old }(os.Stdin)
+	os.Stdin = r
+	if _, err := w.WriteString("n\n"); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	cmd := manifestApplyCmd(&rootArgs{}, &manifestApplyArgs{output: outputJSON})
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	if err := cmd.RunE(cmd, nil); err == nil {
+		t.Error("got no error for a cancelled apply, want one")
+	}
+	result := &CommandResult{}
+	if err := json.Unmarshal(stdout.Bytes(), result); err != nil {
+		t.Fatalf("could not decode the result %q: %s", stdout, err)
+	}
+	if result.Success || result.Error == "" {
+		t.Errorf("got result %+v, want a failed result with an error", result)
+	}
+}
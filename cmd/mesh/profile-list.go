@@ -22,22 +22,40 @@ import (
 	"istio.io/operator/pkg/helm"
 )
 
-func profileListCmd(rootArgs *rootArgs) *cobra.Command {
+type profileListArgs struct {
+	// output is the format the result is printed in, if set.
+	output string
+}
+
+func addProfileListFlags(cmd *cobra.Command, plArgs *profileListArgs) {
+	addOutputFlag(cmd, &plArgs.output)
+}
+
+func profileListCmd(rootArgs *rootArgs, plArgs *profileListArgs) *cobra.Command {
 	return &cobra.Command{
 		Use:   "list",
 		Short: "Lists available Istio configuration profiles",
 		Long:  "The list subcommand lists the available Istio configuration profiles.",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return profileList(rootArgs)
+			if err := validateOutputFormat(plArgs.output); err != nil {
+				return err
+			}
+			result := &CommandResult{Command: "profile list"}
+			err := profileList(rootArgs, plArgs, result)
+			return writeResult(cmd.OutOrStdout(), plArgs.output, result, err)
 		}}
 
 }
 
 // profileList list all the builtin profiles.
-func profileList(args *rootArgs) error {
+func profileList(args *rootArgs, plArgs *profileListArgs, result *CommandResult) error {
 	initLogsOrExit(args)
 	profiles := helm.ListBuiltinProfiles()
+	result.Profiles = profiles
+	if plArgs.output != "" {
+		return nil
+	}
 	if len(profiles) == 0 {
 		fmt.Println("No profiles available.")
 	} else {
@@ -27,9 +27,10 @@ func ProfileCmd() *cobra.Command {
 	}
 
 	pdArgs := &profileDumpArgs{}
+	plArgs := &profileListArgs{}
 	args := &rootArgs{}
 
-	plc := profileListCmd(args)
+	plc := profileListCmd(args, plArgs)
 	pdc := profileDumpCmd(args, pdArgs)
 	pdfc := profileDiffCmd(args)
 
@@ -39,6 +40,7 @@ func ProfileCmd() *cobra.Command {
 	addFlags(pdfc, args)
 
 	addProfileDumpFlags(pdc, pdArgs)
+	addProfileListFlags(plc, plArgs)
 
 	pc.AddCommand(plc)
 	pc.AddCommand(pdc)
@@ -19,6 +19,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"istio.io/operator/pkg/helm"
 	binversion "istio.io/operator/version"
 	"istio.io/pkg/version"
 )
@@ -29,9 +30,28 @@ Overrides the corresponding path value in the selected profile or passed through
 customization file`
 	skipConfirmationFlagHelpStr = `skipConfirmation determines whether the user is prompted for confirmation. 
 If set to true, the user is not prompted and a Yes response is assumed in all cases.`
-	filenameFlagHelpStr = `Path to file containing IstioOperator CustomResource`
+	filenameFlagHelpStr         = `Path to file containing IstioOperator CustomResource`
+	useKubectlFlagHelpStr       = `Apply manifests by running kubectl instead of using the built-in server-side apply client`
+	revisionFlagHelpStr         = `Control plane revision to install next to existing ones, e.g. canary. Sets values.revision`
+	serverDryRunFlagHelpStr     = `Validate all objects with server-side dry-run and report the result for each object, without persisting them`
+	prunePreviewFlagHelpStr     = `Apply manifests, but only report the objects which would be pruned instead of deleting them`
+	maxPruneFlagHelpStr         = `Maximum number of objects pruned per component. If more would be pruned, none is and the apply fails. 0 means no limit`
+	verificationKeysFlagHelpStr = `Path to a file or directory of PEM encoded public keys used to verify the signatures of ` +
+		`downloaded installation packages. If set, unsigned packages and packages not signed by one of the keys are refused`
+	insecureSkipVerificationFlagHelpStr = `Use downloaded installation packages without verifying their signatures, even if ` +
+		`--verification-keys is set`
+	chartCacheDirFlagHelpStr = `Directory where charts fetched from OCI registries and Helm repositories are ` +
+		`cached. It is created with mode 0700 and must not be accessible by other users`
 )
 
+// packageVerificationArgs holds the flags controlling signature verification of downloaded installation packages.
+var packageVerificationArgs struct {
+	// keysPath is the path to the trusted public keys.
+	keysPath string
+	// insecure skips signature verification.
+	insecure bool
+}
+
 type rootArgs struct {
 	// logToStdErr controls whether logs are sent to stderr.
 	logToStdErr bool
@@ -61,6 +81,11 @@ func GetRootCmd(args []string) *cobra.Command {
 	}
 	rootCmd.SetArgs(args)
 	rootCmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+	rootCmd.PersistentFlags().StringVar(&packageVerificationArgs.keysPath, "verification-keys", "",
+		verificationKeysFlagHelpStr)
+	rootCmd.PersistentFlags().BoolVar(&packageVerificationArgs.insecure, "insecure-skip-package-verification", false,
+		insecureSkipVerificationFlagHelpStr)
+	rootCmd.PersistentFlags().StringVar(&helm.ChartCacheDir, "chart-cache-dir", helm.ChartCacheDir, chartCacheDirFlagHelpStr)
 
 	rootCmd.AddCommand(ManifestCmd())
 	rootCmd.AddCommand(ProfileCmd())
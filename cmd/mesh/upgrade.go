@@ -17,7 +17,6 @@ package mesh
 import (
 	"fmt"
 	"io/ioutil"
-	"os"
 	"strings"
 	"time"
 
@@ -27,6 +26,8 @@ import (
 	"istio.io/operator/pkg/compare"
 	"istio.io/operator/pkg/hooks"
 	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/revision"
 	opversion "istio.io/operator/version"
 	"istio.io/pkg/log"
 )
@@ -64,6 +65,14 @@ type upgradeArgs struct {
 	skipConfirmation bool
 	// force means directly applying the upgrade without eligibility checks.
 	force bool
+	// useKubectl applies manifests with kubectl instead of the built-in server-side apply client.
+	useKubectl bool
+	// hooksFile is the path to a YAML file of upgrade hooks, which are run after the built-in hooks.
+	hooksFile string
+	// output is the format the result is printed in, if set.
+	output string
+	// prune controls how objects which are no longer rendered are pruned.
+	prune pruneArgs
 }
 
 // addUpgradeFlags adds upgrade related flags into cobra command
@@ -82,9 +91,14 @@ func addUpgradeFlags(cmd *cobra.Command, args *upgradeArgs) {
 		"Wait, if set will wait until all Pods, Services, and minimum number of Pods "+
 			"of a Deployment are in a ready state before the command exits. "+
 			"It will wait for a maximum duration of "+(upgradeWaitSecCheckVerPerLoop*
-			upgradeWaitCheckVerMaxAttempts).String())
+			upgradeWaitCheckVerMaxAttempts).String()+". The command always waits before running post-upgrade hooks")
 	cmd.PersistentFlags().BoolVar(&args.force, "force", false,
-		"Apply the upgrade without eligibility checks")
+		"Apply the upgrade without eligibility checks, even if upgrade hooks fail")
+	cmd.PersistentFlags().BoolVar(&args.useKubectl, "use-kubectl", false, useKubectlFlagHelpStr)
+	cmd.PersistentFlags().StringVar(&args.hooksFile, "hooks", "",
+		"Path to a YAML file of upgrade hooks, which are run after the built-in hooks")
+	addOutputFlag(cmd, &args.output)
+	addPruneFlags(cmd, &args.prune)
 }
 
 // Upgrade command upgrades Istio control plane in-place with eligibility checks
@@ -99,13 +113,16 @@ func UpgradeCmd() *cobra.Command {
 			"traffic may be disrupted during upgrade. Please ensure PodDisruptionBudgets " +
 			"are defined to maintain service continuity.",
 		RunE: func(cmd *cobra.Command, args []string) (e error) {
-			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.OutOrStderr())
-			initLogsOrExit(rootArgs)
-			err := upgrade(rootArgs, macArgs, l)
+			if err := validateOutputFormat(macArgs.output); err != nil {
+				return err
+			}
+			l := newCommandLogger(rootArgs, macArgs.output, cmd)
+			result := &CommandResult{Command: "upgrade"}
+			err := upgrade(rootArgs, macArgs, result, l)
 			if err != nil {
 				log.Infof("Error: %v\n", err)
 			}
-			return err
+			return writeResult(cmd.OutOrStdout(), macArgs.output, result, err)
 		},
 	}
 	addFlags(cmd, rootArgs)
@@ -113,8 +130,12 @@ func UpgradeCmd() *cobra.Command {
 	return cmd
 }
 
-// upgrade is the main function for Upgrade command
-func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
+// upgrade is the main function for Upgrade command. The versions and the result of each component are recorded in
+// result.
+func upgrade(rootArgs *rootArgs, args *upgradeArgs, result *CommandResult, l *Logger) (err error) {
+	if err := configLogs(rootArgs.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
 	args.inFilename = strings.TrimSpace(args.inFilename)
 
 	// Generate IOPS objects
@@ -125,6 +146,7 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 
 	// Get the target version from the tag in the IOPS
 	targetVersion := targetIOPS.GetTag()
+	result.Versions = &VersionsResult{Target: targetVersion}
 	if targetVersion != opversion.OperatorVersionString {
 		if !args.force {
 			return fmt.Errorf("the target version %v is not supported by istioctl %v, "+
@@ -133,6 +155,11 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 		}
 	}
 
+	registry, err := loadHookRegistry(args.hooksFile)
+	if err != nil {
+		return err
+	}
+
 	// Create a kube client from args.kubeConfigPath and  args.context
 	kubeClient, err := manifest.NewClient(args.kubeConfigPath, args.context)
 	if err != nil {
@@ -148,6 +175,7 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 	if err != nil && !args.force {
 		return fmt.Errorf("failed to read the current Istio version, error: %v", err)
 	}
+	result.Versions.Current = currentVersion
 
 	// Check if the upgrade currentVersion -> targetVersion is supported
 	err = checkSupportedVersions(currentVersion, targetVersion, args.versionsURI, l)
@@ -177,31 +205,75 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 	}
 	checkUpgradeIOPS(currentIOPSYaml, targetIOPSYaml, overrideIOPSYaml, l)
 
-	waitForConfirmation(args.skipConfirmation, l)
+	if err := waitForConfirmation(args.skipConfirmation, l); err != nil {
+		return err
+	}
 
 	// Run pre-upgrade hooks
 	hparams := &hooks.HookCommonParams{
-		SourceVer:  currentVersion,
-		TargetVer:  targetVersion,
-		SourceIOPS: targetIOPS,
-		TargetIOPS: targetIOPS,
+		SourceVer:      currentVersion,
+		TargetVer:      targetVersion,
+		SourceIOPS:     targetIOPS,
+		TargetIOPS:     targetIOPS,
+		IstioNamespace: istioNamespace,
+	}
+	checks, err := registry.RunPreUpgradeHooks(kubeClient, hparams, rootArgs.dryRun)
+	if err != nil && !args.force {
+		return fmt.Errorf("failed in pre-upgrade hooks, error: %v", err)
 	}
-	errs := hooks.RunPreUpgradeHooks(kubeClient, hparams, rootArgs.dryRun)
-	if len(errs) != 0 && !args.force {
-		return fmt.Errorf("failed in pre-upgrade hooks, error: %v", errs.ToError())
+	result.Hooks = append(result.Hooks, checks...)
+	printChecks(checks, l)
+	if checks.Failed() && !args.force {
+		return fmt.Errorf("pre-upgrade hooks failed, fix the failed checks or use --force to upgrade anyway")
 	}
 
 	// Apply the Istio Control Plane specs reading from inFilename to the cluster
-	err = genApplyManifests(nil, args.inFilename, args.force, rootArgs.dryRun,
-		rootArgs.verbose, args.kubeConfigPath, args.context, args.wait, upgradeWaitSecWhenApply, l)
+	var snapshot *manifest.Snapshot
+	result.Components, snapshot, err = genApplyManifests(&applyManifestsArgs{
+		inFilename:     args.inFilename,
+		force:          args.force,
+		dryRun:         rootArgs.dryRun,
+		verbose:        rootArgs.verbose,
+		kubeConfigPath: args.kubeConfigPath,
+		context:        args.context,
+		wait:           args.wait,
+		waitTimeout:    upgradeWaitSecWhenApply,
+		useKubectl:     args.useKubectl,
+		prune:          args.prune,
+	}, l)
 	if err != nil {
 		return fmt.Errorf("failed to apply the Istio Control Plane specs. Error: %v", err)
 	}
 
+	// The post-upgrade hooks check and convert the state of the upgraded control plane, so the rollout must be
+	// complete before they run, even without --wait. Errors of the hooks are returned when they are run.
+	hasPostHooks, _ := registry.HasPostUpgradeHooks(hparams)
+	if args.wait || (hasPostHooks && !rootArgs.dryRun) {
+		// Waits for the upgrade to complete by periodically comparing the each
+		// component version to the target version.
+		err = waitUpgradeComplete(kubeClient, istioNamespace, targetVersion, l)
+		if err != nil {
+			historyNamespace, nerr := name.Namespace(name.IstioBaseComponentName, targetIOPS)
+			if snapshot == nil || nerr != nil {
+				return fmt.Errorf("failed to wait for the upgrade to complete. Error: %v", err)
+			}
+			// Roll back automatically so that the control plane is not left on a mix of versions.
+			if rerr := rollbackInstall(historyNamespace, snapshot, l); rerr != nil {
+				return fmt.Errorf("failed to wait for the upgrade to complete, and failed to roll back. Error: %v, %v", err, rerr)
+			}
+			return fmt.Errorf("failed to wait for the upgrade to complete, rolled back to %v. Error: %v", currentVersion, err)
+		}
+	}
+
 	// Run post-upgrade hooks
-	errs = hooks.RunPostUpgradeHooks(kubeClient, hparams, rootArgs.dryRun)
-	if len(errs) != 0 && !args.force {
-		return fmt.Errorf("failed in post-upgrade hooks, error: %v", errs.ToError())
+	checks, err = registry.RunPostUpgradeHooks(kubeClient, hparams, rootArgs.dryRun)
+	if err != nil && !args.force {
+		return fmt.Errorf("failed in post-upgrade hooks, error: %v", err)
+	}
+	result.Hooks = append(result.Hooks, checks...)
+	printChecks(checks, l)
+	if checks.Failed() && !args.force {
+		return fmt.Errorf("post-upgrade hooks failed, fix the failed checks or use --force to ignore them")
 	}
 
 	if !args.wait {
@@ -210,13 +282,6 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 		return nil
 	}
 
-	// Waits for the upgrade to complete by periodically comparing the each
-	// component version to the target version.
-	err = waitUpgradeComplete(kubeClient, istioNamespace, targetVersion, l)
-	if err != nil {
-		return fmt.Errorf("failed to wait for the upgrade to complete. Error: %v", err)
-	}
-
 	// Read the upgraded Istio version from the the cluster
 	upgradeVer, err := retrieveControlPlaneVersion(kubeClient, istioNamespace, l)
 	if err != nil {
@@ -228,6 +293,27 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 	return nil
 }
 
+// loadHookRegistry returns the built-in upgrade hooks, followed by the hooks in hooksFile if it is set.
+func loadHookRegistry(hooksFile string) (*hooks.Registry, error) {
+	registry, err := hooks.DefaultRegistry()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the built-in upgrade hooks, error: %v", err)
+	}
+	if hooksFile == "" {
+		return registry, nil
+	}
+	b, err := ioutil.ReadFile(hooksFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the upgrade hooks from file %s, error: %v", hooksFile, err)
+	}
+	extra, err := hooks.ParseRegistry(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the upgrade hooks from file %s, error: %v", hooksFile, err)
+	}
+	registry.Merge(extra)
+	return registry, nil
+}
+
 // checkUpgradeIOPS checks the upgrade eligibility by comparing the current IOPS with the target IOPS
 func checkUpgradeIOPS(curIOPS, tarIOPS, ignoreIOPS string, l *Logger) {
 	diff := compare.YAMLCmpWithIgnore(curIOPS, tarIOPS, nil, ignoreIOPS)
@@ -239,14 +325,16 @@ func checkUpgradeIOPS(curIOPS, tarIOPS, ignoreIOPS string, l *Logger) {
 	}
 }
 
-// waitForConfirmation waits for user's confirmation if skipConfirmation is not set
-func waitForConfirmation(skipConfirmation bool, l *Logger) {
+// waitForConfirmation waits for user's confirmation if skipConfirmation is not set, and returns an error if the
+// upgrade is not confirmed.
+func waitForConfirmation(skipConfirmation bool, l *Logger) error {
 	if skipConfirmation {
-		return
+		return nil
 	}
-	if !confirm("Confirm to proceed [y/N]?", os.Stdout) {
-		l.logAndFatalf("Abort.")
+	if !confirm("Confirm to proceed [y/N]?", l.stdOut) {
+		return fmt.Errorf("upgrade aborted")
 	}
+	return nil
 }
 
 // checkSupportedVersions checks if the upgrade cur -> tar is supported by the tool
@@ -279,6 +367,7 @@ func retrieveControlPlaneVersion(kubeClient manifest.ExecClient, istioNamespace
 	if e != nil {
 		return "", fmt.Errorf("failed to retrieve Istio control plane version, error: %v", e)
 	}
+	cv = defaultRevisionVersions(cv)
 
 	if len(cv) == 0 {
 		return "", fmt.Errorf("istio control plane not found in namespace: %v", istioNamespace)
@@ -296,17 +385,30 @@ func retrieveControlPlaneVersion(kubeClient manifest.ExecClient, istioNamespace
 	return v, nil
 }
 
+// defaultRevisionVersions returns the versions of the components of the default control plane revision. Canary
+// revisions are upgraded by installing a new revision, not in place, so their versions are ignored.
+func defaultRevisionVersions(cv []manifest.ComponentVersion) []manifest.ComponentVersion {
+	var out []manifest.ComponentVersion
+	for _, v := range cv {
+		if v.Pod.Labels[revision.Label] == "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 // waitUpgradeComplete waits for the upgrade to complete by periodically comparing the current component version
 // to the target version.
 func waitUpgradeComplete(kubeClient manifest.ExecClient, istioNamespace string, targetVer string, l *Logger) error {
 	for i := 1; i <= upgradeWaitCheckVerMaxAttempts; i++ {
-		sleepSeconds(upgradeWaitSecCheckVerPerLoop)
+		sleepSeconds(upgradeWaitSecCheckVerPerLoop, l)
 		cv, e := kubeClient.GetIstioVersions(istioNamespace)
 		if e != nil {
 			l.logAndPrintf("Failed to retrieve Istio control plane version, error: %v", e)
 			continue
 		}
-		if cv == nil {
+		cv = defaultRevisionVersions(cv)
+		if len(cv) == 0 {
 			l.logAndPrintf("Failed to find Istio namespace: %v", istioNamespace)
 			continue
 		}
@@ -326,12 +428,12 @@ func waitUpgradeComplete(kubeClient manifest.ExecClient, istioNamespace string,
 }
 
 // sleepSeconds sleeps for n seconds, printing a dot '.' per second
-func sleepSeconds(duration time.Duration) {
+func sleepSeconds(duration time.Duration, l *Logger) {
 	for t := time.Duration(0); t < duration; t += time.Second {
 		time.Sleep(time.Second)
-		fmt.Print(".")
+		l.print(".")
 	}
-	fmt.Println()
+	l.print("\n")
 }
 
 // coalesceVersions coalesces all Istio control plane components versions
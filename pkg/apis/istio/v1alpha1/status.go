@@ -0,0 +1,142 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/api/operator/v1alpha1"
+)
+
+// ConditionType is the type of an IstioOperator condition.
+type ConditionType string
+
+const (
+	// ConditionReady is true when all components were applied and all their replicas are ready.
+	ConditionReady ConditionType = "Ready"
+	// ConditionProgressing is true while components are being applied or their replicas are becoming ready.
+	ConditionProgressing ConditionType = "Progressing"
+	// ConditionDegraded is true when one or more components failed to apply.
+	ConditionDegraded ConditionType = "Degraded"
+	// ConditionPaused is true while the IstioOperator is paused, and nothing is applied or pruned.
+	ConditionPaused ConditionType = "Paused"
+	// ConditionPending is true while spec changes wait for the maintenance window of the IstioOperator to open.
+	ConditionPending ConditionType = "Pending"
+)
+
+// IstioOperatorStatus is the status of an IstioOperator resource.
+type IstioOperatorStatus struct {
+	// Status is the aggregate status of all components.
+	Status v1alpha1.InstallStatus_Status `json:"status,omitempty"`
+	// ObservedGeneration is the generation of the IstioOperator spec this status was computed for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions are the Kubernetes style conditions of the installation.
+	Conditions []Condition `json:"conditions,omitempty"`
+	// ComponentStatus is the status of each component, indexed by component name.
+	ComponentStatus map[string]*ComponentStatus `json:"componentStatus,omitempty"`
+	// LastError is the most recent error reported by any component. It is kept after the component recovers.
+	LastError *ErrorStatus `json:"lastError,omitempty"`
+	// PrunedResources is the log of the resources most recently pruned, newest first.
+	PrunedResources []PrunedResource `json:"prunedResources,omitempty"`
+	// PrunePreview lists the resources the last prune would have deleted, in prune preview mode or when the prune
+	// was stopped because it would have deleted too many resources.
+	PrunePreview []PrunedResource `json:"prunePreview,omitempty"`
+}
+
+// ComponentStatus is the status of a single component.
+type ComponentStatus struct {
+	// Version is the version of the component.
+	Version string `json:"version,omitempty"`
+	// Status is the result of applying the component.
+	Status v1alpha1.InstallStatus_Status `json:"status,omitempty"`
+	// StatusString is the string representation of Status.
+	StatusString string `json:"statusString,omitempty"`
+	// Error is the error from the last attempt to apply the component, if any.
+	Error string `json:"error,omitempty"`
+	// ErrorTime is the time Error was observed.
+	ErrorTime *metav1.Time `json:"errorTime,omitempty"`
+	// ReadyReplicas is the number of ready replicas of all Deployments, StatefulSets and DaemonSets in the component.
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// DesiredReplicas is the number of desired replicas of all Deployments, StatefulSets and DaemonSets in the
+	// component.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+}
+
+// Condition is a Kubernetes style condition.
+type Condition struct {
+	// Type is the type of the condition.
+	Type ConditionType `json:"type"`
+	// Status is one of True, False or Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Reason is a one word CamelCase reason for the last transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the last transition.
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the last time the condition changed status.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ErrorStatus records an error reported by a component.
+type ErrorStatus struct {
+	// Component is the name of the component which reported the error.
+	Component string `json:"component,omitempty"`
+	// Message is the error message.
+	Message string `json:"message"`
+	// Time is the time the error was observed.
+	Time metav1.Time `json:"time"`
+}
+
+// PrunedResource identifies a resource which was, or would have been, pruned.
+type PrunedResource struct {
+	// Group, Kind, Namespace and Name identify the resource.
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Time is when the resource was pruned, or found to be prunable.
+	Time metav1.Time `json:"time"`
+}
+
+// IsReady reports whether the component was applied and all its replicas are ready.
+func (c *ComponentStatus) IsReady() bool {
+	return c.Status == v1alpha1.InstallStatus_HEALTHY && c.ReadyReplicas >= c.DesiredReplicas
+}
+
+// GetCondition returns the condition of type t, or nil if s does not have it.
+func (s *IstioOperatorStatus) GetCondition(t ConditionType) *Condition {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == t {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition adds or replaces the condition of the same type as c. The LastTransitionTime of an existing
+// condition is kept if its status does not change.
+func (s *IstioOperatorStatus) SetCondition(c Condition) {
+	if old := s.GetCondition(c.Type); old != nil {
+		if old.Status == c.Status {
+			c.LastTransitionTime = old.LastTransitionTime
+		}
+		*old = c
+		return
+	}
+	s.Conditions = append(s.Conditions, c)
+}
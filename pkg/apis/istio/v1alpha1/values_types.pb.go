@@ -7510,9 +7510,11 @@ type Values struct {
 	Kiali                  *KialiConfig           `protobuf:"bytes,15,opt,name=kiali,proto3" json:"kiali,omitempty"`
 	Version                string                 `protobuf:"bytes,16,opt,name=version,proto3" json:"version,omitempty"`
 	ClusterResources       *protobuf.BoolValue    `protobuf:"bytes,17,opt,name=clusterResources,proto3" json:"clusterResources,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
-	XXX_unrecognized       []byte                 `json:"-"`
-	XXX_sizecache          int32                  `json:"-"`
+	// Revision of the control plane, used to install several control planes side by side.
+	Revision             string   `protobuf:"bytes,24,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Values) Reset()         { *m = Values{} }
@@ -7666,6 +7668,13 @@ func (m *Values) GetClusterResources() *protobuf.BoolValue {
 	return nil
 }
 
+func (m *Values) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 
 
 
@@ -7816,441 +7825,437 @@ func init() {
 }
 
 var fileDescriptor_261260e22432516f = []byte{
-	// 6961 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x49, 0x6c, 0xe4, 0x48,
-	0x76, 0x68, 0xa7, 0xf6, 0x7c, 0x99, 0x29, 0xa5, 0x42, 0x4b, 0xb1, 0x54, 0x9b, 0x9a, 0xbd, 0xd5,
-	0x54, 0xf7, 0xa8, 0xba, 0xd4, 0xd5, 0x55, 0xdd, 0xd5, 0xcb, 0xb4, 0xb6, 0xea, 0x52, 0xb7, 0xb6,
-	0x61, 0xaa, 0xab, 0x97, 0xf9, 0x7f, 0xca, 0x21, 0x32, 0x94, 0xc9, 0x11, 0x93, 0xe4, 0x90, 0x91,
-	0x2a, 0x69, 0x00, 0xc3, 0x30, 0x7c, 0xf0, 0xc5, 0x80, 0x61, 0x63, 0x60, 0x5f, 0xbc, 0x60, 0xec,
-	0x31, 0x7c, 0x32, 0x7c, 0xf0, 0xc1, 0x17, 0x1f, 0x6d, 0xc0, 0x80, 0xe1, 0xab, 0x61, 0xcc, 0xc1,
-	0x80, 0x8f, 0x36, 0x30, 0x07, 0x1f, 0x0d, 0x0f, 0x60, 0x23, 0x16, 0xee, 0x4c, 0x25, 0x95, 0xa9,
-	0xea, 0x1a, 0x7b, 0xe6, 0x46, 0xbe, 0x78, 0x2f, 0x22, 0x18, 0xf1, 0xe2, 0xc5, 0x7b, 0x2f, 0xe2,
-	0x3d, 0xc2, 0x2d, 0xf7, 0xa8, 0x79, 0x1b, 0xbb, 0xa6, 0x7f, 0xdb, 0xf4, 0xa9, 0xe9, 0xdc, 0x3e,
-	0xbe, 0x83, 0x2d, 0xb7, 0x85, 0xef, 0xdc, 0x3e, 0xc6, 0x56, 0x87, 0xf8, 0x4f, 0xe8, 0xa9, 0x4b,
-	0xfc, 0x25, 0xd7, 0x73, 0xa8, 0x83, 0x26, 0x82, 0xc2, 0x85, 0xeb, 0x4d, 0xc7, 0x69, 0x5a, 0xe4,
-	0x36, 0x87, 0x1f, 0x74, 0x0e, 0x6f, 0x1b, 0x1d, 0x0f, 0x53, 0xd3, 0xb1, 0x05, 0xe6, 0x82, 0x7a,
-	0xf4, 0x8e, 0xbf, 0x64, 0x3a, 0xac, 0xe2, 0xdb, 0xba, 0xe3, 0x91, 0xdb, 0xc7, 0x77, 0x6e, 0x37,
-	0x89, 0x4d, 0x3c, 0x4c, 0x89, 0x21, 0x71, 0x3e, 0x6a, 0x9a, 0xb4, 0xd5, 0x39, 0x58, 0xd2, 0x9d,
-	0xf6, 0xed, 0xa6, 0xd3, 0x74, 0xa2, 0xca, 0xc2, 0x87, 0x74, 0x2b, 0x4f, 0x3d, 0xec, 0xba, 0xc4,
-	0x93, 0xfd, 0x51, 0xff, 0xb9, 0x04, 0x68, 0xc5, 0x30, 0x1c, 0x7b, 0xd3, 0x6e, 0x7a, 0xc4, 0xf7,
-	0xd7, 0x1c, 0xfb, 0xd0, 0x6c, 0xa2, 0xbb, 0x30, 0x4e, 0x6c, 0x7c, 0x60, 0x11, 0x43, 0x29, 0x2d,
-	0x96, 0x6e, 0x56, 0x96, 0x17, 0x96, 0x44, 0x45, 0x4b, 0x41, 0x45, 0x4b, 0xab, 0x8e, 0x63, 0x3d,
-	0x66, 0x1f, 0xa8, 0x05, 0xa8, 0x68, 0x16, 0x46, 0x5b, 0x8e, 0x4f, 0x7d, 0x65, 0x68, 0x71, 0xf8,
-	0x66, 0x59, 0x13, 0x2f, 0x68, 0x15, 0x2a, 0xd8, 0xb6, 0x1d, 0xca, 0x3f, 0xce, 0x57, 0x86, 0x79,
-	0x7d, 0x8b, 0x4b, 0xc1, 0x40, 0x2c, 0xed, 0x9f, 0xba, 0x64, 0x1b, 0xbb, 0x0d, 0xea, 0x99, 0x76,
-	0x73, 0xd3, 0xa6, 0xc4, 0x3b, 0xc4, 0x3a, 0xd1, 0xe2, 0x44, 0x68, 0x19, 0x86, 0xa9, 0xe5, 0x2b,
-	0x23, 0x05, 0x69, 0x19, 0xb2, 0xaa, 0x01, 0xac, 0x78, 0x7a, 0x4b, 0x7e, 0xd1, 0x2c, 0x8c, 0xe2,
-	0xb6, 0x71, 0xef, 0x2e, 0xff, 0x9e, 0x9a, 0x26, 0x5e, 0x90, 0x02, 0xe3, 0xae, 0xab, 0xdf, 0xbb,
-	0x6b, 0x11, 0x65, 0x88, 0xc3, 0x83, 0x57, 0x86, 0xef, 0xbf, 0xf5, 0xee, 0x9b, 0x27, 0xbc, 0xbf,
-	0x35, 0x4d, 0xbc, 0xa8, 0x7f, 0x37, 0x0c, 0xe5, 0xb5, 0x9d, 0xcd, 0x81, 0x46, 0xa9, 0x0e, 0xc3,
-	0xad, 0xce, 0x01, 0x6f, 0xaf, 0xac, 0xb1, 0x47, 0x06, 0xa1, 0xb8, 0xc9, 0x5b, 0x2a, 0x6b, 0xec,
-	0x91, 0xb5, 0x6e, 0xb6, 0x71, 0x93, 0xf0, 0x2f, 0x2e, 0x6b, 0xe2, 0x05, 0x5d, 0x07, 0x70, 0x3b,
-	0x96, 0xb5, 0xe7, 0x58, 0xa6, 0x7e, 0xaa, 0x8c, 0xf2, 0xa2, 0x18, 0x04, 0x5d, 0x85, 0xb2, 0x6e,
-	0x9b, 0xab, 0xa6, 0xbd, 0x6e, 0x7a, 0xca, 0x18, 0x2f, 0x8e, 0x00, 0x8c, 0x5a, 0xb7, 0x4d, 0xd6,
-	0x75, 0x56, 0x3c, 0x2e, 0xa8, 0x23, 0x08, 0xba, 0x09, 0x53, 0xf2, 0xed, 0xa1, 0x69, 0x91, 0x1d,
-	0xdc, 0x26, 0xca, 0x04, 0x47, 0x4a, 0x83, 0xd1, 0x1b, 0x30, 0x4d, 0x4e, 0x74, 0xab, 0x63, 0xf0,
-	0x57, 0xdf, 0xc5, 0x3a, 0xf1, 0x95, 0x32, 0x9f, 0xf3, 0x6c, 0x01, 0xda, 0x82, 0x49, 0xd7, 0x31,
-	0x56, 0x62, 0x2c, 0x00, 0xc5, 0xa6, 0x71, 0x75, 0x48, 0x29, 0x69, 0x29, 0x5a, 0x74, 0x13, 0xea,
-	0xae, 0xef, 0x3e, 0xd1, 0xad, 0x8e, 0x4f, 0x89, 0xf7, 0xc4, 0x73, 0x2c, 0xa2, 0x54, 0x78, 0x37,
-	0x27, 0x5d, 0xdf, 0x5d, 0x13, 0x60, 0xcd, 0xb1, 0x08, 0x5a, 0x80, 0x09, 0xcb, 0x69, 0x6e, 0x91,
-	0x63, 0x62, 0x29, 0x55, 0x8e, 0x11, 0xbe, 0xab, 0x5f, 0xc0, 0xc2, 0xda, 0xde, 0x67, 0xfb, 0xd8,
-	0x6b, 0x12, 0xfa, 0x19, 0x35, 0x2d, 0xf3, 0x07, 0xbc, 0x7a, 0x39, 0xaf, 0x0f, 0x40, 0xa1, 0xbc,
-	0x68, 0xe5, 0x98, 0x78, 0xb8, 0x49, 0x62, 0x18, 0x7c, 0xa2, 0x47, 0xb5, 0xae, 0xe5, 0xea, 0x6f,
-	0x8c, 0xc1, 0xf4, 0x1a, 0xf1, 0xe8, 0x36, 0xb6, 0x71, 0x93, 0x78, 0xcf, 0x89, 0x53, 0x5e, 0x85,
-	0xaa, 0x47, 0x5c, 0xcb, 0xd4, 0xf1, 0x9a, 0xd3, 0xb1, 0x29, 0xe7, 0x95, 0x1a, 0x1f, 0xcf, 0x04,
-	0x9c, 0x51, 0x93, 0x36, 0x36, 0x2d, 0xc9, 0x2d, 0xe2, 0x85, 0xf1, 0x11, 0x39, 0xa1, 0x1e, 0x5e,
-	0xf1, 0x9a, 0xbe, 0x32, 0xce, 0xe7, 0x35, 0x02, 0xa0, 0x47, 0x50, 0xb5, 0x1d, 0x83, 0x34, 0x88,
-	0x45, 0x74, 0xea, 0x78, 0x9c, 0x49, 0x8a, 0xce, 0x66, 0x82, 0x12, 0xbd, 0x0d, 0x65, 0x8f, 0xf8,
-	0x4e, 0xc7, 0x13, 0xfc, 0xc3, 0xaa, 0x99, 0x89, 0xaa, 0xd1, 0x82, 0x22, 0x4e, 0x19, 0x61, 0x22,
-	0x15, 0xaa, 0xae, 0x63, 0xac, 0xdb, 0xbe, 0x5c, 0x08, 0xc0, 0xfb, 0x9e, 0x80, 0xa1, 0xf5, 0x00,
-	0x47, 0x4c, 0x00, 0x67, 0x91, 0x22, 0x92, 0x23, 0x41, 0x85, 0x1c, 0xb8, 0xca, 0xd9, 0x8f, 0x9a,
-	0x2b, 0x87, 0x87, 0xa6, 0x6d, 0xd2, 0xd3, 0x2d, 0x7c, 0x40, 0xac, 0xf0, 0xd3, 0xab, 0xbc, 0xd6,
-	0xd7, 0x92, 0xb5, 0x36, 0x2c, 0x53, 0x27, 0xbb, 0x87, 0x5d, 0x46, 0xe0, 0xcc, 0x0a, 0xd1, 0x53,
-	0x58, 0x4c, 0x95, 0xef, 0x13, 0xaf, 0x9d, 0x6c, 0xb4, 0x76, 0xfe, 0x46, 0x7b, 0x56, 0x8a, 0xb6,
-	0xa1, 0x42, 0x1d, 0x8b, 0x78, 0x72, 0x85, 0x4e, 0x9e, 0xbf, 0x8d, 0x38, 0xbd, 0xfa, 0x5f, 0x25,
-	0x28, 0x87, 0xf3, 0x87, 0xee, 0xc3, 0x98, 0x65, 0xb6, 0x4d, 0xea, 0x2b, 0xa5, 0xc5, 0xe1, 0x9b,
-	0x95, 0xe5, 0x1b, 0x39, 0x93, 0xbc, 0xb4, 0xc5, 0x31, 0x36, 0x6c, 0xea, 0x9d, 0x6a, 0x12, 0x1d,
-	0x7d, 0x00, 0x13, 0x1e, 0xf9, 0x7e, 0x87, 0x04, 0x7b, 0x4a, 0x65, 0xf9, 0xc5, 0x3c, 0x52, 0x4d,
-	0xe2, 0x08, 0xe2, 0x90, 0x64, 0xe1, 0x5d, 0xa8, 0xc4, 0x6a, 0x65, 0x8b, 0xe7, 0x88, 0x9c, 0xf2,
-	0x05, 0x58, 0xd6, 0xd8, 0x23, 0x63, 0x7f, 0xbe, 0x47, 0xcb, 0x25, 0x26, 0x5e, 0x1e, 0x0c, 0xbd,
-	0x53, 0x5a, 0x78, 0x0f, 0x6a, 0x89, 0x5a, 0xcf, 0x43, 0xac, 0xfe, 0xce, 0x38, 0xd4, 0xd6, 0x1c,
-	0x8f, 0xac, 0xef, 0x34, 0x06, 0x5a, 0xff, 0x2a, 0x54, 0x75, 0x51, 0xcd, 0x26, 0x5f, 0xe2, 0xa2,
-	0xa1, 0x04, 0x8c, 0x4b, 0x75, 0xf1, 0xbe, 0x1f, 0x0a, 0x86, 0x18, 0x04, 0x2d, 0x01, 0x92, 0x6f,
-	0x7b, 0x56, 0xa7, 0x69, 0xda, 0x9b, 0x31, 0x61, 0x91, 0x53, 0x92, 0x59, 0xdd, 0xa3, 0x7d, 0xaf,
-	0xee, 0xb4, 0x0c, 0x1a, 0xeb, 0x22, 0x83, 0xb2, 0xfb, 0xc3, 0xf8, 0x00, 0xfb, 0x43, 0x42, 0xa6,
-	0x4c, 0x14, 0x96, 0x29, 0x5b, 0x30, 0xe5, 0x39, 0x96, 0x65, 0xda, 0xcd, 0x6d, 0x7c, 0xd2, 0xe8,
-	0x78, 0x4d, 0x22, 0x05, 0xd2, 0xf5, 0x64, 0x2f, 0x36, 0x6d, 0xba, 0xeb, 0x89, 0x7e, 0x3c, 0x74,
-	0xbc, 0xbd, 0x55, 0x5e, 0x4f, 0x9a, 0x14, 0x7d, 0x01, 0x73, 0x11, 0xe8, 0x33, 0x1b, 0x1f, 0x63,
-	0xd3, 0x62, 0x53, 0x2a, 0x77, 0xbe, 0x22, 0x75, 0xe6, 0x57, 0xd0, 0x53, 0x22, 0x55, 0x9e, 0x87,
-	0x44, 0xaa, 0x7e, 0x0d, 0x12, 0xa9, 0x36, 0xa0, 0x44, 0xfa, 0x02, 0x16, 0xd7, 0xc9, 0x21, 0xee,
-	0x58, 0x74, 0xcf, 0x31, 0xd6, 0x4d, 0xdf, 0xeb, 0xb8, 0xac, 0x60, 0xb5, 0x63, 0x34, 0x09, 0x1d,
-	0x64, 0x95, 0xaa, 0x9f, 0xc3, 0xbc, 0xac, 0x39, 0xe4, 0x2e, 0x59, 0x5f, 0x5c, 0x7c, 0x89, 0x0a,
-	0xf3, 0xc4, 0x57, 0x20, 0x67, 0x04, 0x51, 0x24, 0xbe, 0xd4, 0xff, 0x28, 0xc3, 0xcc, 0x06, 0xd7,
-	0xca, 0x3f, 0xc6, 0x94, 0x3c, 0xc5, 0xa7, 0xb2, 0xda, 0x87, 0x50, 0xc7, 0x1d, 0xea, 0xf8, 0x3a,
-	0xb6, 0xc8, 0x46, 0xe1, 0xfe, 0x66, 0x68, 0x98, 0x78, 0x09, 0x61, 0xdb, 0xf8, 0x44, 0x6a, 0xc0,
-	0x09, 0x58, 0x12, 0xc7, 0xb4, 0xa5, 0x36, 0x9c, 0x80, 0xa1, 0x57, 0x61, 0x52, 0x77, 0x6c, 0x9b,
-	0xe8, 0x74, 0xdf, 0x6c, 0x13, 0xa7, 0x43, 0xa5, 0x78, 0x49, 0x41, 0xd1, 0x03, 0x18, 0xd6, 0xdd,
-	0x8e, 0x94, 0x28, 0x2f, 0x47, 0x23, 0xd1, 0x5d, 0x13, 0xe3, 0xd3, 0xc8, 0x88, 0xd0, 0xb7, 0xa0,
-	0x66, 0x78, 0xd8, 0xb4, 0xd7, 0xa5, 0x91, 0xc4, 0xa5, 0x49, 0x65, 0xf9, 0x72, 0xe6, 0x83, 0x03,
-	0x04, 0x2d, 0x89, 0x1f, 0x9f, 0xdb, 0xf1, 0xe2, 0x12, 0x78, 0x19, 0x86, 0x89, 0x7d, 0x5c, 0x54,
-	0xc5, 0xd1, 0x18, 0x32, 0x7a, 0x1b, 0xc6, 0x2c, 0xc6, 0xc9, 0x81, 0x4a, 0x73, 0x2d, 0x22, 0x93,
-	0xf3, 0xc8, 0x19, 0x3d, 0x98, 0x6f, 0x89, 0x9c, 0x11, 0xbc, 0xd0, 0xb7, 0xe0, 0xcd, 0x0a, 0xd4,
-	0xca, 0x00, 0x02, 0xf5, 0x17, 0x47, 0x07, 0x7a, 0x1d, 0x46, 0x5d, 0xc7, 0xa3, 0x4c, 0xfb, 0x61,
-	0xaa, 0xc6, 0x5c, 0x54, 0xfb, 0x1e, 0x03, 0xcb, 0xf9, 0x12, 0x38, 0xc9, 0x7d, 0x66, 0xaa, 0xf0,
-	0x3e, 0xf3, 0x3e, 0xd4, 0x7c, 0xa2, 0x7b, 0x84, 0x3e, 0x76, 0xac, 0x4e, 0x9b, 0xf8, 0x4a, 0x9d,
-	0xb7, 0x35, 0x1f, 0x91, 0x36, 0x62, 0xc5, 0x5a, 0x12, 0x19, 0xed, 0x01, 0xf2, 0x89, 0x77, 0x6c,
-	0xea, 0x24, 0x3e, 0xbb, 0xd3, 0x05, 0xb9, 0x33, 0x87, 0x16, 0x21, 0x18, 0xa1, 0xa7, 0x2e, 0x51,
-	0x10, 0x5f, 0xb1, 0xfc, 0x19, 0xbd, 0x0e, 0x23, 0x3f, 0x38, 0x76, 0x6d, 0x65, 0x86, 0xd7, 0x7b,
-	0x29, 0xaa, 0xf7, 0x2b, 0xe2, 0x39, 0x8f, 0xf7, 0x76, 0xe4, 0x40, 0x70, 0xa4, 0xb4, 0x98, 0x9e,
-	0x1d, 0x50, 0x4c, 0xff, 0xac, 0x04, 0x68, 0xc3, 0x3e, 0x76, 0x4e, 0xb7, 0x09, 0xf5, 0x4c, 0x7d,
-	0x30, 0x7f, 0x04, 0x82, 0x91, 0x96, 0xe3, 0x53, 0xa9, 0x37, 0xf1, 0x67, 0x06, 0x63, 0x13, 0xc8,
-	0x05, 0xd9, 0xa8, 0xc6, 0x9f, 0xd1, 0x2a, 0x54, 0xa8, 0xe5, 0x37, 0x08, 0xa5, 0xa6, 0xdd, 0x2c,
-	0xee, 0x65, 0x88, 0x13, 0x31, 0x83, 0x83, 0xea, 0xee, 0xa7, 0x84, 0xb8, 0xd8, 0x32, 0x8f, 0x49,
-	0x51, 0xbd, 0x49, 0x4b, 0x50, 0xa9, 0xff, 0x38, 0x06, 0xd5, 0x8f, 0xb1, 0x65, 0x91, 0xd3, 0x41,
-	0x1d, 0x31, 0x66, 0x4c, 0x63, 0x94, 0x46, 0xe1, 0x5d, 0x18, 0x69, 0x13, 0xbf, 0xa5, 0x0c, 0x73,
-	0x96, 0x5b, 0x8c, 0x8b, 0xa5, 0xa8, 0xc5, 0xa5, 0x6d, 0xe2, 0xb7, 0x84, 0x22, 0xcd, 0xb1, 0x7b,
-	0xae, 0xff, 0x91, 0xe7, 0xb1, 0xfe, 0x47, 0x9f, 0xc5, 0xfa, 0x2f, 0xaa, 0xb0, 0x26, 0x96, 0xfe,
-	0x78, 0xe1, 0xa5, 0xbf, 0x0a, 0x93, 0x62, 0x7e, 0x56, 0x6c, 0x6c, 0x9d, 0xfa, 0x66, 0xa0, 0x9e,
-	0x9e, 0x35, 0xa3, 0x29, 0x8a, 0xff, 0x35, 0x6a, 0x6a, 0x4a, 0x2a, 0x54, 0x06, 0x93, 0x0a, 0x0b,
-	0xf7, 0xa1, 0x1c, 0xb2, 0xe5, 0xb9, 0x2c, 0xb1, 0x0f, 0x60, 0x26, 0x67, 0xcf, 0x65, 0x55, 0x60,
-	0xd7, 0x0d, 0xaa, 0xc0, 0xae, 0xcb, 0x57, 0x8c, 0x4f, 0x4d, 0x27, 0x5c, 0x31, 0xec, 0x45, 0xfd,
-	0xb7, 0x12, 0x4c, 0x4a, 0xfa, 0x80, 0x74, 0x07, 0x66, 0x78, 0xd9, 0x13, 0xc2, 0x35, 0xb3, 0xa6,
-	0x28, 0x95, 0x8b, 0x33, 0xb6, 0xd5, 0xe7, 0x28, 0x6e, 0x1a, 0xe2, 0x94, 0x1b, 0x71, 0xc2, 0xf8,
-	0x02, 0x1f, 0x2a, 0xbe, 0xc0, 0xbf, 0x0d, 0xb3, 0xa2, 0x17, 0xa6, 0x9d, 0xe8, 0xc6, 0x48, 0x7a,
-	0xe2, 0xa4, 0x5b, 0x37, 0xd9, 0x0f, 0xf1, 0x05, 0x9b, 0x09, 0x52, 0xf5, 0x3f, 0x15, 0xa8, 0x7e,
-	0x6c, 0x39, 0x07, 0xd8, 0x92, 0x5f, 0x7a, 0x13, 0x46, 0xb0, 0xa7, 0xb7, 0xe4, 0xa7, 0xcd, 0x46,
-	0x75, 0x46, 0x5e, 0x55, 0x8d, 0x63, 0xa0, 0x4f, 0xa1, 0xaa, 0x13, 0x8f, 0x9a, 0x87, 0xa6, 0x8e,
-	0x29, 0xf1, 0x95, 0x9b, 0xe7, 0x9a, 0x6e, 0x2d, 0x41, 0xcc, 0xdd, 0x90, 0xbc, 0xf2, 0xd0, 0x85,
-	0x28, 0xe7, 0x24, 0x0d, 0x46, 0x6f, 0xc2, 0x8c, 0x00, 0x69, 0x8e, 0x43, 0x23, 0xec, 0x65, 0x8e,
-	0x9d, 0x57, 0xc4, 0x34, 0x67, 0x01, 0x7e, 0x8c, 0x2d, 0xd3, 0x10, 0x8a, 0xe4, 0x70, 0x6f, 0xcd,
-	0x39, 0x4d, 0x83, 0xfe, 0x1f, 0x5c, 0xd1, 0x1d, 0x9b, 0x7a, 0x8e, 0xb5, 0x67, 0x61, 0x9b, 0x34,
-	0x88, 0xde, 0xf1, 0x4c, 0x7a, 0x1a, 0x28, 0xe3, 0x23, 0x3d, 0xab, 0x3c, 0x8b, 0x1c, 0x3d, 0x82,
-	0x1b, 0x86, 0x30, 0x28, 0xc4, 0x28, 0x3f, 0x36, 0x7d, 0xf3, 0xc0, 0xb4, 0x4c, 0x7a, 0x1a, 0x6e,
-	0x51, 0x77, 0xb9, 0x53, 0xae, 0x17, 0x1a, 0x7a, 0x0c, 0x33, 0x12, 0x65, 0x27, 0xae, 0x5a, 0x8e,
-	0x9d, 0x43, 0x1d, 0xcc, 0xab, 0x00, 0xd9, 0xb0, 0x60, 0x74, 0x35, 0xa6, 0xa4, 0x48, 0xbc, 0x15,
-	0x55, 0xdf, 0xcb, 0xf0, 0xe2, 0x0d, 0x9d, 0x51, 0x23, 0xda, 0x82, 0x19, 0xc3, 0xf4, 0xd9, 0xe8,
-	0x08, 0xf7, 0xde, 0x5a, 0x8b, 0xe8, 0x47, 0x45, 0xe4, 0x67, 0x1e, 0x19, 0xda, 0x83, 0xba, 0x91,
-	0x32, 0xd8, 0xa4, 0x14, 0x5d, 0xcc, 0xf4, 0x39, 0x65, 0xd2, 0xf1, 0x9e, 0x66, 0xa8, 0x23, 0xd1,
-	0xfe, 0x88, 0x58, 0xed, 0x7d, 0xe2, 0x53, 0x29, 0x41, 0x0b, 0x88, 0xf6, 0x80, 0x02, 0x7d, 0x04,
-	0x35, 0x01, 0xd9, 0xf7, 0xb0, 0x6e, 0xda, 0x81, 0xcb, 0xf2, 0xac, 0x2a, 0x92, 0x04, 0x81, 0xbb,
-	0xb8, 0x1a, 0xb9, 0x8b, 0x6f, 0xc2, 0x14, 0xdf, 0xfa, 0xf7, 0xa2, 0x53, 0x83, 0x9a, 0x58, 0x4b,
-	0x29, 0x30, 0x6a, 0x40, 0x3d, 0x04, 0x09, 0x0d, 0xd4, 0x57, 0x5e, 0x39, 0xdf, 0x32, 0xce, 0x54,
-	0xc0, 0x0c, 0x43, 0x2e, 0x69, 0xa2, 0xb5, 0x39, 0x29, 0x0c, 0xc3, 0x24, 0x14, 0xed, 0xc0, 0xb4,
-	0xe5, 0xe8, 0x98, 0xb1, 0xee, 0xd6, 0x81, 0x64, 0x5e, 0xa9, 0x53, 0xf7, 0x56, 0xa0, 0xb2, 0xa4,
-	0x68, 0x05, 0xe0, 0xe8, 0x1d, 0x5f, 0xca, 0x37, 0xa5, 0x9e, 0xb6, 0xbc, 0x3f, 0xed, 0x1c, 0x10,
-	0xcf, 0x26, 0x94, 0xf8, 0x89, 0x43, 0x2f, 0x2d, 0x46, 0x84, 0xde, 0x81, 0xb2, 0xe5, 0x34, 0x57,
-	0xfc, 0x4f, 0x7c, 0xc7, 0x56, 0x5e, 0xee, 0x39, 0x13, 0x11, 0x32, 0xba, 0x0f, 0xe3, 0x96, 0xd3,
-	0x6c, 0xb2, 0x4f, 0x98, 0xce, 0xd8, 0x7f, 0x5c, 0xbe, 0x6e, 0x89, 0x62, 0xd9, 0x6a, 0x80, 0x8d,
-	0xd6, 0xa0, 0xc6, 0x14, 0xae, 0x8d, 0x13, 0x17, 0xdb, 0x3e, 0x93, 0x4c, 0x28, 0x4d, 0xbe, 0x1d,
-	0x2f, 0x96, 0xe4, 0x49, 0x1a, 0x34, 0x0f, 0x63, 0x0c, 0xb0, 0xb9, 0xae, 0xbc, 0xcd, 0x87, 0x5a,
-	0xbe, 0x31, 0xf5, 0x94, 0x3d, 0xed, 0x10, 0xfa, 0xd4, 0xf1, 0x8e, 0x7c, 0xa9, 0xdb, 0x17, 0x50,
-	0x4f, 0xe3, 0x54, 0x6c, 0x42, 0xdb, 0x8e, 0x6d, 0x52, 0x87, 0x21, 0x31, 0xa3, 0x88, 0xeb, 0xfb,
-	0x35, 0x2d, 0x05, 0x65, 0x5b, 0x47, 0x9b, 0x5a, 0xbe, 0x32, 0x97, 0xde, 0x3a, 0xb6, 0xf7, 0xb7,
-	0x1a, 0xc1, 0xd6, 0xc1, 0x30, 0xd0, 0x47, 0x50, 0x6d, 0x77, 0x2c, 0x6a, 0xca, 0x83, 0x1b, 0x65,
-	0x9e, 0x53, 0x5c, 0x8d, 0x51, 0xc4, 0x4a, 0x25, 0x65, 0x82, 0x02, 0x29, 0x30, 0x6e, 0x8b, 0xfe,
-	0x29, 0xaf, 0xf1, 0x4f, 0x0e, 0x5e, 0xd1, 0x3d, 0x98, 0x77, 0x1d, 0x63, 0x7d, 0xa7, 0xd1, 0x20,
-	0x6c, 0x9b, 0x8a, 0x9d, 0x55, 0xbd, 0xce, 0xc5, 0x67, 0x97, 0x52, 0xf4, 0x5d, 0xb8, 0xea, 0xb4,
-	0x4d, 0xda, 0x30, 0x0d, 0xa2, 0x63, 0x6f, 0xd3, 0xfe, 0x1e, 0x17, 0x7a, 0xa2, 0xf1, 0x6d, 0xec,
-	0x2a, 0xaf, 0xf6, 0x64, 0x87, 0x33, 0xe9, 0xd1, 0x87, 0x50, 0x75, 0xec, 0xe8, 0x84, 0x4c, 0xb9,
-	0xd4, 0xb3, 0xbe, 0x04, 0x3e, 0xd2, 0x60, 0xde, 0x71, 0x99, 0x6e, 0xe4, 0x78, 0xe2, 0x94, 0xe9,
-	0x73, 0x72, 0xd0, 0x72, 0x9c, 0x23, 0x5f, 0xf9, 0x46, 0xcf, 0x9a, 0xba, 0x50, 0xa2, 0xef, 0xc0,
-	0x9c, 0xd3, 0xa1, 0x07, 0x4e, 0xc7, 0x36, 0xf6, 0x3d, 0x7c, 0x78, 0x68, 0xea, 0x52, 0x5e, 0x28,
-	0xbc, 0xca, 0x57, 0xa2, 0x09, 0xd9, 0xcd, 0x43, 0x93, 0x33, 0x93, 0x5f, 0x07, 0x13, 0xdf, 0x6e,
-	0x24, 0x80, 0x1f, 0x62, 0xd3, 0xda, 0x75, 0x89, 0xad, 0x5c, 0xee, 0x2d, 0xbe, 0x73, 0xc8, 0x98,
-	0x50, 0x13, 0xe0, 0x68, 0x04, 0x17, 0x84, 0x50, 0x4b, 0x81, 0xd1, 0x9b, 0x30, 0xed, 0x7a, 0xa6,
-	0xc3, 0xf6, 0xd6, 0x35, 0x0b, 0xfb, 0x3e, 0x3f, 0xd3, 0xbc, 0xc2, 0x70, 0xb9, 0x1c, 0xcf, 0x16,
-	0x32, 0x95, 0xc2, 0xf5, 0x9c, 0x36, 0xa1, 0x2d, 0xd2, 0xf1, 0xa3, 0xfa, 0xdf, 0x12, 0x2a, 0x45,
-	0x4e, 0x11, 0x77, 0x1a, 0x78, 0xce, 0xc9, 0xa9, 0x72, 0x95, 0x7f, 0x4d, 0xdc, 0x69, 0xc0, 0xc0,
-	0xa1, 0xd3, 0x80, 0xbd, 0xa0, 0xfb, 0x50, 0xe6, 0x0f, 0x9b, 0xb6, 0x49, 0x95, 0x6b, 0xd2, 0x83,
-	0x95, 0x24, 0x60, 0x45, 0x92, 0x28, 0xc2, 0x45, 0xaf, 0xc0, 0xb0, 0x6f, 0xf8, 0xca, 0xf5, 0xb4,
-	0xb1, 0xd1, 0x58, 0x0f, 0x96, 0x13, 0x2b, 0x0f, 0x8e, 0x07, 0x6f, 0x44, 0xc7, 0x83, 0x4b, 0x80,
-	0x28, 0xb1, 0x48, 0x9b, 0x50, 0x2f, 0x36, 0x5e, 0x8b, 0xc2, 0xfd, 0x9f, 0x2d, 0x41, 0x4b, 0x30,
-	0x46, 0x3d, 0xac, 0x13, 0x4f, 0x79, 0x91, 0xb7, 0x15, 0x73, 0x4c, 0xec, 0x73, 0x78, 0xe0, 0xb5,
-	0x12, 0x58, 0x68, 0x11, 0x2a, 0xd4, 0xeb, 0xf8, 0x74, 0xdd, 0x69, 0x63, 0xd3, 0x56, 0x54, 0x5e,
-	0x71, 0x1c, 0xc4, 0x7b, 0x10, 0xbd, 0xae, 0x58, 0x26, 0xf6, 0x89, 0xaf, 0xdc, 0xe2, 0x2b, 0x30,
-	0xa7, 0x04, 0x2d, 0xc3, 0x58, 0xc7, 0x27, 0xdb, 0x6b, 0x7b, 0xca, 0x4b, 0x3d, 0xf9, 0x43, 0x62,
-	0xa2, 0xf7, 0xa1, 0xc2, 0xb7, 0x14, 0x8d, 0xb4, 0x1d, 0x4a, 0x94, 0x37, 0x7a, 0x12, 0xc6, 0xd1,
-	0xd1, 0x63, 0x50, 0x74, 0x8f, 0x60, 0x4a, 0xc4, 0x7b, 0xe3, 0x58, 0xdf, 0xb0, 0x0d, 0xd7, 0x31,
-	0x6d, 0xea, 0x2b, 0xdf, 0xec, 0x59, 0x55, 0x57, 0x5a, 0x26, 0x47, 0x3c, 0x0e, 0xdd, 0x33, 0x2d,
-	0x87, 0xae, 0x71, 0xb4, 0x18, 0x82, 0xb2, 0xd4, 0x5b, 0x8e, 0x9c, 0x45, 0xcf, 0x98, 0x55, 0x96,
-	0x73, 0xbe, 0x5f, 0x31, 0x0c, 0xbe, 0xdf, 0xdd, 0x16, 0xcc, 0x9a, 0x53, 0xc4, 0xe6, 0x22, 0x56,
-	0x63, 0x40, 0xf0, 0xa6, 0xe0, 0x86, 0x6c, 0x09, 0x93, 0xa0, 0x02, 0xba, 0x1f, 0x70, 0x4a, 0x40,
-	0x73, 0x87, 0xd3, 0x74, 0x29, 0x65, 0x5c, 0xc4, 0x07, 0xd8, 0x50, 0xee, 0xa5, 0xb9, 0x68, 0x93,
-	0xc3, 0x03, 0x2e, 0x12, 0x58, 0xea, 0x3a, 0x54, 0xe3, 0xf0, 0x3e, 0x1d, 0xf1, 0xaf, 0xc3, 0x4c,
-	0xce, 0x06, 0xcb, 0x4c, 0x3b, 0x8b, 0x5f, 0x02, 0x10, 0xe6, 0x9e, 0x78, 0x51, 0x7f, 0x7f, 0x06,
-	0x66, 0xf3, 0x8c, 0xa3, 0x5f, 0x48, 0xef, 0xfa, 0x47, 0x50, 0xd3, 0x3b, 0x3e, 0x75, 0xda, 0x0d,
-	0xe1, 0x21, 0x94, 0x16, 0xc2, 0x99, 0xba, 0x67, 0x82, 0x80, 0x0d, 0xb2, 0x41, 0x0e, 0x3a, 0x4d,
-	0x79, 0xaf, 0x44, 0xbc, 0x30, 0x6d, 0xc4, 0x10, 0x82, 0x41, 0xdc, 0x24, 0x91, 0x6f, 0x59, 0x6f,
-	0x7e, 0xb9, 0x7f, 0x6f, 0x3e, 0x9c, 0xdb, 0x9b, 0x5f, 0x39, 0x8f, 0x37, 0x7f, 0x11, 0x2a, 0xe4,
-	0x84, 0x12, 0xcf, 0xc6, 0xd6, 0xe6, 0x9e, 0xaf, 0x54, 0xb9, 0xdc, 0x8a, 0x83, 0xd0, 0x83, 0x84,
-	0xb6, 0x59, 0xeb, 0xd9, 0x9d, 0xb8, 0x9a, 0xb9, 0x0e, 0x53, 0xd1, 0xdb, 0x23, 0x4a, 0xdd, 0xe0,
-	0xe8, 0xfd, 0xac, 0x0a, 0xd2, 0x24, 0xb1, 0x13, 0x87, 0xa9, 0xf3, 0x9c, 0x38, 0xbc, 0x0a, 0x93,
-	0x96, 0x83, 0x8d, 0x55, 0x6c, 0x61, 0x5b, 0x27, 0xde, 0xe6, 0x1e, 0x57, 0x95, 0xcb, 0x5a, 0x0a,
-	0x8a, 0x1e, 0x80, 0x12, 0x87, 0x34, 0xb8, 0xd1, 0xa3, 0x61, 0xbb, 0x49, 0x7c, 0x65, 0x9a, 0x8f,
-	0x47, 0xd7, 0x72, 0xb4, 0x01, 0x28, 0xa1, 0xa0, 0x72, 0x4f, 0xba, 0x82, 0xce, 0x72, 0xb0, 0xe7,
-	0x10, 0x64, 0x0e, 0x47, 0x66, 0x2e, 0xf0, 0x70, 0x64, 0xf6, 0x19, 0x1e, 0x8e, 0xcc, 0x3d, 0x0f,
-	0xe7, 0xe8, 0xfc, 0x33, 0x3d, 0x1c, 0xb9, 0x54, 0xe0, 0x70, 0x24, 0xed, 0x49, 0x55, 0xba, 0x78,
-	0x52, 0x57, 0xe3, 0x9e, 0xd4, 0xcb, 0xe7, 0x98, 0x87, 0x98, 0x5b, 0xf5, 0x2d, 0xa1, 0x1a, 0x2d,
-	0xa4, 0xad, 0xbc, 0xa4, 0x70, 0x6f, 0x18, 0x7e, 0x5c, 0x51, 0xca, 0x1c, 0xc3, 0x5c, 0x19, 0xfc,
-	0x18, 0xe6, 0xea, 0x05, 0x1c, 0xc3, 0x5c, 0x8b, 0x1d, 0xc3, 0xdc, 0x93, 0xc7, 0x30, 0x42, 0xe9,
-	0x53, 0xbb, 0x7d, 0xd9, 0x57, 0xc7, 0xae, 0x9d, 0x38, 0x91, 0xc9, 0xf1, 0x11, 0xdf, 0x78, 0x06,
-	0x3e, 0xe2, 0xc5, 0x41, 0x7d, 0xc4, 0xb7, 0xa0, 0x8e, 0x5d, 0xce, 0x0c, 0x34, 0x14, 0x0c, 0x2f,
-	0xf2, 0xef, 0xcf, 0xc0, 0xd1, 0x5d, 0x98, 0x0b, 0x44, 0x6e, 0xd2, 0x3c, 0x11, 0x0a, 0x67, 0x7e,
-	0x61, 0xda, 0x0b, 0xfd, 0xd2, 0x60, 0x5e, 0x68, 0xf4, 0x29, 0x54, 0xa5, 0xbb, 0x55, 0x74, 0xf6,
-	0xe5, 0x73, 0xba, 0x39, 0xe3, 0xc4, 0xe8, 0x3b, 0x30, 0x8b, 0x0d, 0xc3, 0x64, 0x35, 0x73, 0x8f,
-	0x2b, 0xc5, 0xa6, 0x4d, 0xbc, 0x73, 0x3b, 0x5d, 0x72, 0x2b, 0x41, 0xdb, 0x50, 0x93, 0x3e, 0x4b,
-	0xc9, 0xde, 0xaf, 0x9e, 0xaf, 0xd6, 0x24, 0x35, 0x33, 0x58, 0x13, 0xfe, 0xdd, 0xd7, 0x7a, 0x1b,
-	0xac, 0x09, 0x97, 0xee, 0x1b, 0xe2, 0xf6, 0xee, 0xcd, 0x9e, 0x64, 0xfc, 0xde, 0xee, 0x1f, 0x94,
-	0xe0, 0x52, 0x97, 0xc5, 0x7b, 0xa1, 0xc7, 0x61, 0x89, 0x63, 0x9c, 0xe1, 0xa2, 0xc7, 0x38, 0x6a,
-	0x0b, 0x94, 0x6e, 0x0b, 0xb0, 0xcf, 0xee, 0xcd, 0xc3, 0x98, 0xdf, 0x39, 0x3c, 0x34, 0x4f, 0x64,
-	0xff, 0xe4, 0x9b, 0xfa, 0x39, 0xdc, 0x88, 0x5c, 0x55, 0x1b, 0xf6, 0xf1, 0xb6, 0x79, 0x42, 0xbc,
-	0x15, 0x03, 0xbb, 0x74, 0xb0, 0x7b, 0xa5, 0xea, 0x5f, 0x96, 0xe0, 0x52, 0x17, 0x27, 0x58, 0x9f,
-	0x9f, 0xf0, 0x3e, 0x54, 0xa4, 0x3b, 0x93, 0xeb, 0x30, 0xbd, 0x4f, 0x32, 0xe2, 0xe8, 0x4c, 0xc7,
-	0x92, 0xa7, 0x10, 0xdc, 0x42, 0x17, 0x97, 0xd8, 0xe2, 0x20, 0xd5, 0x00, 0xb4, 0xe5, 0x60, 0xa3,
-	0xd1, 0x22, 0x86, 0x11, 0x69, 0xf6, 0xb7, 0xa0, 0x6e, 0x61, 0x4a, 0x6c, 0xfd, 0x74, 0xbf, 0xe5,
-	0x11, 0xbf, 0xe5, 0x58, 0x86, 0x54, 0xf2, 0x33, 0x70, 0xa4, 0xc2, 0x48, 0xdb, 0x31, 0x04, 0x0b,
-	0x4c, 0x2e, 0x4f, 0x46, 0x13, 0xcd, 0xa0, 0x1a, 0x2f, 0x53, 0x3d, 0x80, 0xc8, 0x41, 0xd5, 0xe7,
-	0x48, 0x2c, 0xc1, 0x08, 0x53, 0xdf, 0x0b, 0x0c, 0x01, 0xc7, 0x53, 0x7f, 0x0d, 0x66, 0x72, 0xdc,
-	0x7a, 0x7d, 0x36, 0x2e, 0x6c, 0xe7, 0xcd, 0xad, 0xd5, 0x02, 0xcd, 0x4b, 0x4c, 0xf5, 0xbf, 0x87,
-	0xe0, 0x2a, 0xe7, 0xac, 0x98, 0x15, 0xc7, 0x59, 0x2c, 0xe0, 0x88, 0x5d, 0xa8, 0x1d, 0x85, 0xcc,
-	0xc2, 0xf4, 0x67, 0xd1, 0xa1, 0x6f, 0xe4, 0x39, 0x54, 0x73, 0xb9, 0x54, 0x4b, 0xd2, 0xa3, 0x87,
-	0x00, 0x91, 0x27, 0x45, 0xf6, 0xf4, 0xd5, 0x84, 0x1b, 0x44, 0x96, 0xe5, 0x54, 0x15, 0xa3, 0x44,
-	0xf7, 0x61, 0xd4, 0xa7, 0x86, 0xe9, 0xc8, 0xc5, 0x1b, 0xdb, 0xfb, 0x1b, 0x0c, 0x9c, 0x43, 0x2d,
-	0xf0, 0xd1, 0x26, 0x54, 0x7c, 0x8a, 0xf5, 0x23, 0xc3, 0x33, 0x8f, 0x49, 0xce, 0x09, 0x76, 0x23,
-	0x2a, 0xcc, 0xa9, 0x24, 0x4e, 0x8b, 0x56, 0x61, 0xb2, 0xe3, 0x93, 0x00, 0x41, 0x5b, 0xf7, 0xa5,
-	0x01, 0x76, 0xa6, 0xe7, 0x3f, 0x49, 0xa1, 0xfe, 0x6c, 0x08, 0x2e, 0xf3, 0x76, 0x02, 0x63, 0xfd,
-	0x97, 0xc3, 0xff, 0x75, 0x0e, 0xff, 0xdf, 0x96, 0xa0, 0xc2, 0xdb, 0x91, 0x03, 0xfe, 0x16, 0x8c,
-	0x09, 0x47, 0xa2, 0x1c, 0xe9, 0x2b, 0x31, 0x67, 0x74, 0x34, 0x4b, 0x81, 0x2d, 0x25, 0x50, 0xd1,
-	0xfb, 0x50, 0x0e, 0xbd, 0x69, 0x72, 0x4c, 0xaf, 0xa7, 0xe8, 0xc2, 0xf5, 0x15, 0xb8, 0xf7, 0x42,
-	0x02, 0xb4, 0x0a, 0x13, 0x58, 0xce, 0xba, 0x1c, 0xcd, 0x57, 0xbb, 0x11, 0x27, 0xb9, 0x43, 0x0b,
-	0xe9, 0xd4, 0x9f, 0x8e, 0xc0, 0x74, 0xa6, 0x7f, 0x3f, 0x77, 0xde, 0x0c, 0xe9, 0xa5, 0x18, 0xe9,
-	0xc7, 0x4b, 0x11, 0x93, 0x89, 0xa3, 0x7d, 0x6c, 0xfe, 0x63, 0xf1, 0xcd, 0xff, 0x62, 0x2f, 0x1d,
-	0xa7, 0xed, 0x9d, 0x89, 0x2e, 0xf6, 0xce, 0xb7, 0x62, 0xf3, 0x2c, 0x5c, 0x1e, 0x2f, 0xe5, 0x32,
-	0x57, 0xb7, 0x49, 0x46, 0x1a, 0xcc, 0xfb, 0xc4, 0x67, 0xfb, 0x44, 0x60, 0xa9, 0x6d, 0x14, 0x76,
-	0x83, 0x74, 0xa1, 0x4c, 0xea, 0x41, 0x95, 0xc2, 0x7a, 0xd0, 0xbf, 0x03, 0xcc, 0xe6, 0xf1, 0x75,
-	0x2e, 0xcb, 0x0d, 0x5d, 0x00, 0xcb, 0x0d, 0x17, 0x60, 0xb9, 0x91, 0xee, 0x2c, 0x37, 0x3a, 0x20,
-	0xcb, 0x8d, 0x9d, 0xdb, 0xcf, 0x34, 0x7e, 0x1e, 0x3f, 0x53, 0xc8, 0xa6, 0x13, 0x71, 0x36, 0xfd,
-	0x08, 0xaa, 0x96, 0x83, 0x0d, 0x5f, 0xea, 0x3d, 0x92, 0x69, 0x62, 0xc7, 0x63, 0x59, 0xad, 0x48,
-	0x4b, 0x50, 0xfc, 0xdc, 0x5e, 0x2b, 0x4d, 0x2f, 0x99, 0x6a, 0xd7, 0xe8, 0x80, 0x8c, 0x35, 0x3b,
-	0xf5, 0x0c, 0xac, 0xd9, 0xfa, 0xa0, 0xd6, 0x6c, 0x74, 0x6c, 0x31, 0x5d, 0xf8, 0xd8, 0x82, 0xbb,
-	0xe3, 0x5d, 0xc7, 0xa3, 0xab, 0x98, 0xea, 0xad, 0x6d, 0x7c, 0xb2, 0x6f, 0xb6, 0x83, 0xab, 0x98,
-	0x39, 0x25, 0xcc, 0x0a, 0x4e, 0x42, 0x37, 0x6c, 0xea, 0x99, 0x44, 0x9c, 0xe6, 0xd6, 0xb4, 0xfc,
-	0xc2, 0xe4, 0xfa, 0xae, 0x15, 0xbe, 0xae, 0xd6, 0x5d, 0xd4, 0x4c, 0xf6, 0x2d, 0x6a, 0x7a, 0xb9,
-	0xcb, 0x66, 0x9f, 0x87, 0xbb, 0x6c, 0xee, 0x6b, 0x88, 0x5e, 0x98, 0x1f, 0xf0, 0x5a, 0xac, 0x05,
-	0x28, 0x7b, 0x10, 0xde, 0xa7, 0x91, 0xb0, 0x08, 0x15, 0x19, 0x3d, 0xc9, 0xad, 0x2d, 0x61, 0x73,
-	0xc6, 0x41, 0xea, 0x0f, 0x47, 0x60, 0x6a, 0xc7, 0x31, 0xc8, 0x4a, 0x93, 0xd8, 0x74, 0x40, 0x83,
-	0x84, 0x4b, 0xc2, 0xa1, 0xbe, 0x24, 0xe1, 0x70, 0x5c, 0x12, 0xa6, 0xe5, 0xd8, 0x48, 0xdf, 0x72,
-	0x2c, 0x35, 0x35, 0xa3, 0x03, 0x7a, 0x85, 0x7a, 0xf1, 0xf4, 0xd8, 0xf3, 0xe0, 0xe9, 0xf1, 0x67,
-	0xc0, 0xd3, 0xea, 0x6f, 0x96, 0xe0, 0xca, 0x19, 0xa7, 0xff, 0xe8, 0xc3, 0x84, 0x89, 0x7d, 0xab,
-	0xd0, 0x95, 0x81, 0xa5, 0xed, 0xc8, 0xfc, 0xbe, 0x09, 0x23, 0xec, 0x0d, 0xd5, 0xa0, 0xbc, 0xb2,
-	0xb5, 0xb5, 0xfb, 0xf9, 0x93, 0x95, 0x9d, 0x2f, 0xeb, 0x2f, 0xa0, 0x69, 0xa8, 0x69, 0x1b, 0x1f,
-	0x6f, 0x36, 0xf6, 0xb5, 0x2f, 0x9f, 0xec, 0xee, 0x6c, 0x7d, 0x59, 0x2f, 0xa9, 0x3f, 0x9d, 0x82,
-	0x8a, 0x38, 0x14, 0x1d, 0x84, 0x39, 0x9f, 0x89, 0xa2, 0xd2, 0x45, 0xef, 0x4d, 0x2b, 0x33, 0x23,
-	0x39, 0xca, 0xcc, 0x39, 0x82, 0x76, 0x73, 0x34, 0xda, 0xbb, 0x30, 0xee, 0x8b, 0x1b, 0x27, 0x45,
-	0x02, 0x5c, 0x24, 0x2a, 0x7a, 0x19, 0x6a, 0xfc, 0x24, 0xbf, 0x81, 0xdb, 0x2e, 0xdb, 0xd5, 0xb8,
-	0xfa, 0x51, 0xd2, 0x92, 0xc0, 0x7e, 0x03, 0x75, 0x73, 0xae, 0x72, 0x42, 0xfe, 0x55, 0x4e, 0xa9,
-	0xa3, 0x55, 0xfa, 0xd1, 0xd1, 0xd2, 0x92, 0xa1, 0xda, 0xb7, 0x64, 0xd0, 0xe1, 0xc6, 0x51, 0x70,
-	0x15, 0x9f, 0xa9, 0x0c, 0xc4, 0x3b, 0xe6, 0xb2, 0xd6, 0x26, 0x3a, 0x6b, 0x78, 0xa5, 0x49, 0xe4,
-	0xfe, 0x79, 0xc6, 0x41, 0x65, 0xaf, 0x1a, 0xd0, 0x16, 0xd4, 0x0d, 0xe2, 0x5a, 0xce, 0x69, 0x9b,
-	0xd8, 0x54, 0x9c, 0xcb, 0xc9, 0x1d, 0xb5, 0xb7, 0x7c, 0xcc, 0x50, 0x66, 0xee, 0x75, 0x4d, 0xf5,
-	0x75, 0xaf, 0xab, 0x97, 0x0c, 0xab, 0x3f, 0x0f, 0x19, 0x36, 0xfd, 0x2c, 0xf6, 0xe5, 0x77, 0xa0,
-	0xac, 0x87, 0x17, 0xb9, 0x50, 0xef, 0x7b, 0x7d, 0x21, 0x32, 0xba, 0x07, 0xe3, 0xd2, 0x81, 0x2f,
-	0x4f, 0x1f, 0x63, 0x5a, 0x38, 0x97, 0x45, 0xc9, 0xcb, 0x84, 0x01, 0x72, 0x4c, 0x31, 0x9c, 0x2d,
-	0xac, 0x18, 0xca, 0x6d, 0x73, 0xee, 0x3c, 0xdb, 0x66, 0xe4, 0xb6, 0x98, 0x4f, 0xbb, 0x2d, 0x78,
-	0xf7, 0x72, 0xdd, 0x16, 0x39, 0xda, 0xb5, 0xf2, 0x0c, 0xb4, 0xeb, 0xcb, 0x17, 0x1c, 0x4f, 0xb0,
-	0x30, 0xe0, 0x9e, 0xbd, 0x0d, 0x35, 0xec, 0xba, 0xb1, 0x0b, 0x81, 0x57, 0xce, 0x79, 0x3e, 0x92,
-	0xa0, 0x46, 0x2d, 0x78, 0x51, 0xec, 0x29, 0x7b, 0x6c, 0x4a, 0x75, 0xc7, 0x6a, 0xd8, 0x26, 0xe3,
-	0x40, 0xf6, 0x5d, 0xc1, 0xde, 0x27, 0x8f, 0x07, 0xcf, 0x9a, 0xfd, 0xde, 0x95, 0xa0, 0x43, 0x58,
-	0xec, 0x8a, 0xb4, 0x69, 0x8b, 0x86, 0xae, 0xf5, 0x6c, 0xa8, 0x67, 0x1d, 0x39, 0xb6, 0xde, 0xf5,
-	0x01, 0x6c, 0xbd, 0x6f, 0x41, 0x55, 0xac, 0x23, 0x71, 0x35, 0x40, 0x1e, 0x47, 0xa6, 0x19, 0x74,
-	0x2d, 0x86, 0xa2, 0x25, 0x08, 0xd4, 0xbf, 0x2e, 0x01, 0xca, 0xae, 0x31, 0x7e, 0xbf, 0x58, 0x00,
-	0x82, 0x7b, 0x2b, 0x25, 0x79, 0xbf, 0x38, 0x01, 0x45, 0x9f, 0xc1, 0x9c, 0x19, 0x12, 0x52, 0xc6,
-	0x61, 0xc4, 0xdb, 0x8e, 0x34, 0x95, 0x58, 0x3a, 0x82, 0x5c, 0x34, 0x2d, 0x9f, 0x9a, 0xed, 0xe9,
-	0x41, 0x81, 0x85, 0x7d, 0x5f, 0xea, 0xab, 0x09, 0x98, 0xba, 0x09, 0xd3, 0x99, 0xd5, 0xd7, 0xe7,
-	0xa9, 0xcd, 0x8f, 0x4a, 0x30, 0x95, 0xf6, 0xb5, 0xf4, 0xa7, 0xf8, 0xbc, 0x0e, 0x43, 0xc7, 0x77,
-	0xa4, 0xaa, 0x13, 0x9b, 0x85, 0xb0, 0xf2, 0xc7, 0x77, 0xa4, 0x98, 0x18, 0x3a, 0xbe, 0xc3, 0x91,
-	0x97, 0xa5, 0x57, 0x32, 0x17, 0x79, 0x39, 0x44, 0x5e, 0x66, 0x9f, 0x9b, 0xa9, 0xa5, 0xcf, 0xcf,
-	0xfd, 0x49, 0x29, 0x5e, 0xd7, 0xf2, 0x40, 0x1f, 0xbc, 0x91, 0xe3, 0xf2, 0x7e, 0x25, 0xf7, 0x5b,
-	0x22, 0xef, 0x77, 0x8e, 0xc7, 0xfb, 0x51, 0xd2, 0x71, 0x9d, 0xf1, 0xd4, 0xc6, 0xea, 0xe1, 0x3e,
-	0xec, 0x75, 0x8e, 0x97, 0xe3, 0xb7, 0x56, 0x1b, 0x70, 0xe5, 0x8c, 0x46, 0xfb, 0x1c, 0xb1, 0xbf,
-	0x1f, 0x82, 0xab, 0x67, 0x75, 0xa1, 0xcf, 0xc1, 0xbb, 0x1b, 0x5d, 0x68, 0x2f, 0x10, 0xa1, 0x14,
-	0xdc, 0x66, 0x7f, 0x00, 0x10, 0x5d, 0x0a, 0x2f, 0x10, 0x64, 0x13, 0xc3, 0x46, 0xf7, 0x60, 0x82,
-	0x3a, 0xae, 0x63, 0x39, 0xcd, 0xd3, 0x02, 0xb1, 0x34, 0x21, 0x2e, 0x7a, 0xc4, 0xaf, 0xca, 0x1d,
-	0x9a, 0xcd, 0xdd, 0x63, 0xe2, 0x79, 0xa6, 0x51, 0x3c, 0x0a, 0x33, 0x45, 0xa7, 0x6e, 0xc8, 0x65,
-	0x1b, 0x97, 0x49, 0xe8, 0x4d, 0x98, 0xf1, 0x3b, 0x07, 0xbe, 0xee, 0x99, 0x07, 0xc4, 0x88, 0x42,
-	0x47, 0x84, 0xcc, 0xc9, 0x2b, 0x52, 0xbf, 0x0f, 0x95, 0xd8, 0xed, 0x18, 0x84, 0x60, 0xc4, 0x66,
-	0x26, 0xb7, 0xa0, 0xe0, 0xcf, 0x61, 0x3c, 0xea, 0x50, 0x2c, 0x1e, 0x75, 0x01, 0x26, 0x98, 0x5e,
-	0xba, 0x17, 0xc5, 0xa9, 0x86, 0xef, 0xe8, 0x3a, 0x80, 0xc8, 0x3d, 0xc4, 0x4b, 0x47, 0x78, 0x69,
-	0x0c, 0xa2, 0xfe, 0xcb, 0x38, 0xd4, 0x33, 0xfc, 0x14, 0xde, 0x70, 0x8d, 0x4a, 0x82, 0x4e, 0x16,
-	0xe0, 0x84, 0xae, 0xb4, 0x7d, 0x06, 0xaf, 0xa5, 0xed, 0x9c, 0xe1, 0x2e, 0x76, 0x8e, 0x8c, 0x67,
-	0x19, 0xc9, 0xa4, 0x3f, 0x1a, 0x8d, 0xee, 0x37, 0x5f, 0x65, 0x96, 0x09, 0x25, 0x76, 0x98, 0x13,
-	0xa0, 0xac, 0x45, 0x80, 0x8c, 0x69, 0x30, 0xde, 0xb7, 0x69, 0xb0, 0x02, 0x93, 0xbe, 0xee, 0x61,
-	0xae, 0xb5, 0x10, 0xef, 0x18, 0x5b, 0x32, 0xf8, 0xe8, 0x0c, 0x4b, 0x20, 0x45, 0xc0, 0xfd, 0x2e,
-	0x8e, 0x4d, 0xc9, 0x09, 0xdd, 0xc3, 0xb4, 0xc5, 0xcd, 0xa8, 0xb2, 0x16, 0x07, 0xc5, 0x55, 0x4c,
-	0x48, 0xab, 0x98, 0xd9, 0x24, 0x6d, 0x91, 0x8a, 0xf9, 0x1e, 0x8c, 0xcb, 0x3b, 0x45, 0xd2, 0x82,
-	0x7a, 0x31, 0xef, 0x38, 0x4f, 0xee, 0x86, 0x01, 0xb1, 0xa4, 0x40, 0x1f, 0xc2, 0x84, 0x2f, 0x03,
-	0xd0, 0xa4, 0xe9, 0xa4, 0xe6, 0x53, 0x0b, 0x9c, 0xe0, 0x48, 0x22, 0xa0, 0xb9, 0xe0, 0x3c, 0x1d,
-	0x3d, 0x4d, 0x91, 0xc9, 0xe7, 0x61, 0x8a, 0x4c, 0x3d, 0x0b, 0x53, 0x24, 0x61, 0x54, 0xd7, 0x0b,
-	0x9f, 0xbb, 0xfc, 0x59, 0x09, 0xae, 0x9e, 0x75, 0x46, 0xdb, 0xa7, 0x94, 0xdf, 0x85, 0xb9, 0xb6,
-	0x08, 0xb9, 0xdf, 0x38, 0x71, 0x4d, 0xef, 0x34, 0xbc, 0x9a, 0x3b, 0xd4, 0x8b, 0xcf, 0xf3, 0xe9,
-	0xd4, 0x3d, 0x50, 0xba, 0x71, 0x4f, 0x9f, 0xfb, 0xdb, 0x8f, 0x4b, 0x70, 0xa9, 0x0b, 0x3b, 0xa7,
-	0x93, 0x0c, 0x96, 0xfa, 0x49, 0x32, 0xb8, 0x11, 0x13, 0xbb, 0x43, 0xe9, 0x43, 0xf6, 0x4c, 0xc3,
-	0x3b, 0x12, 0x35, 0x58, 0x10, 0x01, 0xa9, 0x7a, 0x04, 0x37, 0x7a, 0x20, 0xf7, 0x9f, 0xce, 0x20,
-	0xdc, 0x2a, 0x6a, 0x62, 0xab, 0x50, 0xff, 0xa8, 0x06, 0x95, 0x58, 0xa0, 0x49, 0xbc, 0xe6, 0x97,
-	0x8a, 0xd7, 0xfc, 0x32, 0xd4, 0xb0, 0xae, 0x13, 0xdf, 0xdf, 0x72, 0x9a, 0x0f, 0x4d, 0x2b, 0xd8,
-	0xa1, 0x92, 0x40, 0x74, 0x13, 0xa6, 0x22, 0x80, 0xe3, 0xb5, 0x71, 0x90, 0x59, 0x21, 0x0d, 0x46,
-	0x9b, 0x30, 0x1d, 0x82, 0x36, 0x6c, 0xdd, 0x31, 0x02, 0x1d, 0x60, 0x32, 0xae, 0x42, 0x66, 0x50,
-	0xb4, 0x2c, 0x15, 0xdb, 0xef, 0x70, 0x87, 0x3a, 0x22, 0x8a, 0x4a, 0xee, 0x05, 0x31, 0x08, 0xeb,
-	0xba, 0x74, 0x5d, 0xcb, 0x10, 0x14, 0xb1, 0x39, 0x24, 0x81, 0xe8, 0x0d, 0x98, 0xd6, 0x9d, 0xb6,
-	0xeb, 0xd8, 0xc4, 0xa6, 0x5b, 0x41, 0x52, 0x40, 0xb1, 0x5d, 0x64, 0x0b, 0xa4, 0xa4, 0xd6, 0x3b,
-	0x9e, 0x47, 0x6c, 0xfd, 0x94, 0xef, 0x1a, 0x35, 0x2d, 0x0e, 0x62, 0xdb, 0x81, 0x61, 0xfb, 0x1a,
-	0x39, 0xf4, 0x88, 0xdf, 0xd2, 0x30, 0x25, 0x05, 0xb6, 0x83, 0x24, 0x41, 0x14, 0x33, 0xca, 0x33,
-	0x85, 0x75, 0xda, 0xae, 0x74, 0xac, 0x15, 0x88, 0x19, 0x0d, 0x28, 0xd0, 0x0e, 0xcc, 0x90, 0x58,
-	0xb2, 0x8c, 0xc0, 0x0a, 0xca, 0x6c, 0x1e, 0xd9, 0x8c, 0x1a, 0x5a, 0x1e, 0x21, 0xfa, 0x10, 0x2a,
-	0x1c, 0xdc, 0xa0, 0x98, 0xfa, 0x86, 0xdc, 0x4c, 0xce, 0xae, 0x27, 0x4e, 0xc0, 0x34, 0x24, 0x99,
-	0xff, 0x51, 0x1a, 0x92, 0xe2, 0xa2, 0xa4, 0x88, 0x48, 0xcd, 0x2b, 0x62, 0x3c, 0x15, 0x80, 0xf7,
-	0xe4, 0x95, 0x72, 0x19, 0xa1, 0x9a, 0x02, 0x47, 0x5e, 0xcf, 0xc9, 0xb8, 0xd7, 0xf3, 0x4d, 0x98,
-	0x31, 0xed, 0x6c, 0x8b, 0x53, 0xa2, 0xc5, 0x9c, 0x22, 0x1e, 0x13, 0x6b, 0x27, 0x5b, 0xac, 0xcb,
-	0x98, 0xd8, 0x24, 0x18, 0x2d, 0x01, 0x3a, 0xea, 0x1c, 0x90, 0x63, 0xd3, 0xa3, 0xa1, 0xc4, 0x10,
-	0xd9, 0x56, 0xca, 0x5a, 0x4e, 0x49, 0x22, 0xe1, 0x24, 0x4a, 0x26, 0x9c, 0x64, 0xea, 0xb0, 0xeb,
-	0x99, 0xc7, 0xa6, 0x45, 0x9a, 0xc4, 0x90, 0x0e, 0xa4, 0x33, 0xd5, 0xe1, 0x08, 0x1b, 0xad, 0xc2,
-	0x55, 0x8f, 0x60, 0xc3, 0xb4, 0x89, 0xef, 0x6f, 0xda, 0x26, 0x35, 0xb1, 0xb5, 0x4e, 0x2c, 0x7c,
-	0xda, 0x20, 0xba, 0x63, 0x1b, 0xbe, 0x8c, 0xc1, 0x3c, 0x13, 0x47, 0x44, 0xf2, 0xc8, 0xf2, 0x3d,
-	0xe2, 0x99, 0x8e, 0x11, 0x50, 0xcf, 0x71, 0xea, 0x2e, 0xa5, 0xe8, 0x7d, 0xb8, 0x1c, 0x96, 0x3c,
-	0xc4, 0xa6, 0xd5, 0xf1, 0x48, 0x74, 0xd7, 0x6e, 0x9e, 0x93, 0x76, 0x47, 0x60, 0x8b, 0xd7, 0xa7,
-	0x98, 0x76, 0xf8, 0x9d, 0x57, 0x1e, 0xe7, 0x58, 0xd3, 0x62, 0x90, 0xe4, 0x16, 0xa8, 0x9c, 0xc3,
-	0xaf, 0x1c, 0x04, 0xa9, 0x5d, 0xe6, 0x32, 0xa5, 0x1e, 0xd1, 0x08, 0x78, 0x18, 0x9e, 0xf6, 0x00,
-	0x14, 0x57, 0x7a, 0x39, 0xd6, 0x09, 0x15, 0x4e, 0xd8, 0x20, 0x8c, 0x46, 0x04, 0x0d, 0x76, 0x2d,
-	0x47, 0xfb, 0x30, 0xc7, 0x79, 0x7b, 0x25, 0x90, 0x49, 0xc1, 0xf2, 0xba, 0x92, 0xf6, 0x66, 0x6d,
-	0x24, 0xd0, 0x82, 0x58, 0xc8, 0x5c, 0x62, 0xb4, 0x0c, 0xb3, 0x92, 0xb3, 0x03, 0xa7, 0x8e, 0xe0,
-	0xd8, 0xab, 0xbc, 0x37, 0xb9, 0x65, 0xd9, 0x70, 0x99, 0x6b, 0xe7, 0x0c, 0x97, 0xc9, 0xc6, 0x10,
-	0x5d, 0xcf, 0x8d, 0x21, 0xfa, 0x36, 0xcc, 0xbb, 0xd8, 0x23, 0x36, 0x6d, 0xb4, 0x3a, 0xd4, 0x70,
-	0x9e, 0x46, 0x2d, 0x2e, 0xf6, 0x6a, 0xb1, 0x0b, 0xa1, 0xfa, 0xeb, 0x43, 0x30, 0x9b, 0x37, 0x3e,
-	0xcf, 0x28, 0xa5, 0x4f, 0x59, 0x9a, 0x50, 0x1b, 0x79, 0x29, 0x7d, 0x5e, 0xea, 0x36, 0x65, 0x31,
-	0xd4, 0x67, 0x91, 0xd5, 0xe7, 0x5f, 0x4b, 0x70, 0xb9, 0x6b, 0x83, 0xac, 0xfb, 0xfc, 0xd8, 0x4c,
-	0x5a, 0x85, 0xec, 0x99, 0xef, 0x57, 0x96, 0x49, 0x6c, 0xba, 0x16, 0xdd, 0xa3, 0x96, 0xdf, 0x9c,
-	0x2d, 0xe0, 0x79, 0x81, 0x3d, 0xf3, 0x18, 0x53, 0xf2, 0x29, 0x39, 0x0d, 0x72, 0x40, 0x46, 0x10,
-	0x3e, 0xfd, 0x78, 0x2d, 0x7e, 0x83, 0x3b, 0x08, 0x21, 0x4b, 0x40, 0x99, 0x79, 0xe5, 0xdb, 0x66,
-	0x60, 0x5e, 0xf9, 0xb6, 0xc9, 0x84, 0xa5, 0xdf, 0x39, 0x60, 0x1b, 0xed, 0x8a, 0x25, 0xf2, 0x68,
-	0x28, 0x63, 0x3c, 0xe2, 0x27, 0x0d, 0x56, 0xbf, 0x0b, 0x53, 0xa9, 0xf8, 0xd5, 0x48, 0x62, 0x97,
-	0xba, 0x5e, 0xbb, 0x1e, 0x2d, 0xac, 0xf6, 0xae, 0xc1, 0xa5, 0x2e, 0x19, 0xf3, 0x58, 0xb7, 0x75,
-	0xb7, 0x13, 0x64, 0x73, 0xd1, 0xdd, 0x8e, 0x88, 0x82, 0x6f, 0x3b, 0xf2, 0x2a, 0x1e, 0x8f, 0x82,
-	0x67, 0x6f, 0xea, 0x1f, 0x0e, 0x41, 0x39, 0x0c, 0x99, 0xed, 0x93, 0x03, 0x15, 0x18, 0xef, 0x18,
-	0x3e, 0x37, 0xe1, 0x44, 0xe5, 0xc1, 0x2b, 0xfa, 0x10, 0xaa, 0x1d, 0x9f, 0xec, 0x30, 0x15, 0xc8,
-	0xfa, 0xe4, 0x29, 0x2d, 0xe0, 0xf4, 0x48, 0xe0, 0xa3, 0x47, 0x30, 0xdd, 0xf1, 0xc9, 0xbe, 0xd7,
-	0xf1, 0xe9, 0x53, 0xc7, 0xa3, 0xad, 0x53, 0x56, 0x49, 0x6f, 0xff, 0x47, 0x96, 0x08, 0xdd, 0x83,
-	0x51, 0xea, 0x1c, 0x11, 0xbb, 0x30, 0xbf, 0x0a, 0x74, 0xf5, 0x57, 0xa0, 0x1a, 0x8f, 0x7b, 0x61,
-	0xd6, 0x75, 0x9b, 0x99, 0xe2, 0xfc, 0x6b, 0xc5, 0xf8, 0x46, 0x80, 0xd0, 0x9d, 0x31, 0x14, 0x73,
-	0x67, 0x30, 0x89, 0xcf, 0x6b, 0x88, 0xdd, 0xe4, 0x8e, 0x41, 0xd4, 0xdf, 0x1b, 0x87, 0xc9, 0x8b,
-	0x30, 0x06, 0x32, 0x4e, 0x84, 0xa1, 0x5e, 0x87, 0xa5, 0x89, 0xdb, 0x04, 0x0f, 0x58, 0x37, 0xad,
-	0xc3, 0x86, 0xd9, 0xb4, 0x0b, 0xe5, 0x6b, 0x89, 0x61, 0xa7, 0x43, 0x9e, 0x47, 0xb3, 0x21, 0xcf,
-	0xab, 0x30, 0x61, 0xd8, 0x3e, 0x5b, 0x5a, 0x62, 0xb9, 0x24, 0x9c, 0x84, 0xc9, 0xaf, 0x5f, 0x5a,
-	0x97, 0x88, 0x32, 0x77, 0x6d, 0x40, 0xc7, 0x53, 0xd5, 0x70, 0xb7, 0xcb, 0x36, 0xf1, 0x5b, 0x32,
-	0xd8, 0x65, 0xbc, 0x40, 0xaa, 0x9a, 0x14, 0x0d, 0xfa, 0x02, 0x2e, 0x8b, 0x21, 0x8b, 0xce, 0x2b,
-	0x56, 0x4f, 0x65, 0x72, 0x93, 0x02, 0x09, 0x54, 0xba, 0x13, 0xa3, 0x4f, 0x00, 0xe9, 0x26, 0xc5,
-	0x06, 0xb1, 0x1e, 0x11, 0x6c, 0xd1, 0x16, 0x8f, 0xd2, 0x2f, 0xa0, 0xc4, 0xe6, 0x50, 0x5d, 0xe0,
-	0x2d, 0xb5, 0x7e, 0x62, 0x3c, 0xb3, 0xa7, 0x1d, 0xd5, 0x81, 0x32, 0x94, 0x4f, 0x3d, 0x75, 0xbc,
-	0x23, 0xcb, 0xc1, 0x06, 0x9b, 0xca, 0x7d, 0x6a, 0x05, 0x2a, 0x6d, 0x0a, 0x7c, 0xc1, 0x49, 0x97,
-	0x17, 0xde, 0x83, 0x5a, 0x82, 0x9b, 0xce, 0x95, 0x29, 0xeb, 0x8f, 0x4b, 0x50, 0xbb, 0x78, 0x93,
-	0x5a, 0x85, 0x6a, 0x10, 0x9a, 0xb5, 0x17, 0x99, 0xae, 0x09, 0x58, 0x28, 0x46, 0x86, 0x93, 0x5e,
-	0xd1, 0x74, 0x5a, 0x42, 0xf5, 0x47, 0x65, 0x98, 0xcb, 0xcd, 0xa9, 0xd1, 0xa7, 0x04, 0x39, 0x73,
-	0x65, 0x0c, 0x0d, 0xb2, 0x32, 0x8a, 0xdd, 0x60, 0xea, 0x9f, 0xc7, 0xbf, 0x84, 0x19, 0x9b, 0x1c,
-	0x13, 0x39, 0x0c, 0x7d, 0xe6, 0xfe, 0xd5, 0xf2, 0xea, 0xe0, 0x61, 0x69, 0xd6, 0x53, 0x7c, 0xea,
-	0xa7, 0xea, 0xae, 0x9e, 0x37, 0x2c, 0x2d, 0xa7, 0x92, 0x9e, 0xbe, 0xbd, 0xda, 0xf3, 0xf0, 0xed,
-	0x4d, 0x7e, 0x1d, 0xa9, 0x04, 0xa7, 0xba, 0xde, 0x6e, 0x9d, 0xf1, 0xc8, 0x53, 0xcf, 0xa4, 0x64,
-	0xc5, 0x75, 0x1f, 0xed, 0xef, 0xef, 0xed, 0x79, 0xce, 0x41, 0x70, 0x1b, 0xf5, 0xcc, 0xcc, 0x28,
-	0x39, 0x64, 0xa9, 0x5d, 0x6d, 0xfa, 0xbc, 0xbb, 0x9a, 0xc9, 0x67, 0x8b, 0x7f, 0x88, 0x5c, 0x78,
-	0x71, 0x10, 0xd2, 0x98, 0xa9, 0xcd, 0x5e, 0x49, 0x42, 0x54, 0x16, 0xcd, 0x24, 0x94, 0x47, 0x9c,
-	0x54, 0x06, 0x67, 0x0b, 0x1b, 0x80, 0x8f, 0x60, 0xd2, 0x39, 0x48, 0xf0, 0x67, 0xd1, 0xab, 0x12,
-	0x29, 0xba, 0x8b, 0xbe, 0xa7, 0xf9, 0xdb, 0x25, 0xb8, 0xd4, 0x25, 0xfa, 0xa5, 0x4f, 0x29, 0xf5,
-	0x21, 0x54, 0x9d, 0x0e, 0x75, 0x3b, 0x54, 0xe6, 0xa2, 0x1a, 0x2a, 0x90, 0x2c, 0x28, 0x86, 0xaf,
-	0xfe, 0xd6, 0x10, 0x5c, 0x3b, 0x33, 0xa0, 0xa6, 0xcf, 0x7e, 0xbd, 0xc5, 0xe3, 0xdc, 0x5a, 0xb2,
-	0x3f, 0x37, 0x72, 0xa3, 0x77, 0x56, 0x3a, 0x34, 0x4a, 0x14, 0xd8, 0xa1, 0x2d, 0xf4, 0x6e, 0x68,
-	0xb8, 0xe7, 0xc4, 0x0c, 0x85, 0x64, 0xb9, 0x89, 0x66, 0x36, 0xf8, 0x1d, 0x02, 0x4a, 0x4e, 0xe8,
-	0xc7, 0x1e, 0x76, 0x5b, 0x52, 0x67, 0xcb, 0xaf, 0x60, 0x2d, 0x86, 0xa8, 0x25, 0xc8, 0xd4, 0x3f,
-	0x2d, 0xc1, 0x5c, 0x6e, 0x0f, 0xd1, 0x2a, 0x4c, 0x62, 0xd7, 0x5d, 0xf3, 0x88, 0x41, 0x6c, 0x6a,
-	0x62, 0xcb, 0x2f, 0x30, 0x1a, 0x29, 0x0a, 0x66, 0x77, 0x60, 0xd7, 0x64, 0x46, 0x98, 0xb4, 0x3b,
-	0xc4, 0x1b, 0x5a, 0x8a, 0x02, 0xc6, 0x75, 0x3d, 0x54, 0xa8, 0xc5, 0xee, 0x90, 0x53, 0xa2, 0xfe,
-	0x2a, 0xe3, 0xa2, 0xdc, 0xf1, 0xe8, 0x73, 0xb6, 0xde, 0x80, 0x69, 0x1f, 0xb7, 0x5d, 0x7e, 0xe7,
-	0xe3, 0x00, 0x8b, 0x2c, 0x81, 0x72, 0x33, 0xce, 0x16, 0xa8, 0xbb, 0x89, 0xe6, 0xe3, 0xa3, 0xd9,
-	0xa7, 0xe7, 0xfe, 0x6f, 0x4a, 0x50, 0x4d, 0x7c, 0xc5, 0x7d, 0x18, 0x37, 0x30, 0xc5, 0x86, 0xd3,
-	0xcc, 0x66, 0xce, 0x14, 0x88, 0xeb, 0xa2, 0x38, 0x38, 0xad, 0x92, 0xd8, 0xe8, 0x03, 0x28, 0x5b,
-	0x66, 0xb3, 0x45, 0x7d, 0x4a, 0xdc, 0x2c, 0xef, 0x09, 0xd2, 0x2d, 0x86, 0xd0, 0xa0, 0xc4, 0x0d,
-	0x02, 0xad, 0x42, 0x0a, 0x74, 0x17, 0xc6, 0x7e, 0x60, 0xba, 0x47, 0x66, 0x90, 0xf6, 0xf1, 0x6a,
-	0x9a, 0xf6, 0x2b, 0x5e, 0x1a, 0xf0, 0x9e, 0xc0, 0x55, 0x6f, 0xc3, 0x4c, 0x4e, 0xa7, 0x98, 0x25,
-	0x88, 0x65, 0x3a, 0x1c, 0xa1, 0x62, 0x05, 0xaf, 0xea, 0x9f, 0x97, 0x60, 0x2e, 0xb7, 0x2f, 0xdd,
-	0x69, 0x98, 0x00, 0x16, 0xde, 0xef, 0x7d, 0x6e, 0xb9, 0xc9, 0x6b, 0xd9, 0x31, 0x10, 0xff, 0xd5,
-	0x03, 0xab, 0x33, 0xce, 0x3d, 0x31, 0x08, 0x5a, 0x86, 0x31, 0x7e, 0x2a, 0x47, 0x0a, 0x18, 0x34,
-	0x12, 0x53, 0x5d, 0x02, 0x94, 0xfd, 0xf0, 0x33, 0xbe, 0xec, 0xc7, 0x63, 0x50, 0x93, 0xf9, 0x06,
-	0x07, 0x62, 0xc8, 0x77, 0xa2, 0xa3, 0xce, 0x4c, 0xb4, 0x9c, 0xac, 0xbf, 0xcb, 0x61, 0xe7, 0xdb,
-	0x30, 0xf6, 0x3d, 0x4c, 0x9a, 0xa1, 0x0c, 0xb9, 0x96, 0x21, 0xfc, 0x84, 0x17, 0x07, 0x73, 0x28,
-	0x90, 0x2f, 0xf0, 0xfe, 0xf8, 0x02, 0x4c, 0xb8, 0x9e, 0x73, 0x6c, 0x1a, 0xc4, 0x93, 0xc6, 0x5f,
-	0xf8, 0x8e, 0xee, 0x44, 0x27, 0xb1, 0x63, 0xe9, 0xec, 0xd9, 0x5d, 0xce, 0x5f, 0xdf, 0x0e, 0x59,
-	0x72, 0xbc, 0xcb, 0xf7, 0xe4, 0xf1, 0x24, 0x5a, 0x01, 0x70, 0x5c, 0x62, 0xeb, 0xc4, 0xf6, 0x3b,
-	0x41, 0x26, 0xcc, 0x17, 0x33, 0xa4, 0xbb, 0x21, 0x4a, 0x70, 0x9d, 0x25, 0x22, 0x2a, 0x70, 0x20,
-	0xfd, 0x8b, 0xa3, 0xb0, 0xa5, 0xf4, 0x80, 0xa9, 0x01, 0xf5, 0x80, 0x7f, 0x28, 0xc1, 0xa5, 0x2e,
-	0x53, 0x10, 0x5c, 0x6b, 0x28, 0x65, 0xae, 0x35, 0x0c, 0x45, 0xd7, 0x1a, 0x1e, 0x41, 0x99, 0x9c,
-	0xb8, 0x8e, 0x17, 0x8b, 0x08, 0xbd, 0x75, 0xc6, 0xe4, 0x6e, 0x04, 0xb8, 0x81, 0xc4, 0x0b, 0x89,
-	0x93, 0x29, 0x56, 0x46, 0xfb, 0x4a, 0xb1, 0xa2, 0x1e, 0xc2, 0x62, 0xaf, 0x26, 0x99, 0xb5, 0x18,
-	0xbf, 0x1b, 0x55, 0xd8, 0x5a, 0x8c, 0xdf, 0x8a, 0xfa, 0x49, 0x09, 0x66, 0xf3, 0x16, 0x7f, 0x9f,
-	0x32, 0x26, 0x65, 0xc0, 0x0e, 0xf5, 0x63, 0xc0, 0x86, 0xbf, 0xb4, 0x1b, 0x8e, 0xff, 0xd2, 0xae,
-	0x9f, 0xdf, 0xd1, 0xfd, 0xc9, 0x90, 0xd8, 0x45, 0x52, 0x02, 0xaa, 0x10, 0x3b, 0xbc, 0x17, 0xfa,
-	0x33, 0x87, 0xd3, 0x8e, 0xec, 0x44, 0x95, 0xdb, 0x1c, 0x29, 0x90, 0x14, 0x82, 0x84, 0xfb, 0x70,
-	0x5d, 0x6c, 0x37, 0xa8, 0xe3, 0xe1, 0x26, 0x61, 0x5d, 0x94, 0xee, 0xdf, 0x34, 0x98, 0x0d, 0xb3,
-	0x4b, 0x3c, 0xdf, 0xf4, 0x69, 0x91, 0x00, 0x5b, 0x89, 0x8a, 0x6e, 0x41, 0xdd, 0x17, 0x95, 0x44,
-	0x49, 0x16, 0xc5, 0xd1, 0x6a, 0x06, 0xce, 0x4f, 0x73, 0xf9, 0x8e, 0xc6, 0xaf, 0x5f, 0xca, 0x7f,
-	0xd0, 0x45, 0x10, 0xf5, 0x01, 0x5c, 0xee, 0xfa, 0x41, 0xe8, 0x1a, 0x40, 0x1b, 0x9f, 0x3c, 0xe1,
-	0x0a, 0xa1, 0x2f, 0xff, 0xe3, 0x57, 0x6e, 0xe3, 0x13, 0xbe, 0x81, 0xf9, 0xea, 0x5f, 0x45, 0x03,
-	0x9c, 0xd8, 0xcc, 0x8a, 0x0c, 0xf0, 0x1b, 0x30, 0xed, 0x32, 0x13, 0xaa, 0x41, 0xb1, 0x47, 0x3b,
-	0x2e, 0x3f, 0x3a, 0x93, 0x71, 0x1c, 0xd9, 0x02, 0x74, 0x15, 0xca, 0xdf, 0xef, 0x10, 0xef, 0x34,
-	0xbc, 0x82, 0x55, 0xd3, 0x22, 0x40, 0x9f, 0x0e, 0x6e, 0xa4, 0x42, 0xf5, 0x7b, 0xf8, 0x18, 0xef,
-	0xba, 0xd4, 0x7f, 0x44, 0xb0, 0x2b, 0xb2, 0xcf, 0x6b, 0x09, 0x18, 0xdb, 0x7a, 0xda, 0xf8, 0xa4,
-	0xe1, 0x62, 0x19, 0xaf, 0x5c, 0xd3, 0xc2, 0x77, 0xf4, 0x36, 0x8c, 0xb0, 0x6d, 0xaa, 0xeb, 0x56,
-	0x20, 0xc6, 0x64, 0xc7, 0x31, 0x82, 0x1d, 0x88, 0xa3, 0xab, 0xdf, 0x0c, 0x05, 0x55, 0x1a, 0x01,
-	0x21, 0x18, 0xd1, 0xdd, 0x4e, 0x30, 0xd2, 0xfc, 0x59, 0xfd, 0xdd, 0x12, 0xcc, 0x7c, 0x6a, 0x62,
-	0xcb, 0xbc, 0x10, 0x27, 0xee, 0x15, 0x28, 0x33, 0xed, 0xe5, 0xc9, 0xa1, 0x69, 0x05, 0x2e, 0xa9,
-	0x09, 0x06, 0x90, 0xd7, 0x0d, 0xea, 0xf2, 0x0c, 0xe3, 0xc9, 0x11, 0x39, 0x15, 0x38, 0xc3, 0xf2,
-	0x4f, 0x7f, 0xe1, 0xd9, 0x06, 0xc3, 0x54, 0x7f, 0x56, 0x82, 0x59, 0xde, 0xa9, 0x75, 0xec, 0xb7,
-	0x0e, 0x1c, 0xec, 0x05, 0x79, 0x04, 0x93, 0xde, 0xe8, 0x52, 0xda, 0x1b, 0xcd, 0x76, 0xc0, 0x8e,
-	0x4f, 0x3c, 0x1b, 0xb7, 0x49, 0xa4, 0xb4, 0xc7, 0x41, 0xe8, 0x65, 0xa8, 0xb9, 0xd8, 0xf7, 0xdd,
-	0x96, 0x87, 0xfd, 0xd8, 0xe9, 0x4a, 0x12, 0xc8, 0x8c, 0xb4, 0x63, 0x93, 0x3c, 0xdd, 0xb5, 0xad,
-	0x53, 0xce, 0xd8, 0xbd, 0xf5, 0xaf, 0x04, 0x3e, 0xeb, 0x67, 0xd3, 0xc3, 0x87, 0xd8, 0xc6, 0x9f,
-	0x69, 0x5b, 0xc1, 0x8f, 0x1d, 0x23, 0x08, 0x63, 0x38, 0xa1, 0xc6, 0xb0, 0x62, 0x79, 0xcb, 0x2d,
-	0x04, 0xa8, 0x3f, 0x2c, 0x01, 0xe2, 0x9f, 0x7f, 0x11, 0x42, 0x73, 0x31, 0x2b, 0x34, 0xcb, 0x49,
-	0x91, 0x58, 0x17, 0xc2, 0x2f, 0xf8, 0x07, 0xa1, 0x15, 0x13, 0x92, 0x23, 0x31, 0x21, 0xa9, 0xfe,
-	0xc5, 0x38, 0x54, 0x78, 0xb7, 0x06, 0x0d, 0xd2, 0x12, 0x3e, 0xed, 0x75, 0xd2, 0x76, 0xc4, 0xe1,
-	0x44, 0x91, 0x20, 0xad, 0x34, 0x4d, 0x20, 0x05, 0x86, 0x33, 0x52, 0x60, 0x24, 0x92, 0x02, 0x45,
-	0x03, 0xb0, 0xba, 0x64, 0x89, 0x1d, 0xeb, 0x9e, 0x25, 0xf6, 0xdd, 0xd8, 0x25, 0xbb, 0x8c, 0x9a,
-	0x97, 0xb3, 0x9e, 0x62, 0xf7, 0xeb, 0xde, 0x87, 0xb2, 0x11, 0xb0, 0xb5, 0x5c, 0xdc, 0xd7, 0x53,
-	0xb4, 0x29, 0xb6, 0xd7, 0x22, 0x82, 0xf8, 0x95, 0xc2, 0x4c, 0xec, 0x78, 0x96, 0x67, 0x22, 0x2d,
-	0x3b, 0xa5, 0x1b, 0x4e, 0x65, 0x75, 0xc3, 0x5f, 0xfe, 0xaf, 0xe8, 0xff, 0xd8, 0x3f, 0x1b, 0xff,
-	0x69, 0x1c, 0xc6, 0xf8, 0xea, 0xf1, 0xd1, 0x07, 0x50, 0x61, 0x62, 0xb8, 0x2d, 0xfe, 0x61, 0x9a,
-	0xcd, 0x83, 0x92, 0xf9, 0xc1, 0xa9, 0x16, 0xc7, 0x47, 0xaf, 0xc0, 0xb0, 0x6e, 0x9b, 0x72, 0x99,
-	0xc6, 0xf6, 0xbe, 0xf0, 0xcf, 0xb9, 0x1a, 0x2b, 0x47, 0xef, 0x41, 0x95, 0xe7, 0x7f, 0xd5, 0x1d,
-	0x8f, 0x18, 0xe1, 0x9f, 0x81, 0x63, 0x16, 0x53, 0xe2, 0x1f, 0x8a, 0x5a, 0x02, 0x19, 0x2d, 0xc1,
-	0x58, 0x93, 0xff, 0xb6, 0x46, 0x0a, 0xdb, 0xf9, 0xfc, 0xdf, 0xd9, 0x68, 0x12, 0x0b, 0xdd, 0x85,
-	0x09, 0x99, 0x50, 0x2a, 0xd8, 0x94, 0x95, 0x4c, 0x96, 0xcc, 0x30, 0xdf, 0x46, 0x80, 0xc9, 0x5b,
-	0xe1, 0x29, 0x65, 0xa5, 0x39, 0x37, 0x9f, 0xce, 0xe5, 0x1e, 0xb6, 0xc2, 0xdf, 0xd0, 0x03, 0x18,
-	0x97, 0x62, 0xbb, 0x70, 0xf6, 0x87, 0x80, 0x00, 0xbd, 0x0e, 0xa3, 0x6d, 0xf3, 0x84, 0x04, 0x3f,
-	0x54, 0x9d, 0x4b, 0x65, 0x06, 0x09, 0x72, 0xe8, 0x70, 0x1c, 0x74, 0x1f, 0xca, 0x6c, 0x0d, 0xe1,
-	0x26, 0xb1, 0x69, 0x98, 0x3d, 0x35, 0x24, 0x48, 0x05, 0x6f, 0x6b, 0x11, 0x2e, 0xcf, 0xe3, 0x68,
-	0x5a, 0x4e, 0xf0, 0x87, 0x82, 0xb9, 0xdc, 0x20, 0x1c, 0x4d, 0xe0, 0xb0, 0x56, 0xa2, 0xac, 0x36,
-	0x97, 0xd2, 0xad, 0x9c, 0x91, 0xd0, 0xe6, 0x41, 0x22, 0xe0, 0x22, 0xf8, 0x93, 0x41, 0xce, 0x65,
-	0xca, 0x9c, 0x28, 0x8b, 0xbb, 0x99, 0x0b, 0xc9, 0x4a, 0xb7, 0xd3, 0xd3, 0x98, 0x98, 0xfc, 0x1c,
-	0xe6, 0xfd, 0xe4, 0xe1, 0x90, 0xcc, 0x6d, 0x2e, 0xd7, 0x5e, 0xdc, 0x43, 0x99, 0x77, 0x88, 0xa4,
-	0x75, 0x21, 0x67, 0x26, 0x3d, 0x95, 0x7f, 0x64, 0x98, 0x4c, 0x33, 0x68, 0xc2, 0x13, 0xa2, 0x05,
-	0x78, 0x6c, 0x8c, 0x8f, 0x98, 0x6c, 0x95, 0x66, 0xe4, 0x5c, 0x4a, 0xe4, 0x06, 0x63, 0xcc, 0x71,
-	0x90, 0x02, 0xe3, 0xc7, 0x4c, 0x93, 0x76, 0x6c, 0x79, 0x0f, 0x2d, 0x78, 0xe5, 0x5b, 0x9f, 0xfc,
-	0x9f, 0x71, 0xa8, 0x4f, 0x4e, 0x17, 0xd8, 0xfa, 0x52, 0x34, 0xaa, 0x02, 0xf3, 0xf9, 0xbc, 0xa7,
-	0xde, 0x80, 0x6b, 0x67, 0x0a, 0x09, 0x75, 0x1e, 0x66, 0xf3, 0x42, 0xf5, 0xd4, 0xff, 0x0f, 0xb5,
-	0xc4, 0xcf, 0xc0, 0x2e, 0x36, 0xf3, 0xdd, 0xad, 0xdb, 0xe2, 0x4a, 0x0c, 0xaa, 0xc2, 0x84, 0xfc,
-	0x81, 0x87, 0x51, 0x7f, 0x81, 0xbd, 0x59, 0x4e, 0xf3, 0x89, 0x63, 0x5b, 0xa7, 0xf5, 0x12, 0xaa,
-	0xb0, 0x16, 0x0f, 0x1d, 0x4f, 0x27, 0xf5, 0xa1, 0x5b, 0xef, 0x76, 0x89, 0xf0, 0x62, 0x58, 0xeb,
-	0x1b, 0x0f, 0x57, 0x3e, 0xdb, 0xda, 0xaf, 0xbf, 0x80, 0x00, 0xc6, 0x1a, 0xfb, 0xda, 0xe6, 0xda,
-	0x7e, 0xbd, 0x84, 0xc6, 0x61, 0x78, 0xf7, 0xe1, 0xc3, 0xfa, 0xd0, 0xad, 0xd7, 0x72, 0xee, 0xaa,
-	0xa2, 0x09, 0x18, 0xf9, 0xa4, 0xb1, 0xbb, 0x53, 0x7f, 0x81, 0x3d, 0xed, 0x6f, 0x7c, 0xb1, 0x5f,
-	0x2f, 0xdd, 0x7a, 0x33, 0x70, 0x5e, 0xb3, 0x7a, 0x84, 0x17, 0xa6, 0xfe, 0x02, 0xaa, 0xc5, 0x1c,
-	0x92, 0xa2, 0x57, 0xd2, 0x55, 0x59, 0x1f, 0x5a, 0x85, 0xaf, 0xc2, 0xdf, 0xbd, 0x1f, 0x8c, 0xf1,
-	0x71, 0x78, 0xeb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xcd, 0x51, 0xa2, 0x2d, 0x7e, 0x00,
-	0x00,
+	// 6909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x49, 0x6c, 0x24, 0x47,
+	0x72, 0xea, 0xe6, 0xd9, 0xd1, 0x6c, 0x1e, 0xc9, 0x63, 0x6a, 0x2e, 0x0d, 0x55, 0xba, 0x66, 0x47,
+	0x5a, 0x8e, 0x66, 0x34, 0x1a, 0x49, 0x23, 0xad, 0x56, 0xbc, 0x46, 0x43, 0x89, 0x1c, 0x72, 0xab,
+	0xa9, 0xd1, 0xb1, 0xf6, 0x8e, 0x93, 0x55, 0xc9, 0x66, 0x2d, 0xab, 0x2b, 0x6b, 0xab, 0xb2, 0x7b,
+	0xc8, 0x05, 0x0c, 0xc3, 0xf0, 0xc3, 0x1f, 0x03, 0x86, 0x8d, 0x85, 0xfd, 0xf1, 0x81, 0xb5, 0xd7,
+	0xf0, 0xcb, 0xf0, 0xc3, 0x0f, 0x7f, 0xfc, 0xb4, 0x01, 0x03, 0x86, 0xff, 0x86, 0x1f, 0x06, 0xfc,
+	0xb4, 0x81, 0x7d, 0xd8, 0x3f, 0xc3, 0x0b, 0xd8, 0xc8, 0xa3, 0xee, 0x6a, 0x76, 0xb1, 0xc9, 0xd1,
+	0xac, 0xad, 0xfd, 0x55, 0x45, 0x46, 0x64, 0x66, 0xe5, 0x11, 0x19, 0x11, 0x19, 0x11, 0x05, 0x37,
+	0xbc, 0xc3, 0xd6, 0x4d, 0xec, 0xd9, 0xc1, 0x4d, 0x3b, 0x60, 0x36, 0xbd, 0xd9, 0xbd, 0x85, 0x1d,
+	0xef, 0x00, 0xdf, 0xba, 0xd9, 0xc5, 0x4e, 0x87, 0x04, 0x8f, 0xd9, 0xb1, 0x47, 0x82, 0x25, 0xcf,
+	0xa7, 0x8c, 0xa2, 0xf1, 0xb0, 0xf0, 0xd2, 0xf3, 0x2d, 0x4a, 0x5b, 0x0e, 0xb9, 0x29, 0xe0, 0x7b,
+	0x9d, 0xfd, 0x9b, 0x56, 0xc7, 0xc7, 0xcc, 0xa6, 0xae, 0xc4, 0xbc, 0xa4, 0x1f, 0xbe, 0x13, 0x2c,
+	0xd9, 0x94, 0x57, 0x7c, 0xd3, 0xa4, 0x3e, 0xb9, 0xd9, 0xbd, 0x75, 0xb3, 0x45, 0x5c, 0xe2, 0x63,
+	0x46, 0x2c, 0x85, 0xf3, 0x61, 0xcb, 0x66, 0x07, 0x9d, 0xbd, 0x25, 0x93, 0xb6, 0x6f, 0xb6, 0x68,
+	0x8b, 0xc6, 0x95, 0x45, 0x0f, 0xd9, 0x56, 0x9e, 0xf8, 0xd8, 0xf3, 0x88, 0xaf, 0xfa, 0xa3, 0xff,
+	0x53, 0x05, 0xd0, 0xb2, 0x65, 0x51, 0x77, 0xc3, 0x6d, 0xf9, 0x24, 0x08, 0x56, 0xa9, 0xbb, 0x6f,
+	0xb7, 0xd0, 0x1d, 0x18, 0x23, 0x2e, 0xde, 0x73, 0x88, 0xa5, 0x55, 0x16, 0x2b, 0xd7, 0xeb, 0xb7,
+	0x2f, 0x2d, 0xc9, 0x8a, 0x96, 0xc2, 0x8a, 0x96, 0x56, 0x28, 0x75, 0x1e, 0xf1, 0x0f, 0x34, 0x42,
+	0x54, 0x34, 0x07, 0x23, 0x07, 0x34, 0x60, 0x81, 0x56, 0x5d, 0x1c, 0xba, 0x5e, 0x33, 0xe4, 0x0b,
+	0x5a, 0x81, 0x3a, 0x76, 0x5d, 0xca, 0xc4, 0xc7, 0x05, 0xda, 0x90, 0xa8, 0x6f, 0x71, 0x29, 0x1c,
+	0x88, 0xa5, 0xdd, 0x63, 0x8f, 0x6c, 0x61, 0xaf, 0xc9, 0x7c, 0xdb, 0x6d, 0x6d, 0xb8, 0x8c, 0xf8,
+	0xfb, 0xd8, 0x24, 0x46, 0x92, 0x08, 0xdd, 0x86, 0x21, 0xe6, 0x04, 0xda, 0x70, 0x49, 0x5a, 0x8e,
+	0xac, 0x1b, 0x00, 0xcb, 0xbe, 0x79, 0xa0, 0xbe, 0x68, 0x0e, 0x46, 0x70, 0xdb, 0xba, 0x7b, 0x47,
+	0x7c, 0x4f, 0xc3, 0x90, 0x2f, 0x48, 0x83, 0x31, 0xcf, 0x33, 0xef, 0xde, 0x71, 0x88, 0x56, 0x15,
+	0xf0, 0xf0, 0x95, 0xe3, 0x07, 0x6f, 0xbe, 0xfb, 0xc6, 0x91, 0xe8, 0x6f, 0xc3, 0x90, 0x2f, 0xfa,
+	0xdf, 0x0d, 0x41, 0x6d, 0xf5, 0xe1, 0xc6, 0x99, 0x46, 0x69, 0x1a, 0x86, 0x0e, 0x3a, 0x7b, 0xa2,
+	0xbd, 0x9a, 0xc1, 0x1f, 0x39, 0x84, 0xe1, 0x96, 0x68, 0xa9, 0x66, 0xf0, 0x47, 0xde, 0xba, 0xdd,
+	0xc6, 0x2d, 0x22, 0xbe, 0xb8, 0x66, 0xc8, 0x17, 0xf4, 0x3c, 0x80, 0xd7, 0x71, 0x9c, 0x1d, 0xea,
+	0xd8, 0xe6, 0xb1, 0x36, 0x22, 0x8a, 0x12, 0x10, 0x74, 0x05, 0x6a, 0xa6, 0x6b, 0xaf, 0xd8, 0xee,
+	0x9a, 0xed, 0x6b, 0xa3, 0xa2, 0x38, 0x06, 0x70, 0x6a, 0xd3, 0xb5, 0x79, 0xd7, 0x79, 0xf1, 0x98,
+	0xa4, 0x8e, 0x21, 0xe8, 0x3a, 0x4c, 0xa9, 0xb7, 0xfb, 0xb6, 0x43, 0x1e, 0xe2, 0x36, 0xd1, 0xc6,
+	0x05, 0x52, 0x16, 0x8c, 0x5e, 0x87, 0x19, 0x72, 0x64, 0x3a, 0x1d, 0x4b, 0xbc, 0x06, 0x1e, 0x36,
+	0x49, 0xa0, 0xd5, 0xc4, 0x9c, 0xe7, 0x0b, 0xd0, 0x26, 0x4c, 0x7a, 0xd4, 0x5a, 0x4e, 0x2c, 0x01,
+	0x28, 0x37, 0x8d, 0x2b, 0x55, 0xad, 0x62, 0x64, 0x68, 0xd1, 0x75, 0x98, 0xf6, 0x02, 0xef, 0xb1,
+	0xe9, 0x74, 0x02, 0x46, 0xfc, 0xc7, 0x3e, 0x75, 0x88, 0x56, 0x17, 0xdd, 0x9c, 0xf4, 0x02, 0x6f,
+	0x55, 0x82, 0x0d, 0xea, 0x10, 0x74, 0x09, 0xc6, 0x1d, 0xda, 0xda, 0x24, 0x5d, 0xe2, 0x68, 0x13,
+	0x02, 0x23, 0x7a, 0xd7, 0x3f, 0x87, 0x4b, 0xab, 0x3b, 0x9f, 0xee, 0x62, 0xbf, 0x45, 0xd8, 0xa7,
+	0xcc, 0x76, 0xec, 0x1f, 0x8a, 0xea, 0xd5, 0xbc, 0xde, 0x03, 0x8d, 0x89, 0xa2, 0xe5, 0x2e, 0xf1,
+	0x71, 0x8b, 0x24, 0x30, 0xc4, 0x44, 0x8f, 0x18, 0x3d, 0xcb, 0xf5, 0xdf, 0x18, 0x85, 0x99, 0x55,
+	0xe2, 0xb3, 0x2d, 0xec, 0xe2, 0x16, 0xf1, 0x9f, 0xd1, 0x4a, 0x79, 0x05, 0x26, 0x7c, 0xe2, 0x39,
+	0xb6, 0x89, 0x57, 0x69, 0xc7, 0x65, 0x62, 0xad, 0x34, 0xc4, 0x78, 0xa6, 0xe0, 0x9c, 0x9a, 0xb4,
+	0xb1, 0xed, 0xa8, 0xd5, 0x22, 0x5f, 0xf8, 0x3a, 0x22, 0x47, 0xcc, 0xc7, 0xcb, 0x7e, 0x2b, 0xd0,
+	0xc6, 0xc4, 0xbc, 0xc6, 0x00, 0xf4, 0x00, 0x26, 0x5c, 0x6a, 0x91, 0x26, 0x71, 0x88, 0xc9, 0xa8,
+	0xaf, 0x8d, 0x9f, 0x62, 0x36, 0x53, 0x94, 0xe8, 0x2d, 0xa8, 0xf9, 0x24, 0xa0, 0x1d, 0x5f, 0xae,
+	0x1f, 0x5e, 0xcd, 0x6c, 0x5c, 0x8d, 0x11, 0x16, 0x09, 0xca, 0x18, 0x13, 0xe9, 0x30, 0xe1, 0x51,
+	0x6b, 0xcd, 0x0d, 0xd4, 0x46, 0x00, 0xd1, 0xf7, 0x14, 0x0c, 0xad, 0x85, 0x38, 0x72, 0x02, 0xb4,
+	0x7a, 0xb9, 0x4e, 0x1a, 0x29, 0x2a, 0x44, 0xe1, 0x8a, 0x58, 0x7e, 0xcc, 0x5e, 0xde, 0xdf, 0xb7,
+	0x5d, 0x9b, 0x1d, 0x6f, 0xe2, 0x3d, 0xe2, 0x44, 0x9f, 0x3e, 0x21, 0x6a, 0x7d, 0x35, 0x5d, 0x6b,
+	0xd3, 0xb1, 0x4d, 0xb2, 0xbd, 0xdf, 0x63, 0x04, 0x4e, 0xac, 0x10, 0x3d, 0x81, 0xc5, 0x4c, 0xf9,
+	0x2e, 0xf1, 0xdb, 0xe9, 0x46, 0x1b, 0xa7, 0x6f, 0xb4, 0x6f, 0xa5, 0x68, 0x0b, 0xea, 0x8c, 0x3a,
+	0xc4, 0x57, 0x3b, 0x74, 0xf2, 0xf4, 0x6d, 0x24, 0xe9, 0xf5, 0xff, 0xae, 0x40, 0x2d, 0x9a, 0x3f,
+	0xf4, 0x36, 0x8c, 0x3a, 0x76, 0xdb, 0x66, 0x81, 0x56, 0x59, 0x1c, 0xba, 0x5e, 0xbf, 0x7d, 0xad,
+	0x60, 0x92, 0x97, 0x36, 0x05, 0xc6, 0xba, 0xcb, 0xfc, 0x63, 0x43, 0xa1, 0xa3, 0x6f, 0xc1, 0xb8,
+	0x4f, 0x7e, 0xd0, 0x21, 0xe1, 0x99, 0x52, 0xbf, 0xfd, 0x42, 0x11, 0xa9, 0xa1, 0x70, 0x24, 0x71,
+	0x44, 0x72, 0xe9, 0x5d, 0xa8, 0x27, 0x6a, 0xe5, 0x9b, 0xe7, 0x90, 0x1c, 0x8b, 0x0d, 0x58, 0x33,
+	0xf8, 0x23, 0x5f, 0xfe, 0xe2, 0x8c, 0x56, 0x5b, 0x4c, 0xbe, 0xdc, 0xab, 0xbe, 0x53, 0xb9, 0xf4,
+	0x1e, 0x34, 0x52, 0xb5, 0x9e, 0x86, 0x58, 0xff, 0x9d, 0x31, 0x68, 0xac, 0x52, 0x9f, 0xac, 0x3d,
+	0x6c, 0x9e, 0x69, 0xff, 0xeb, 0x30, 0x61, 0xca, 0x6a, 0x36, 0xc4, 0x16, 0x97, 0x0d, 0xa5, 0x60,
+	0x82, 0xab, 0xcb, 0xf7, 0xdd, 0x88, 0x31, 0x24, 0x20, 0x68, 0x09, 0x90, 0x7a, 0xdb, 0x71, 0x3a,
+	0x2d, 0xdb, 0xdd, 0x48, 0x30, 0x8b, 0x82, 0x92, 0xdc, 0xee, 0x1e, 0x19, 0x78, 0x77, 0x67, 0x79,
+	0xd0, 0x68, 0x0f, 0x1e, 0x94, 0x3f, 0x1f, 0xc6, 0xce, 0x70, 0x3e, 0xa4, 0x78, 0xca, 0x78, 0x69,
+	0x9e, 0xb2, 0x09, 0x53, 0x3e, 0x75, 0x1c, 0xdb, 0x6d, 0x6d, 0xe1, 0xa3, 0x66, 0xc7, 0x6f, 0x11,
+	0xc5, 0x90, 0x9e, 0x4f, 0xf7, 0x62, 0xc3, 0x65, 0xdb, 0xbe, 0xec, 0xc7, 0x7d, 0xea, 0xef, 0xac,
+	0x88, 0x7a, 0xb2, 0xa4, 0xe8, 0x73, 0x98, 0x8f, 0x41, 0x9f, 0xba, 0xb8, 0x8b, 0x6d, 0x87, 0x4f,
+	0xa9, 0x06, 0xa5, 0xeb, 0x2c, 0xae, 0xa0, 0x2f, 0x47, 0xaa, 0x3f, 0x0b, 0x8e, 0x34, 0xf1, 0x15,
+	0x70, 0xa4, 0xc6, 0x19, 0x39, 0xd2, 0xe7, 0xb0, 0xb8, 0x46, 0xf6, 0x71, 0xc7, 0x61, 0x3b, 0xd4,
+	0x5a, 0xb3, 0x03, 0xbf, 0xe3, 0xf1, 0x82, 0x95, 0x8e, 0xd5, 0x22, 0xec, 0x2c, 0xbb, 0x54, 0xff,
+	0x0c, 0x16, 0x54, 0xcd, 0xd1, 0xea, 0x52, 0xf5, 0x25, 0xd9, 0x97, 0xac, 0xb0, 0x88, 0x7d, 0x85,
+	0x7c, 0x46, 0x12, 0xc5, 0xec, 0x4b, 0xff, 0x8f, 0x1a, 0xcc, 0xae, 0x0b, 0xa9, 0xfc, 0x23, 0xcc,
+	0xc8, 0x13, 0x7c, 0xac, 0xaa, 0xbd, 0x0f, 0xd3, 0xb8, 0xc3, 0x68, 0x60, 0x62, 0x87, 0xac, 0x97,
+	0xee, 0x6f, 0x8e, 0x86, 0xb3, 0x97, 0x08, 0xb6, 0x85, 0x8f, 0x94, 0x04, 0x9c, 0x82, 0xa5, 0x71,
+	0x6c, 0x57, 0x49, 0xc3, 0x29, 0x18, 0x7a, 0x05, 0x26, 0x4d, 0xea, 0xba, 0xc4, 0x64, 0xbb, 0x76,
+	0x9b, 0xd0, 0x0e, 0x53, 0xec, 0x25, 0x03, 0x45, 0xf7, 0x60, 0xc8, 0xf4, 0x3a, 0x8a, 0xa3, 0xbc,
+	0x14, 0x8f, 0x44, 0x6f, 0x49, 0x4c, 0x4c, 0x23, 0x27, 0x42, 0xdf, 0x86, 0x86, 0xe5, 0x63, 0xdb,
+	0x5d, 0x53, 0x4a, 0x92, 0xe0, 0x26, 0xf5, 0xdb, 0x17, 0x73, 0x1f, 0x1c, 0x22, 0x18, 0x69, 0xfc,
+	0xe4, 0xdc, 0x8e, 0x95, 0xe7, 0xc0, 0xb7, 0x61, 0x88, 0xb8, 0xdd, 0xb2, 0x22, 0x8e, 0xc1, 0x91,
+	0xd1, 0x5b, 0x30, 0xea, 0xf0, 0x95, 0x1c, 0x8a, 0x34, 0x57, 0x63, 0x32, 0x35, 0x8f, 0x62, 0xa1,
+	0x87, 0xf3, 0xad, 0x90, 0x73, 0x8c, 0x17, 0x06, 0x66, 0xbc, 0x79, 0x86, 0x5a, 0x3f, 0x03, 0x43,
+	0xfd, 0xfa, 0xc8, 0x40, 0xaf, 0xc1, 0x88, 0x47, 0x7d, 0xc6, 0xa5, 0x1f, 0x2e, 0x6a, 0xcc, 0xc7,
+	0xb5, 0xef, 0x70, 0xb0, 0x9a, 0x2f, 0x89, 0x93, 0x3e, 0x67, 0xa6, 0x4a, 0x9f, 0x33, 0xef, 0x43,
+	0x23, 0x20, 0xa6, 0x4f, 0xd8, 0x23, 0xea, 0x74, 0xda, 0x24, 0xd0, 0xa6, 0x45, 0x5b, 0x0b, 0x31,
+	0x69, 0x33, 0x51, 0x6c, 0xa4, 0x91, 0xd1, 0x0e, 0xa0, 0x80, 0xf8, 0x5d, 0xdb, 0x24, 0xc9, 0xd9,
+	0x9d, 0x29, 0xb9, 0x3a, 0x0b, 0x68, 0x11, 0x82, 0x61, 0x6e, 0x9e, 0xd0, 0x90, 0xd8, 0xb1, 0xe2,
+	0x19, 0xbd, 0x06, 0xc3, 0x3f, 0xec, 0x7a, 0xae, 0x36, 0x2b, 0xea, 0xbd, 0x10, 0xd7, 0xfb, 0x25,
+	0xf1, 0xe9, 0xa3, 0x9d, 0x87, 0x6a, 0x20, 0x04, 0x52, 0x96, 0x4d, 0xcf, 0x9d, 0x91, 0x4d, 0xff,
+	0xac, 0x02, 0x68, 0xdd, 0xed, 0xd2, 0xe3, 0x2d, 0xc2, 0x7c, 0xdb, 0x3c, 0x9b, 0x3d, 0x02, 0xc1,
+	0x30, 0x37, 0x41, 0x28, 0xb9, 0x49, 0x3c, 0x73, 0x18, 0x9f, 0x40, 0xc1, 0xc8, 0x46, 0x0c, 0xf1,
+	0xcc, 0x2d, 0x14, 0xcc, 0x09, 0x9a, 0x84, 0x31, 0xdb, 0x6d, 0x95, 0xb7, 0x32, 0x24, 0x89, 0xb8,
+	0xc2, 0xc1, 0x4c, 0xef, 0x13, 0x42, 0x3c, 0xec, 0xd8, 0x5d, 0x52, 0x56, 0x6e, 0x32, 0x52, 0x54,
+	0xfa, 0x3f, 0x8e, 0xc2, 0xc4, 0x47, 0xd8, 0x71, 0xc8, 0xf1, 0x59, 0x0d, 0x31, 0x76, 0x42, 0x62,
+	0x94, 0x2f, 0xe8, 0x0e, 0x0c, 0xb7, 0x49, 0x70, 0xa0, 0x0d, 0x2d, 0x0e, 0xa5, 0xbb, 0x96, 0x6c,
+	0x71, 0x69, 0x8b, 0x04, 0x07, 0x52, 0x90, 0x16, 0xd8, 0x7d, 0xf7, 0xff, 0xf0, 0xb3, 0xd8, 0xff,
+	0x23, 0x4f, 0x63, 0xff, 0x97, 0x15, 0x58, 0x53, 0x5b, 0x7f, 0xac, 0xf4, 0xd6, 0x5f, 0x81, 0x49,
+	0x39, 0x3f, 0xcb, 0x2e, 0x76, 0x8e, 0x03, 0x3b, 0x14, 0x4f, 0x4f, 0x9a, 0xd1, 0x0c, 0xc5, 0xff,
+	0x19, 0x31, 0x35, 0xc3, 0x15, 0xea, 0x67, 0xe3, 0x0a, 0x97, 0xde, 0x86, 0x5a, 0xb4, 0x2c, 0x4f,
+	0xa5, 0x89, 0x7d, 0x0b, 0x66, 0x0b, 0xce, 0x5c, 0x5e, 0x05, 0xf6, 0xbc, 0xb0, 0x0a, 0xec, 0x79,
+	0x62, 0xc7, 0x04, 0xcc, 0xa6, 0xd1, 0x8e, 0xe1, 0x2f, 0xfa, 0xbf, 0x55, 0x60, 0x52, 0xd1, 0x87,
+	0xa4, 0x0f, 0x61, 0x56, 0x94, 0x3d, 0x26, 0x42, 0x32, 0x6b, 0xc9, 0x52, 0xad, 0x92, 0x3d, 0xea,
+	0x0b, 0x04, 0x37, 0x03, 0x09, 0xca, 0xf5, 0x24, 0x61, 0x72, 0x83, 0x57, 0xcb, 0x6f, 0xf0, 0xef,
+	0xc0, 0x9c, 0xec, 0x85, 0xed, 0xa6, 0xba, 0x31, 0x9c, 0x9d, 0xb8, 0x0d, 0xb7, 0xa0, 0x1f, 0xf2,
+	0x0b, 0x36, 0x52, 0xa4, 0xfa, 0x7f, 0x69, 0x30, 0xf1, 0x91, 0x43, 0xf7, 0xb0, 0xa3, 0xbe, 0xf4,
+	0x3a, 0x0c, 0x63, 0xdf, 0x3c, 0x50, 0x9f, 0x36, 0x17, 0xd7, 0x19, 0x5b, 0x55, 0x0d, 0x81, 0x81,
+	0x3e, 0x81, 0x09, 0x93, 0xf8, 0xcc, 0xde, 0xb7, 0x4d, 0xcc, 0x48, 0xa0, 0x5d, 0x3f, 0xd5, 0x74,
+	0x1b, 0x29, 0x62, 0x61, 0x86, 0x14, 0x95, 0x47, 0x26, 0x44, 0x35, 0x27, 0x59, 0x30, 0x7a, 0x03,
+	0x66, 0x25, 0xc8, 0xa0, 0x94, 0xc5, 0xd8, 0xb7, 0x05, 0x76, 0x51, 0x11, 0x97, 0x9c, 0x25, 0xf8,
+	0x11, 0x76, 0x6c, 0x4b, 0x0a, 0x92, 0x43, 0xfd, 0x25, 0xe7, 0x2c, 0x0d, 0xfa, 0x25, 0xb8, 0x6c,
+	0x52, 0x97, 0xf9, 0xd4, 0xd9, 0x71, 0xb0, 0x4b, 0x9a, 0xc4, 0xec, 0xf8, 0x36, 0x3b, 0x0e, 0x85,
+	0xf1, 0xe1, 0xbe, 0x55, 0x9e, 0x44, 0x8e, 0x1e, 0xc0, 0x35, 0x4b, 0x2a, 0x14, 0x72, 0x94, 0x1f,
+	0xd9, 0x81, 0xbd, 0x67, 0x3b, 0x36, 0x3b, 0x8e, 0x8e, 0xa8, 0x3b, 0xc2, 0x28, 0xd7, 0x0f, 0x0d,
+	0x3d, 0x82, 0x59, 0x85, 0xf2, 0x30, 0x29, 0x5a, 0x8e, 0x9e, 0x42, 0x1c, 0x2c, 0xaa, 0x00, 0xb9,
+	0x70, 0xc9, 0xea, 0xa9, 0x4c, 0x29, 0x96, 0x78, 0x23, 0xae, 0xbe, 0x9f, 0xe2, 0x25, 0x1a, 0x3a,
+	0xa1, 0x46, 0xb4, 0x09, 0xb3, 0x96, 0x1d, 0xf0, 0xd1, 0x91, 0xe6, 0xbd, 0xd5, 0x03, 0x62, 0x1e,
+	0x96, 0xe1, 0x9f, 0x45, 0x64, 0x68, 0x07, 0xa6, 0xad, 0x8c, 0xc2, 0xa6, 0xd5, 0xb2, 0x43, 0x52,
+	0xac, 0xd2, 0x89, 0x9e, 0xe6, 0xa8, 0x63, 0xd6, 0xfe, 0x80, 0x38, 0xed, 0x5d, 0x12, 0x30, 0x0d,
+	0xfa, 0x76, 0x2d, 0x43, 0x81, 0x3e, 0x84, 0x86, 0x84, 0xec, 0xfa, 0xd8, 0xb4, 0xdd, 0xd0, 0x64,
+	0x79, 0x52, 0x15, 0x69, 0x82, 0xd0, 0x5c, 0x3c, 0x11, 0x9b, 0x8b, 0xaf, 0xc3, 0x94, 0x38, 0xfa,
+	0x77, 0xe2, 0x5b, 0x83, 0x86, 0xdc, 0x4b, 0x19, 0x30, 0x6a, 0xc2, 0x74, 0x04, 0x92, 0x12, 0x68,
+	0xa0, 0xbd, 0x7c, 0xba, 0x6d, 0x9c, 0xab, 0x80, 0x2b, 0x86, 0x82, 0xd3, 0xc4, 0x7b, 0x73, 0x52,
+	0x2a, 0x86, 0x69, 0x28, 0x7a, 0x08, 0x33, 0x0e, 0x35, 0x31, 0x5f, 0xba, 0x9b, 0x7b, 0x6a, 0xf1,
+	0x6a, 0x53, 0xd9, 0x19, 0xe9, 0x21, 0x40, 0xe5, 0x49, 0xd1, 0x32, 0xc0, 0xe1, 0x3b, 0x81, 0xe2,
+	0x6f, 0xda, 0x74, 0x56, 0xf3, 0xfe, 0xa4, 0xb3, 0x47, 0x7c, 0x97, 0x30, 0x12, 0xa4, 0x2e, 0xbd,
+	0x8c, 0x04, 0x11, 0x7a, 0x07, 0x6a, 0x0e, 0x6d, 0x2d, 0x07, 0x1f, 0x07, 0xd4, 0xd5, 0x5e, 0xea,
+	0x3b, 0x13, 0x31, 0x32, 0x7a, 0x1b, 0xc6, 0x1c, 0xda, 0x6a, 0xf1, 0x4f, 0x98, 0xc9, 0xe9, 0x7f,
+	0x82, 0xbf, 0x6e, 0xca, 0x62, 0xd5, 0x6a, 0x88, 0x8d, 0x56, 0xa1, 0xc1, 0x05, 0xae, 0xf5, 0x23,
+	0x0f, 0xbb, 0x01, 0xe7, 0x4c, 0x28, 0x4b, 0xbe, 0x95, 0x2c, 0x56, 0xe4, 0x69, 0x1a, 0xb4, 0x00,
+	0xa3, 0x1c, 0xb0, 0xb1, 0xa6, 0xbd, 0x25, 0x86, 0x5a, 0xbd, 0x71, 0xf1, 0x94, 0x3f, 0x3d, 0x24,
+	0xec, 0x09, 0xf5, 0x0f, 0x03, 0x6d, 0xb6, 0xe4, 0xe8, 0xa6, 0xa8, 0xf8, 0x84, 0xb6, 0xa9, 0x6b,
+	0x33, 0xca, 0x91, 0xb8, 0x52, 0x24, 0xe4, 0xfd, 0x86, 0x91, 0x81, 0xf2, 0xa3, 0xa3, 0xcd, 0xef,
+	0xeb, 0xe6, 0xb3, 0x47, 0xc7, 0xd6, 0xee, 0x66, 0x33, 0x3c, 0x3a, 0x38, 0x06, 0xfa, 0x10, 0x26,
+	0xda, 0x1d, 0x87, 0xd9, 0xea, 0xe2, 0x46, 0x5b, 0x10, 0x14, 0x57, 0x12, 0x14, 0x89, 0x52, 0x45,
+	0x99, 0xa2, 0xe0, 0x57, 0x78, 0xae, 0xec, 0x9f, 0xf6, 0xaa, 0xf8, 0xe4, 0xf0, 0x15, 0xdd, 0x85,
+	0x05, 0x6e, 0xcd, 0x7f, 0xd8, 0x6c, 0x12, 0x7e, 0x4c, 0x25, 0xee, 0xaa, 0x5e, 0x13, 0xec, 0xb3,
+	0x47, 0x29, 0xfa, 0x1e, 0x5c, 0xa1, 0x6d, 0x9b, 0x35, 0x6d, 0x8b, 0x98, 0xd8, 0xdf, 0x70, 0xbf,
+	0x2f, 0x98, 0x9e, 0x6c, 0x7c, 0x0b, 0x7b, 0xda, 0x2b, 0x7d, 0x97, 0xc3, 0x89, 0xf4, 0xe8, 0x03,
+	0x98, 0xa0, 0x6e, 0x7c, 0x43, 0xa6, 0x5d, 0xe8, 0x5b, 0x5f, 0x0a, 0x1f, 0x19, 0xb0, 0x40, 0x3d,
+	0xe2, 0x63, 0x46, 0x7d, 0x79, 0xcb, 0xf4, 0x19, 0xd9, 0x3b, 0xa0, 0xf4, 0x30, 0xd0, 0xbe, 0xd1,
+	0xb7, 0xa6, 0x1e, 0x94, 0xe8, 0xbb, 0x30, 0x4f, 0x3b, 0x6c, 0x8f, 0x76, 0x5c, 0x6b, 0xd7, 0xc7,
+	0xfb, 0xfb, 0xb6, 0xa9, 0xf8, 0x85, 0x26, 0xaa, 0x7c, 0x39, 0x9e, 0x90, 0xed, 0x22, 0x34, 0x35,
+	0x33, 0xc5, 0x75, 0x70, 0xf6, 0xed, 0xc5, 0x0c, 0xf8, 0x3e, 0xb6, 0x9d, 0x6d, 0x8f, 0xb8, 0xda,
+	0xc5, 0xfe, 0xec, 0xbb, 0x80, 0x8c, 0x33, 0x35, 0x09, 0x8e, 0x47, 0xf0, 0x92, 0x64, 0x6a, 0x19,
+	0x30, 0x7a, 0x03, 0x66, 0x3c, 0xdf, 0xa6, 0xfc, 0x6c, 0x5d, 0x75, 0x70, 0x10, 0xf0, 0x12, 0xed,
+	0x32, 0xc7, 0x15, 0x7c, 0x3c, 0x5f, 0xc8, 0x45, 0x0a, 0xcf, 0xa7, 0x6d, 0xc2, 0x0e, 0x48, 0x27,
+	0x88, 0xeb, 0x7f, 0x53, 0x8a, 0x14, 0x05, 0x45, 0xc2, 0x68, 0xe0, 0xd3, 0xa3, 0x63, 0xed, 0xca,
+	0x62, 0x25, 0x63, 0x34, 0xe0, 0xe0, 0xc8, 0x68, 0xc0, 0x5f, 0xd0, 0xdb, 0x50, 0x13, 0x0f, 0x1b,
+	0xae, 0xcd, 0xb4, 0xab, 0xca, 0x82, 0x95, 0x26, 0xe0, 0x45, 0x8a, 0x28, 0xc6, 0x45, 0x2f, 0xc3,
+	0x50, 0x60, 0x05, 0xda, 0xf3, 0x59, 0x65, 0xa3, 0xb9, 0x16, 0x6e, 0x27, 0x5e, 0x1e, 0x5e, 0x0f,
+	0x5e, 0x8b, 0xaf, 0x07, 0x97, 0x00, 0x31, 0xe2, 0x90, 0x36, 0x61, 0x7e, 0x62, 0xbc, 0x16, 0x05,
+	0x42, 0x41, 0x09, 0x5a, 0x82, 0x51, 0xe6, 0x63, 0x93, 0xf8, 0xda, 0x0b, 0x8b, 0x95, 0xb4, 0x61,
+	0x62, 0x57, 0xc0, 0x43, 0xab, 0x95, 0xc4, 0x42, 0x8b, 0x50, 0x67, 0x7e, 0x27, 0x60, 0x6b, 0xb4,
+	0x8d, 0x6d, 0x57, 0xd3, 0x45, 0xc5, 0x49, 0x90, 0xe8, 0x41, 0xfc, 0xba, 0xec, 0xd8, 0x38, 0x20,
+	0x81, 0x76, 0x43, 0xec, 0xc0, 0x82, 0x12, 0x74, 0x1b, 0x46, 0x3b, 0x01, 0xd9, 0x5a, 0xdd, 0xd1,
+	0x5e, 0xec, 0xbb, 0x3e, 0x14, 0x26, 0x7a, 0x1f, 0xea, 0xe2, 0x48, 0x31, 0x48, 0x9b, 0x32, 0xa2,
+	0xbd, 0xde, 0x97, 0x30, 0x89, 0x8e, 0x1e, 0x81, 0x66, 0xfa, 0x04, 0x33, 0x22, 0xdf, 0x9b, 0x5d,
+	0x73, 0xdd, 0xb5, 0x3c, 0x6a, 0xbb, 0x2c, 0xd0, 0xbe, 0xd9, 0xb7, 0xaa, 0x9e, 0xb4, 0x9c, 0x8f,
+	0xf8, 0x02, 0xba, 0x63, 0x3b, 0x94, 0xad, 0x0a, 0xb4, 0x04, 0x82, 0xb6, 0xd4, 0x9f, 0x8f, 0x9c,
+	0x44, 0xcf, 0x17, 0xab, 0x2a, 0x17, 0xeb, 0x7e, 0xd9, 0xb2, 0xc4, 0x79, 0x77, 0x53, 0x2e, 0xd6,
+	0x82, 0x22, 0x3e, 0x17, 0x89, 0x1a, 0x43, 0x82, 0x37, 0xe4, 0x6a, 0xc8, 0x97, 0x70, 0x0e, 0x2a,
+	0xa1, 0xbb, 0xe1, 0x4a, 0x09, 0x69, 0x6e, 0x09, 0x9a, 0x1e, 0xa5, 0x7c, 0x15, 0x89, 0x01, 0xb6,
+	0xb4, 0xbb, 0xd9, 0x55, 0xb4, 0x21, 0xe0, 0xe1, 0x2a, 0x92, 0x58, 0xfa, 0x1a, 0x4c, 0x24, 0xe1,
+	0x03, 0x1a, 0xe2, 0x5f, 0x83, 0xd9, 0x82, 0x03, 0x96, 0xab, 0x76, 0x8e, 0x70, 0x02, 0x90, 0xea,
+	0x9e, 0x7c, 0xd1, 0x7f, 0x7f, 0x16, 0xe6, 0x8a, 0x94, 0xa3, 0xaf, 0xa5, 0x75, 0xfd, 0x43, 0x68,
+	0x98, 0x9d, 0x80, 0xd1, 0x76, 0x53, 0x5a, 0x08, 0xb5, 0xd1, 0xbe, 0x1f, 0x9c, 0x26, 0xe0, 0x83,
+	0x6c, 0x91, 0xbd, 0x4e, 0x4b, 0xf9, 0x95, 0xc8, 0x17, 0x2e, 0x8d, 0x58, 0x92, 0x31, 0x48, 0x4f,
+	0x12, 0xf5, 0x96, 0xb7, 0xe6, 0xd7, 0x06, 0xb7, 0xe6, 0xc3, 0xa9, 0xad, 0xf9, 0xf5, 0xd3, 0x58,
+	0xf3, 0x17, 0xa1, 0x4e, 0x8e, 0x18, 0xf1, 0x5d, 0xec, 0x6c, 0xec, 0x04, 0xda, 0x84, 0xe0, 0x5b,
+	0x49, 0x10, 0xba, 0x97, 0x92, 0x36, 0x1b, 0x7d, 0xbb, 0x93, 0xc0, 0x46, 0x6b, 0x30, 0x15, 0xbf,
+	0x3d, 0x60, 0xcc, 0x0b, 0xaf, 0xde, 0x4f, 0xaa, 0x20, 0x4b, 0x92, 0xb8, 0x71, 0x98, 0x3a, 0xcd,
+	0x8d, 0xc3, 0x2b, 0x30, 0xe9, 0x50, 0x6c, 0xad, 0x60, 0x07, 0xbb, 0x26, 0xf1, 0x37, 0x76, 0x84,
+	0xa8, 0x5c, 0x33, 0x32, 0x50, 0xee, 0x0e, 0x93, 0x84, 0x34, 0x85, 0xd2, 0x63, 0x60, 0xb7, 0x45,
+	0xb8, 0xed, 0x99, 0x8f, 0x47, 0xcf, 0x72, 0xb4, 0x0e, 0x28, 0x25, 0xa0, 0x0a, 0x4b, 0xba, 0x86,
+	0x4e, 0x32, 0xb0, 0x17, 0x10, 0xe4, 0x2e, 0x47, 0x66, 0xcf, 0xf1, 0x72, 0x64, 0xee, 0x29, 0x5e,
+	0x8e, 0xcc, 0x3f, 0x0b, 0xe3, 0xe8, 0xc2, 0x53, 0xbd, 0x1c, 0xb9, 0x50, 0xe2, 0x72, 0x24, 0x6b,
+	0x49, 0xd5, 0x7a, 0x58, 0x52, 0x57, 0x92, 0x96, 0xd4, 0x8b, 0xa7, 0x98, 0x87, 0x98, 0x0c, 0xbd,
+	0x29, 0x45, 0xa3, 0x4b, 0x59, 0x2d, 0x2f, 0xcd, 0xdc, 0x9b, 0x56, 0x90, 0x14, 0x94, 0x72, 0xd7,
+	0x30, 0x97, 0xcf, 0x7e, 0x0d, 0x73, 0xe5, 0x1c, 0xae, 0x61, 0xae, 0x26, 0xae, 0x61, 0xee, 0xaa,
+	0x6b, 0x18, 0x29, 0xf4, 0xe9, 0xbd, 0xbe, 0xec, 0xcb, 0xae, 0xe7, 0xa6, 0x6e, 0x64, 0x0a, 0x6c,
+	0xc4, 0xd7, 0x9e, 0x82, 0x8d, 0x78, 0xf1, 0xac, 0x36, 0xe2, 0x1b, 0x30, 0x8d, 0x3d, 0xb1, 0x18,
+	0x58, 0xc4, 0x18, 0x5e, 0x10, 0xdf, 0x9f, 0x83, 0xa3, 0x3b, 0x30, 0x1f, 0xb2, 0xdc, 0xb4, 0x7a,
+	0x22, 0x05, 0xce, 0xe2, 0xc2, 0xac, 0x15, 0xfa, 0xc5, 0xb3, 0x59, 0xa1, 0xb9, 0x99, 0x53, 0x99,
+	0x5b, 0x65, 0x67, 0x5f, 0x3a, 0xa5, 0x99, 0x33, 0x49, 0x8c, 0xbe, 0x0b, 0x73, 0xd8, 0xb2, 0x6c,
+	0x5e, 0xb3, 0xb0, 0xb8, 0x32, 0x6c, 0xbb, 0xc4, 0x3f, 0xb5, 0xd1, 0xa5, 0xb0, 0x12, 0xb4, 0x05,
+	0x0d, 0x65, 0xb3, 0x54, 0xcb, 0xfb, 0x95, 0xd3, 0xd5, 0x9a, 0xa6, 0xe6, 0x0a, 0x6b, 0xca, 0xbe,
+	0xfb, 0x6a, 0x7f, 0x85, 0x35, 0x89, 0x8f, 0x5e, 0x97, 0xde, 0xbb, 0xd7, 0xfb, 0x92, 0x71, 0x34,
+	0xfd, 0x0f, 0x2a, 0x70, 0xa1, 0xc7, 0xe6, 0x3d, 0xd7, 0xeb, 0xb0, 0xd4, 0x35, 0xce, 0x50, 0xd9,
+	0x6b, 0x1c, 0xfd, 0x00, 0xb4, 0x5e, 0x1b, 0x70, 0xc0, 0xee, 0x2d, 0xc0, 0x68, 0xd0, 0xd9, 0xdf,
+	0xb7, 0x8f, 0x54, 0xff, 0xd4, 0x9b, 0xfe, 0x19, 0x5c, 0x8b, 0x4d, 0x55, 0xeb, 0x6e, 0x77, 0xcb,
+	0x3e, 0x22, 0xfe, 0xb2, 0x85, 0x3d, 0x76, 0x36, 0xbf, 0x52, 0xfd, 0x2f, 0x2b, 0x70, 0xa1, 0x87,
+	0x11, 0x6c, 0xc0, 0x4f, 0x78, 0x1f, 0xea, 0xca, 0x9c, 0x29, 0x64, 0x98, 0xfe, 0x37, 0x19, 0x49,
+	0x74, 0x2e, 0x63, 0xa9, 0x5b, 0x08, 0xa1, 0xa1, 0x4b, 0x27, 0xb6, 0x24, 0x48, 0xb7, 0x00, 0x6d,
+	0x52, 0x6c, 0x35, 0x0f, 0x88, 0x65, 0xc5, 0x92, 0xfd, 0x0d, 0x98, 0x76, 0x30, 0x23, 0xae, 0x79,
+	0xbc, 0x7b, 0xe0, 0x93, 0xe0, 0x80, 0x3a, 0x96, 0x12, 0xf2, 0x73, 0x70, 0xa4, 0xc3, 0x70, 0x9b,
+	0x5a, 0x72, 0x09, 0x4c, 0xde, 0x9e, 0x8c, 0x27, 0x9a, 0x43, 0x0d, 0x51, 0xa6, 0xfb, 0x00, 0xb1,
+	0x81, 0x6a, 0xc0, 0x91, 0x58, 0x82, 0x61, 0x2e, 0xbe, 0x97, 0x18, 0x02, 0x81, 0xa7, 0xff, 0x1a,
+	0xcc, 0x16, 0x98, 0xf5, 0x06, 0x6c, 0x5c, 0xea, 0xce, 0x1b, 0x9b, 0x2b, 0x25, 0x9a, 0x57, 0x98,
+	0xfa, 0xff, 0x54, 0xe1, 0x8a, 0x58, 0x59, 0x09, 0x2d, 0x4e, 0x2c, 0xb1, 0x70, 0x45, 0x6c, 0x43,
+	0xe3, 0x30, 0x5a, 0x2c, 0x5c, 0x7e, 0x96, 0x1d, 0xfa, 0x46, 0x91, 0x41, 0xb5, 0x70, 0x95, 0x1a,
+	0x69, 0x7a, 0x74, 0x1f, 0x20, 0xb6, 0xa4, 0xa8, 0x9e, 0xbe, 0x92, 0x32, 0x83, 0xa8, 0xb2, 0x82,
+	0xaa, 0x12, 0x94, 0xe8, 0x6d, 0x18, 0x09, 0x98, 0x65, 0x53, 0x6d, 0x28, 0x7b, 0xf6, 0x37, 0x39,
+	0xb8, 0x80, 0x5a, 0xe2, 0xa3, 0x0d, 0xa8, 0x07, 0x0c, 0x9b, 0x87, 0x96, 0x6f, 0x77, 0x49, 0xc1,
+	0x0d, 0x76, 0x33, 0x2e, 0x2c, 0xa8, 0x24, 0x49, 0xcb, 0x2d, 0xff, 0x9d, 0x80, 0x84, 0x08, 0xc6,
+	0x5a, 0xa0, 0x8d, 0xf4, 0x1d, 0xf9, 0x0c, 0x85, 0xfe, 0xb3, 0x2a, 0x5c, 0x14, 0xed, 0x84, 0xca,
+	0xfa, 0x2f, 0x86, 0xff, 0xab, 0x1c, 0xfe, 0xbf, 0xad, 0x40, 0x5d, 0xb4, 0xa3, 0x06, 0xfc, 0x4d,
+	0x18, 0x95, 0x86, 0x44, 0x35, 0xd2, 0x97, 0x13, 0xc6, 0xe8, 0x78, 0x96, 0x42, 0x5d, 0x4a, 0xa2,
+	0xa2, 0xf7, 0xa1, 0x16, 0x59, 0xd3, 0xb4, 0x6a, 0x56, 0x34, 0x4a, 0xef, 0x2f, 0x45, 0x1a, 0x13,
+	0xa0, 0x15, 0x18, 0xc7, 0x6a, 0xd6, 0xb5, 0xa1, 0xec, 0x84, 0x9c, 0xb4, 0x39, 0x8d, 0x88, 0x4e,
+	0xff, 0xe9, 0x30, 0xcc, 0xe4, 0xfa, 0xf7, 0x73, 0x67, 0xcd, 0x50, 0x56, 0x8a, 0xe1, 0x41, 0xac,
+	0x14, 0x09, 0x9e, 0x38, 0x32, 0xc0, 0xe1, 0x3f, 0x9a, 0x3c, 0xfc, 0xcf, 0xd7, 0xe9, 0x38, 0xab,
+	0xef, 0x8c, 0xf7, 0xd0, 0x77, 0xbe, 0x9d, 0x98, 0x67, 0x69, 0xf2, 0x78, 0xb1, 0x70, 0x71, 0xf5,
+	0x9a, 0x64, 0x6e, 0xfa, 0x0f, 0x48, 0xc0, 0xcf, 0x89, 0x50, 0x53, 0x5b, 0x2f, 0x6d, 0x06, 0xe9,
+	0x41, 0x99, 0x96, 0x83, 0xea, 0xa5, 0xe5, 0xa0, 0x7f, 0x07, 0x98, 0x2b, 0x5a, 0xd7, 0x85, 0x4b,
+	0xae, 0x7a, 0x0e, 0x4b, 0x6e, 0xa8, 0xc4, 0x92, 0x1b, 0xee, 0xbd, 0xe4, 0x46, 0xce, 0xb8, 0xe4,
+	0x46, 0x4f, 0x6d, 0x67, 0x1a, 0x3b, 0x8d, 0x9d, 0x29, 0x5a, 0xa6, 0xe3, 0xc9, 0x65, 0xfa, 0x21,
+	0x4c, 0x70, 0xd3, 0x4a, 0xa0, 0xe4, 0x1e, 0xad, 0x96, 0xbd, 0x1e, 0xcb, 0x4b, 0x45, 0x46, 0x8a,
+	0xe2, 0xe7, 0xd6, 0xad, 0x34, 0xbb, 0x65, 0x26, 0x7a, 0x46, 0x07, 0xe4, 0xb4, 0xd9, 0xa9, 0xa7,
+	0xa0, 0xcd, 0x4e, 0x9f, 0x55, 0x9b, 0x8d, 0xaf, 0x2d, 0x66, 0x4a, 0x5f, 0x5b, 0x08, 0x73, 0xbc,
+	0x47, 0x7d, 0xb6, 0x82, 0x99, 0x79, 0xb0, 0x85, 0x8f, 0xb8, 0x2d, 0x57, 0xb9, 0x62, 0x16, 0x94,
+	0x70, 0x2d, 0x38, 0x0d, 0xe5, 0x0e, 0x51, 0x36, 0x91, 0xb7, 0xb9, 0x0d, 0xa3, 0xb8, 0x30, 0xbd,
+	0xbf, 0x1b, 0xa5, 0xdd, 0xd5, 0x7a, 0xb3, 0x9a, 0xc9, 0x81, 0x59, 0x4d, 0x3f, 0x73, 0xd9, 0xdc,
+	0xb3, 0x30, 0x97, 0xcd, 0x7f, 0x05, 0xd1, 0x0b, 0x0b, 0x67, 0x74, 0x8b, 0x75, 0x00, 0xe5, 0x2f,
+	0xc2, 0x07, 0x54, 0x12, 0x16, 0xa1, 0xae, 0xa2, 0x27, 0x85, 0xb6, 0x25, 0x75, 0xce, 0x24, 0x48,
+	0xff, 0xd1, 0x30, 0x4c, 0x71, 0x7f, 0x9f, 0xe5, 0x16, 0x71, 0xd9, 0x19, 0x15, 0x12, 0xc1, 0x09,
+	0xab, 0x03, 0x71, 0xc2, 0xa1, 0x24, 0x27, 0xcc, 0xf2, 0xb1, 0xe1, 0x81, 0xf9, 0x58, 0x66, 0x6a,
+	0x46, 0xce, 0x68, 0x15, 0xea, 0xb7, 0xa6, 0x47, 0x9f, 0xc5, 0x9a, 0x1e, 0x7b, 0x0a, 0x6b, 0x5a,
+	0xff, 0xcd, 0x0a, 0x5c, 0x3e, 0xe1, 0xf6, 0x1f, 0x7d, 0x90, 0x52, 0xb1, 0x6f, 0x94, 0x72, 0x19,
+	0x58, 0xda, 0x8a, 0xd5, 0xef, 0xeb, 0x30, 0xcc, 0xdf, 0x50, 0x03, 0x6a, 0xcb, 0x9b, 0x9b, 0xdb,
+	0x9f, 0x3d, 0x5e, 0x7e, 0xf8, 0xc5, 0xf4, 0x73, 0x68, 0x06, 0x1a, 0xc6, 0xfa, 0x47, 0x1b, 0xcd,
+	0x5d, 0xe3, 0x8b, 0xc7, 0xdb, 0x0f, 0x37, 0xbf, 0x98, 0xae, 0xe8, 0x3f, 0x9d, 0x82, 0xba, 0xbc,
+	0x14, 0x3d, 0xcb, 0xe2, 0x7c, 0x2a, 0x82, 0x4a, 0x0f, 0xb9, 0x37, 0x2b, 0xcc, 0x0c, 0x17, 0x08,
+	0x33, 0xa7, 0x08, 0xda, 0x2d, 0x90, 0x68, 0xef, 0xc0, 0x58, 0x20, 0x3d, 0x4e, 0xca, 0x04, 0xb8,
+	0x28, 0x54, 0xf4, 0x12, 0x34, 0xc4, 0x4d, 0x7e, 0x13, 0xb7, 0x3d, 0x7e, 0xaa, 0x09, 0xf1, 0xa3,
+	0x62, 0xa4, 0x81, 0x83, 0x06, 0xea, 0x16, 0xb8, 0x72, 0x42, 0xb1, 0x2b, 0xa7, 0x92, 0xd1, 0xea,
+	0x83, 0xc8, 0x68, 0x59, 0xce, 0x30, 0x31, 0x30, 0x67, 0x30, 0xe1, 0xda, 0x61, 0xe8, 0x8a, 0xcf,
+	0x45, 0x06, 0xe2, 0x77, 0x05, 0xaf, 0x75, 0x89, 0xc9, 0x1b, 0x5e, 0x6e, 0x11, 0xad, 0xd1, 0xef,
+	0xa2, 0xb2, 0x5f, 0x0d, 0x68, 0x93, 0x7b, 0x1f, 0x7a, 0x0e, 0x3d, 0x6e, 0x13, 0x97, 0xc9, 0x7b,
+	0x39, 0x6d, 0xb2, 0x5c, 0x97, 0x8d, 0x1c, 0x65, 0xce, 0xaf, 0x6b, 0x6a, 0x20, 0xbf, 0xae, 0x7e,
+	0x3c, 0x6c, 0xfa, 0x59, 0xf0, 0xb0, 0x99, 0xa7, 0x71, 0x2e, 0xbf, 0x03, 0x35, 0x33, 0x72, 0xe4,
+	0x42, 0xfd, 0xfd, 0xfa, 0x22, 0x64, 0x74, 0x17, 0xc6, 0x94, 0x01, 0x5f, 0x9b, 0xcd, 0x4a, 0xe1,
+	0x82, 0x17, 0xa5, 0x9d, 0x09, 0x43, 0xe4, 0x84, 0x60, 0x38, 0x57, 0x5a, 0x30, 0x54, 0xc7, 0xe6,
+	0xfc, 0x69, 0x8e, 0xcd, 0xd8, 0x6c, 0xb1, 0x90, 0x35, 0x5b, 0x88, 0xee, 0x15, 0x9a, 0x2d, 0x0a,
+	0xa4, 0x6b, 0xed, 0x29, 0x48, 0xd7, 0x17, 0xcf, 0x39, 0x9e, 0xe0, 0xd2, 0x19, 0xcf, 0xec, 0x2d,
+	0x68, 0x60, 0xcf, 0x4b, 0x38, 0x04, 0x5e, 0x3e, 0xe5, 0xfd, 0x48, 0x8a, 0x1a, 0x1d, 0xc0, 0x0b,
+	0xf2, 0x4c, 0xd9, 0xe1, 0x53, 0x6a, 0x52, 0xa7, 0xe9, 0xda, 0xfb, 0xfb, 0xf2, 0xbb, 0xc2, 0xb3,
+	0x4f, 0xbb, 0xd2, 0x77, 0xf6, 0xfb, 0x57, 0x82, 0xf6, 0x61, 0xb1, 0x27, 0xd2, 0x86, 0x2b, 0x1b,
+	0xba, 0xda, 0xb7, 0xa1, 0xbe, 0x75, 0x14, 0xe8, 0x7a, 0xcf, 0x9f, 0x41, 0xd7, 0xfb, 0x36, 0x8f,
+	0x63, 0xe7, 0xeb, 0x4e, 0xba, 0x06, 0x68, 0xd7, 0x0a, 0x17, 0xe8, 0x6a, 0x02, 0xc5, 0x48, 0x11,
+	0xe8, 0x7f, 0x5d, 0x01, 0x94, 0xdf, 0x63, 0xc2, 0xbf, 0x58, 0x02, 0x42, 0xbf, 0x95, 0x8a, 0xf2,
+	0x2f, 0x4e, 0x41, 0xd1, 0xa7, 0x30, 0x6f, 0x47, 0x84, 0x8c, 0xaf, 0x30, 0xe2, 0x6f, 0xc5, 0x92,
+	0x4a, 0x22, 0x1d, 0x41, 0x21, 0x9a, 0x51, 0x4c, 0xcd, 0xcf, 0xf4, 0xb0, 0xc0, 0xc1, 0x41, 0xa0,
+	0xe4, 0xd5, 0x14, 0x4c, 0xdf, 0x80, 0x99, 0xdc, 0xee, 0x1b, 0xf0, 0xd6, 0xe6, 0xc7, 0x15, 0x98,
+	0xca, 0xda, 0x5a, 0x06, 0x13, 0x7c, 0x5e, 0x83, 0x6a, 0xf7, 0x96, 0x56, 0xcd, 0xce, 0x42, 0x54,
+	0xf9, 0xa3, 0x5b, 0x8a, 0x4d, 0x54, 0xbb, 0xb7, 0x04, 0xf2, 0x6d, 0x6d, 0xa8, 0x37, 0xf2, 0xed,
+	0x08, 0xf9, 0x36, 0xff, 0xdc, 0x5c, 0x2d, 0x03, 0x7e, 0xee, 0x3f, 0x57, 0x60, 0x26, 0xd7, 0xc8,
+	0x80, 0x1f, 0xbc, 0x5e, 0x60, 0xf2, 0x7e, 0xb9, 0xf0, 0x5b, 0x62, 0xeb, 0x77, 0x81, 0xc5, 0xfb,
+	0x41, 0xda, 0x70, 0x9d, 0xb3, 0xd4, 0x26, 0xea, 0x11, 0x36, 0xec, 0x35, 0x81, 0x57, 0x60, 0xb7,
+	0xd6, 0x9b, 0x70, 0xf9, 0x84, 0x46, 0x07, 0x1c, 0xb1, 0xbf, 0xaf, 0xc2, 0x95, 0x93, 0xba, 0x30,
+	0xe0, 0xe0, 0xdd, 0x89, 0x1d, 0xda, 0x4b, 0x44, 0x28, 0x29, 0x54, 0xee, 0x15, 0x15, 0x3b, 0x85,
+	0x97, 0x08, 0xb2, 0x49, 0x60, 0xa3, 0xbb, 0x30, 0xce, 0xa8, 0x47, 0x1d, 0xda, 0x3a, 0x2e, 0x11,
+	0x4b, 0x13, 0xe1, 0xa2, 0x07, 0xc2, 0x55, 0x6e, 0xdf, 0x6e, 0x6d, 0x77, 0x89, 0xef, 0xdb, 0x56,
+	0xf9, 0x28, 0xcc, 0x0c, 0x9d, 0xbe, 0xae, 0xb6, 0x6d, 0x92, 0x27, 0x71, 0x7f, 0xcb, 0xa0, 0xb3,
+	0x17, 0x98, 0xbe, 0xbd, 0x47, 0xac, 0x38, 0x74, 0x44, 0xf2, 0x9c, 0xa2, 0x22, 0xfd, 0x07, 0x50,
+	0x4f, 0x78, 0xc7, 0x70, 0x2f, 0x0f, 0x97, 0xab, 0xdc, 0x92, 0x42, 0x3c, 0x47, 0xf1, 0xa8, 0xd5,
+	0x44, 0x3c, 0xea, 0x25, 0x18, 0xe7, 0x72, 0xe9, 0x4e, 0x1c, 0xa7, 0x1a, 0xbd, 0xf3, 0x7c, 0x1f,
+	0x32, 0xf7, 0x90, 0x28, 0x1d, 0x16, 0xa5, 0x09, 0x88, 0xfe, 0x2f, 0x63, 0x30, 0x9d, 0x5b, 0x4f,
+	0x91, 0x87, 0x6b, 0x5c, 0x12, 0x76, 0xb2, 0xc4, 0x4a, 0xe8, 0x49, 0x3b, 0x60, 0xf0, 0x5a, 0x56,
+	0xcf, 0x19, 0xea, 0xa1, 0xe7, 0xa8, 0x78, 0x96, 0xe1, 0x5c, 0xfa, 0xa3, 0x91, 0xd8, 0xbf, 0xf9,
+	0x0a, 0xd7, 0x4c, 0x18, 0x71, 0xa3, 0x9c, 0x00, 0x35, 0x23, 0x06, 0xe4, 0x54, 0x83, 0xb1, 0x81,
+	0x55, 0x83, 0x65, 0x98, 0x0c, 0x4c, 0x1f, 0x0b, 0xa9, 0x85, 0xf8, 0x5d, 0xec, 0x68, 0xe3, 0xfd,
+	0x34, 0x81, 0x0c, 0x81, 0xb0, 0xbb, 0x50, 0x97, 0x91, 0x23, 0xb6, 0x83, 0xd9, 0x81, 0x56, 0x53,
+	0x76, 0x97, 0x18, 0x94, 0x14, 0x31, 0x21, 0x2b, 0x62, 0xe6, 0x93, 0xb4, 0xc5, 0x22, 0xe6, 0x7b,
+	0x30, 0xa6, 0x7c, 0x8a, 0xb4, 0x7a, 0xf6, 0x2e, 0x2e, 0x9e, 0x35, 0x75, 0x1a, 0x86, 0xc4, 0x8a,
+	0x02, 0x7d, 0x00, 0xe3, 0x81, 0x0a, 0x40, 0xd3, 0x26, 0xb2, 0xae, 0x46, 0x49, 0x6a, 0x89, 0x13,
+	0x5e, 0x49, 0x84, 0x34, 0xe7, 0x9c, 0xa7, 0xa3, 0xaf, 0x2a, 0x32, 0xf9, 0x2c, 0x54, 0x91, 0xa9,
+	0xa7, 0xa1, 0x8a, 0xa4, 0x94, 0xea, 0xe9, 0xd2, 0xf7, 0x2e, 0x7f, 0x56, 0x81, 0x2b, 0x27, 0xdd,
+	0xd1, 0x0e, 0xc8, 0xe5, 0xb7, 0x61, 0xbe, 0x2d, 0x43, 0xee, 0xd7, 0x8f, 0x3c, 0xdb, 0x3f, 0x8e,
+	0x5c, 0x73, 0xab, 0xfd, 0xd6, 0x79, 0x31, 0x9d, 0xbe, 0x03, 0x5a, 0xaf, 0xd5, 0x33, 0xe0, 0xf9,
+	0xf6, 0x93, 0x0a, 0x5c, 0xe8, 0xb1, 0x9c, 0xb3, 0x49, 0x06, 0x2b, 0x83, 0x24, 0x19, 0x5c, 0x4f,
+	0xb0, 0xdd, 0x6a, 0xf6, 0x92, 0x3d, 0xd7, 0xf0, 0x43, 0x85, 0x1a, 0x6e, 0x88, 0x90, 0x54, 0x3f,
+	0x84, 0x6b, 0x7d, 0x90, 0x07, 0x4f, 0x67, 0x10, 0x1d, 0x15, 0x0d, 0x79, 0x54, 0xe8, 0x7f, 0xd4,
+	0x80, 0x7a, 0x22, 0xd0, 0x24, 0x59, 0xf3, 0x8b, 0xe5, 0x6b, 0x7e, 0x09, 0x1a, 0xd8, 0x34, 0x49,
+	0x10, 0x6c, 0xd2, 0x16, 0xcf, 0xf2, 0xa7, 0x4e, 0xa8, 0x34, 0x90, 0x9b, 0x73, 0x62, 0x00, 0xf5,
+	0xdb, 0x38, 0xcc, 0xac, 0x90, 0x05, 0xa3, 0x0d, 0x98, 0x89, 0x40, 0xeb, 0xae, 0x49, 0xad, 0x50,
+	0x06, 0x98, 0x4c, 0x8a, 0x90, 0x39, 0x14, 0x23, 0x4f, 0xc5, 0xcf, 0x3b, 0xdc, 0x61, 0x54, 0x46,
+	0x51, 0xa9, 0xb3, 0x20, 0x01, 0xe1, 0x5d, 0x57, 0xa6, 0x6b, 0x15, 0x82, 0x22, 0x0f, 0x87, 0x34,
+	0x90, 0x67, 0x2c, 0x34, 0x69, 0xdb, 0xa3, 0x2e, 0xb7, 0x9c, 0x84, 0x49, 0x01, 0xe5, 0x71, 0x91,
+	0x2f, 0x50, 0x9c, 0xda, 0xec, 0xf8, 0x3e, 0xf7, 0x21, 0x12, 0xa7, 0x46, 0xc3, 0x48, 0x82, 0xf8,
+	0x71, 0x60, 0xb9, 0x81, 0x41, 0xf6, 0xb9, 0x7b, 0x91, 0x81, 0x19, 0x29, 0x71, 0x1c, 0xa4, 0x09,
+	0xe2, 0x98, 0x51, 0x91, 0x29, 0xac, 0xd3, 0xf6, 0xb4, 0x5a, 0xdf, 0x09, 0xcb, 0x50, 0xf0, 0x60,
+	0x74, 0x92, 0x48, 0x96, 0x11, 0x6a, 0x41, 0xb9, 0xc3, 0x23, 0x9f, 0x51, 0xc3, 0x28, 0x22, 0x44,
+	0x1f, 0x70, 0x37, 0xae, 0x2e, 0x3d, 0x6e, 0x32, 0xcc, 0x02, 0x4b, 0xab, 0x97, 0xa8, 0x27, 0x49,
+	0xc0, 0x25, 0x24, 0x95, 0xff, 0x51, 0x29, 0x92, 0xd2, 0x51, 0x52, 0x46, 0xa4, 0x16, 0x15, 0xf1,
+	0x35, 0x15, 0x82, 0x77, 0x94, 0x4b, 0xb9, 0x8a, 0x50, 0xcd, 0x80, 0x63, 0xab, 0xe7, 0x64, 0xd2,
+	0xea, 0xf9, 0x06, 0xcc, 0xda, 0x6e, 0xbe, 0xc5, 0x29, 0xd9, 0xa2, 0xed, 0x16, 0xb6, 0x68, 0xbb,
+	0xa9, 0xaa, 0x95, 0xdb, 0x7b, 0x16, 0xcc, 0xaf, 0xe7, 0xb8, 0xe7, 0x4c, 0xd7, 0xf6, 0x59, 0xc4,
+	0x31, 0x64, 0xb6, 0x95, 0x9a, 0x51, 0x50, 0x92, 0x4a, 0x38, 0x89, 0xd2, 0x09, 0x27, 0xb9, 0x38,
+	0xec, 0xf9, 0x76, 0xd7, 0x76, 0x48, 0x8b, 0x58, 0xda, 0x6c, 0xdf, 0x99, 0x4e, 0x60, 0xa3, 0x15,
+	0x1e, 0x47, 0x84, 0x2d, 0xdb, 0x25, 0x41, 0xc0, 0xa3, 0xc1, 0x6c, 0xec, 0xac, 0x11, 0x07, 0x1f,
+	0x37, 0x89, 0x49, 0x5d, 0x2b, 0x50, 0x31, 0x98, 0x27, 0xe2, 0xc8, 0x48, 0x1e, 0x55, 0xbe, 0x43,
+	0x7c, 0x9b, 0x5a, 0x21, 0xf5, 0xbc, 0xa0, 0xee, 0x51, 0x8a, 0xde, 0x87, 0x8b, 0x51, 0x09, 0x8f,
+	0xc0, 0xeb, 0xf8, 0x24, 0xf6, 0xb5, 0x5b, 0x10, 0xa4, 0xbd, 0x11, 0xf8, 0xe6, 0x0d, 0x18, 0x66,
+	0x1d, 0xe1, 0xf3, 0x2a, 0xe2, 0x1c, 0x1b, 0x46, 0x02, 0x92, 0x3e, 0x02, 0xb5, 0x53, 0xd8, 0x95,
+	0xc3, 0x20, 0xb5, 0x8b, 0x82, 0xa7, 0x4c, 0xc7, 0x34, 0x12, 0x1e, 0x85, 0xa7, 0xdd, 0x03, 0xcd,
+	0x53, 0x56, 0x8e, 0x35, 0xc2, 0xa4, 0x11, 0x36, 0x0c, 0xa3, 0x91, 0x41, 0x83, 0x3d, 0xcb, 0xd1,
+	0x2e, 0xcc, 0x8b, 0xb5, 0xbd, 0x1c, 0xf2, 0xa4, 0x70, 0x7b, 0x5d, 0xce, 0x5a, 0xb3, 0xd6, 0x53,
+	0x68, 0x61, 0x2c, 0x64, 0x21, 0x31, 0xba, 0x0d, 0x73, 0x6a, 0x65, 0x87, 0x46, 0x1d, 0xb9, 0x62,
+	0xaf, 0x88, 0xde, 0x14, 0x96, 0xe5, 0xc3, 0x65, 0xae, 0x9e, 0x32, 0x5c, 0x26, 0x1f, 0x43, 0xf4,
+	0x7c, 0x61, 0x0c, 0xd1, 0x77, 0x60, 0xc1, 0xc3, 0x3e, 0x71, 0x59, 0xf3, 0xa0, 0xc3, 0x2c, 0xfa,
+	0x24, 0x6e, 0x71, 0xb1, 0x5f, 0x8b, 0x3d, 0x08, 0xf5, 0x5f, 0xaf, 0xc2, 0x5c, 0xd1, 0xf8, 0x3c,
+	0xa5, 0x94, 0x3e, 0x35, 0xa5, 0x42, 0xad, 0x17, 0xa5, 0xf4, 0x79, 0xb1, 0xd7, 0x94, 0x25, 0x50,
+	0x9f, 0x46, 0x56, 0x9f, 0x7f, 0xad, 0xc0, 0xc5, 0x9e, 0x0d, 0xf2, 0xee, 0x8b, 0x6b, 0x33, 0xa5,
+	0x15, 0xf2, 0x67, 0x71, 0x5e, 0x39, 0x36, 0xbf, 0x7d, 0x8d, 0xfd, 0xa8, 0xd5, 0x37, 0xe7, 0x0b,
+	0xf8, 0x36, 0xe3, 0xec, 0x02, 0x33, 0xf2, 0x09, 0x39, 0x56, 0xc3, 0x90, 0x80, 0x88, 0xe9, 0xc7,
+	0xab, 0x49, 0x0f, 0xee, 0x30, 0x84, 0x2c, 0x05, 0xe5, 0xea, 0x55, 0xe0, 0xda, 0xa1, 0x7a, 0x15,
+	0xb8, 0x36, 0x67, 0x96, 0x41, 0x67, 0x8f, 0x1f, 0xb4, 0xcb, 0x8e, 0xcc, 0xa3, 0xa1, 0x8d, 0x8a,
+	0x88, 0x9f, 0x2c, 0x58, 0xff, 0x1e, 0x4c, 0x65, 0xe2, 0x57, 0x63, 0x8e, 0x5d, 0xe9, 0xe9, 0x76,
+	0x3d, 0x52, 0x5a, 0xec, 0x5d, 0x85, 0x0b, 0x3d, 0x32, 0xe6, 0xa1, 0x69, 0x79, 0x79, 0x24, 0x5b,
+	0xe1, 0x8f, 0x32, 0x0a, 0xbe, 0x4d, 0x95, 0x2b, 0x5e, 0xcd, 0x50, 0x6f, 0xfa, 0x1f, 0x56, 0xa1,
+	0x16, 0x85, 0xcc, 0x0e, 0xb8, 0x02, 0x35, 0x18, 0xeb, 0x58, 0x81, 0x50, 0xe1, 0x64, 0xe5, 0xe1,
+	0x2b, 0x77, 0x93, 0xef, 0x04, 0xe4, 0x21, 0x17, 0x81, 0x9c, 0x8f, 0x9f, 0xb0, 0x12, 0x46, 0x8f,
+	0x14, 0x3e, 0x7a, 0x00, 0x33, 0x9d, 0x80, 0xec, 0xf2, 0x90, 0xd8, 0x27, 0xd4, 0x67, 0x07, 0xc7,
+	0xbc, 0x92, 0xfe, 0xf6, 0x8f, 0x3c, 0x11, 0xba, 0x0b, 0x23, 0x8c, 0x1e, 0x12, 0xb7, 0xf4, 0x7a,
+	0x95, 0xe8, 0xfa, 0xaf, 0xc0, 0x44, 0x32, 0xee, 0x85, 0x6b, 0xd7, 0x6d, 0xae, 0x8a, 0x8b, 0xaf,
+	0x95, 0xe3, 0x1b, 0x03, 0x22, 0x73, 0x46, 0x35, 0x61, 0xce, 0xe0, 0x1c, 0x5f, 0xd4, 0x90, 0xf0,
+	0xe4, 0x4e, 0x40, 0xf4, 0xdf, 0x1b, 0x83, 0xc9, 0xf3, 0x50, 0x06, 0x72, 0x46, 0x84, 0x6a, 0xbf,
+	0xcb, 0xd2, 0x94, 0x37, 0xc1, 0x3d, 0xde, 0x4d, 0x67, 0xbf, 0x69, 0xb7, 0xdc, 0x52, 0xf9, 0x5a,
+	0x12, 0xd8, 0xd9, 0x90, 0xe7, 0x91, 0x7c, 0xc8, 0xf3, 0x0a, 0x8c, 0x5b, 0x6e, 0xc0, 0xb7, 0x96,
+	0xdc, 0x2e, 0x29, 0x23, 0x61, 0xfa, 0xeb, 0x97, 0xd6, 0x14, 0xa2, 0xca, 0x5d, 0x1b, 0xd2, 0x89,
+	0x54, 0x35, 0xc2, 0xec, 0xc2, 0xbd, 0xc3, 0x55, 0xb0, 0xcb, 0x58, 0x89, 0x54, 0x35, 0x19, 0x1a,
+	0xf4, 0x39, 0x5c, 0x94, 0x43, 0x16, 0xdf, 0x57, 0xac, 0x1c, 0xab, 0xe4, 0x26, 0x25, 0x12, 0xa8,
+	0xf4, 0x26, 0x46, 0x1f, 0x03, 0x32, 0x6d, 0x86, 0x2d, 0xe2, 0x3c, 0x20, 0xd8, 0x61, 0x07, 0x22,
+	0x4a, 0xbf, 0x84, 0x10, 0x5b, 0x40, 0x75, 0x8e, 0x5e, 0x6a, 0x83, 0xc4, 0x78, 0xe6, 0x6f, 0x3b,
+	0x26, 0xce, 0x94, 0xa1, 0x7c, 0x8a, 0xdf, 0xaa, 0x3a, 0x14, 0x5b, 0x7c, 0x2a, 0x77, 0x99, 0x13,
+	0x8a, 0xb4, 0x19, 0xf0, 0x39, 0x27, 0x5d, 0xe6, 0x39, 0x8b, 0x53, 0xab, 0xe9, 0x54, 0x99, 0xb2,
+	0xfe, 0xb8, 0x02, 0x8d, 0xf3, 0x57, 0xa9, 0x75, 0x98, 0x08, 0x43, 0xb3, 0x76, 0x62, 0xd5, 0x35,
+	0x05, 0x8b, 0xd8, 0xc8, 0x50, 0xda, 0x2a, 0x9a, 0x4d, 0x4b, 0xa8, 0xff, 0xb8, 0x06, 0xf3, 0x85,
+	0x39, 0x35, 0x06, 0xe4, 0x20, 0x27, 0xee, 0x8c, 0xea, 0x59, 0x76, 0x46, 0x39, 0x0f, 0xa6, 0xc1,
+	0xd7, 0xf8, 0x17, 0x30, 0xeb, 0x92, 0x2e, 0x51, 0xc3, 0x30, 0x60, 0xee, 0x5f, 0xa3, 0xa8, 0x0e,
+	0x11, 0x96, 0xe6, 0xf0, 0x74, 0x67, 0x99, 0xba, 0x27, 0x4e, 0x1b, 0x96, 0x56, 0x50, 0x49, 0x5f,
+	0xdb, 0x5e, 0xe3, 0x59, 0xd8, 0xf6, 0x26, 0xbf, 0x8a, 0x54, 0x82, 0x53, 0x3d, 0xbd, 0x5b, 0x67,
+	0x7d, 0xf2, 0xc4, 0xb7, 0x19, 0x59, 0xf6, 0xbc, 0x07, 0xbb, 0xbb, 0x3b, 0x3b, 0x3e, 0xdd, 0x0b,
+	0xbd, 0x51, 0x4f, 0xcc, 0x8c, 0x52, 0x40, 0x96, 0x39, 0xd5, 0x66, 0x4e, 0x7b, 0xaa, 0xd9, 0x62,
+	0xb6, 0xc4, 0x87, 0xa8, 0x8d, 0x97, 0x04, 0x21, 0x03, 0x66, 0xe5, 0x2b, 0x49, 0xb1, 0xca, 0xb2,
+	0x99, 0x84, 0x8a, 0x88, 0xd3, 0xc2, 0xe0, 0x5c, 0x69, 0x05, 0xf0, 0x01, 0x4c, 0xd2, 0xbd, 0xd4,
+	0xfa, 0x2c, 0xeb, 0x2a, 0x91, 0xa1, 0x3b, 0x6f, 0x3f, 0xcd, 0xdf, 0xae, 0xc0, 0x85, 0x1e, 0xd1,
+	0x2f, 0x03, 0x72, 0x29, 0x9e, 0x2c, 0xa8, 0xc3, 0xbc, 0x0e, 0x53, 0xb9, 0xa8, 0xfa, 0x33, 0xa6,
+	0x14, 0xbe, 0xfe, 0x5b, 0x55, 0xb8, 0x7a, 0x62, 0x40, 0xcd, 0x80, 0xfd, 0x7a, 0x53, 0xc4, 0xb9,
+	0x1d, 0xa8, 0xfe, 0x5c, 0x2b, 0x8c, 0xde, 0x59, 0xee, 0xb0, 0x38, 0x51, 0x60, 0x87, 0x1d, 0xa0,
+	0x77, 0x23, 0xc5, 0xbd, 0x20, 0x66, 0x28, 0x22, 0x2b, 0x4c, 0x34, 0xb3, 0x0e, 0x13, 0xea, 0xaa,
+	0xe4, 0x23, 0x1f, 0x7b, 0x07, 0xda, 0xf0, 0x09, 0x15, 0xac, 0x26, 0x10, 0x8d, 0x14, 0x99, 0xfe,
+	0xa7, 0x15, 0x98, 0x2f, 0xec, 0x21, 0xb7, 0xc7, 0x61, 0xcf, 0x5b, 0xf5, 0x89, 0x45, 0x5c, 0x66,
+	0x63, 0x27, 0x28, 0x31, 0x1a, 0x19, 0x0a, 0xae, 0x77, 0x60, 0xcf, 0xe6, 0x4a, 0x98, 0xd2, 0x3b,
+	0xe4, 0x1b, 0xb7, 0x24, 0x85, 0x41, 0xdf, 0xa6, 0x19, 0x09, 0xd4, 0xf2, 0x74, 0x28, 0x28, 0xd1,
+	0x7f, 0x15, 0x2e, 0x24, 0x3a, 0x99, 0x1c, 0x8f, 0x01, 0x67, 0xeb, 0x75, 0x98, 0x09, 0xb8, 0x33,
+	0x1f, 0xbf, 0xc4, 0xdb, 0xc3, 0x32, 0x4b, 0xa0, 0x3a, 0x8c, 0xf3, 0x05, 0xfa, 0x36, 0x5c, 0xe8,
+	0x31, 0x9a, 0x03, 0x5a, 0xee, 0xff, 0xa6, 0x02, 0x13, 0xa9, 0xaf, 0x78, 0x1b, 0xc6, 0x2c, 0xcc,
+	0xb0, 0x45, 0x5b, 0xf9, 0xcc, 0x99, 0x12, 0x71, 0x4d, 0x16, 0x87, 0xb7, 0x55, 0x0a, 0x1b, 0x7d,
+	0x0b, 0x6a, 0x8e, 0xdd, 0x3a, 0x60, 0x01, 0x23, 0x5e, 0x7e, 0xed, 0x49, 0xd2, 0x4d, 0x8e, 0xd0,
+	0x64, 0xc4, 0x53, 0xc4, 0x31, 0x05, 0xba, 0x03, 0xa3, 0x3f, 0xb4, 0xbd, 0x43, 0x3b, 0x4c, 0xfb,
+	0x78, 0x25, 0x4b, 0xfb, 0xa5, 0x28, 0x0d, 0xd7, 0x9e, 0xc4, 0xd5, 0x6f, 0xc2, 0x6c, 0x41, 0xa7,
+	0xb8, 0x26, 0x88, 0x55, 0x3a, 0x1c, 0x29, 0x62, 0x85, 0xaf, 0xfa, 0x9f, 0x57, 0x60, 0xbe, 0xb0,
+	0x2f, 0xbd, 0x69, 0x38, 0x03, 0x96, 0xd6, 0xef, 0x5d, 0xa1, 0xb9, 0x29, 0xb7, 0xec, 0x04, 0x48,
+	0xfc, 0xea, 0x81, 0xd7, 0x99, 0x5c, 0x3d, 0x09, 0x08, 0xf7, 0x34, 0x13, 0xb7, 0x72, 0xa4, 0x84,
+	0x42, 0xa3, 0x30, 0xf5, 0x25, 0x40, 0xf9, 0x0f, 0x3f, 0xe1, 0xcb, 0x7e, 0x32, 0x0a, 0x0d, 0x95,
+	0x6f, 0xf0, 0x4c, 0x0b, 0xf2, 0x9d, 0xf8, 0xaa, 0x33, 0x17, 0x2d, 0xa7, 0xea, 0xef, 0x71, 0xd9,
+	0xf9, 0x16, 0x8c, 0x7e, 0x1f, 0x93, 0x56, 0xc4, 0x43, 0xae, 0xe6, 0x08, 0x3f, 0x16, 0xc5, 0xe1,
+	0x1c, 0x4a, 0xe4, 0x73, 0xf4, 0x1f, 0xbf, 0x04, 0xe3, 0x9e, 0x4f, 0xbb, 0xb6, 0x45, 0x7c, 0xa5,
+	0xfc, 0x45, 0xef, 0xe8, 0x56, 0x7c, 0x13, 0x3b, 0x9a, 0xcd, 0x9e, 0xdd, 0xe3, 0xfe, 0xf5, 0xad,
+	0x68, 0x49, 0x8e, 0xf5, 0xf8, 0x9e, 0xa2, 0x35, 0xc9, 0x73, 0x1c, 0x52, 0x8f, 0xb8, 0x26, 0x71,
+	0x83, 0x4e, 0x98, 0x09, 0xf3, 0x85, 0x1c, 0xe9, 0x76, 0x84, 0xa2, 0xc8, 0x13, 0x44, 0x25, 0x2e,
+	0xa4, 0xbf, 0x3e, 0x02, 0x5b, 0x46, 0x0e, 0x98, 0x3a, 0xa3, 0x1c, 0xf0, 0x0f, 0x15, 0xb8, 0xd0,
+	0x63, 0x0a, 0x42, 0xb7, 0x86, 0x4a, 0xce, 0xad, 0xa1, 0x1a, 0xbb, 0x35, 0x3c, 0xe0, 0x7f, 0x60,
+	0xf2, 0xa8, 0x9f, 0x88, 0x08, 0xbd, 0x71, 0xc2, 0xe4, 0xae, 0x87, 0xb8, 0x21, 0xc7, 0x8b, 0x88,
+	0xd3, 0x29, 0x56, 0x46, 0x06, 0x4a, 0xb1, 0xa2, 0xef, 0xc3, 0x62, 0xbf, 0x26, 0xb9, 0xb6, 0x98,
+	0xf4, 0x8d, 0x2a, 0xad, 0x2d, 0x26, 0x88, 0xb8, 0xcb, 0xd7, 0x5c, 0xd1, 0xe6, 0x1f, 0x90, 0xc7,
+	0x64, 0x14, 0xd8, 0xea, 0x20, 0x0a, 0x6c, 0xf4, 0x4b, 0xbb, 0xa1, 0xe4, 0x2f, 0xed, 0x06, 0xf9,
+	0x1d, 0xdd, 0x9f, 0x54, 0x61, 0xb6, 0x80, 0x41, 0x95, 0x5a, 0x0e, 0xef, 0x45, 0xf6, 0xcc, 0xa1,
+	0xac, 0x21, 0x3b, 0x55, 0xe5, 0x96, 0x40, 0x0a, 0x39, 0x85, 0x24, 0x11, 0x36, 0x5c, 0x0f, 0xbb,
+	0x4d, 0x46, 0x7d, 0xdc, 0x22, 0xbc, 0x8b, 0xca, 0xfc, 0x9b, 0x05, 0xf3, 0x61, 0xf6, 0x88, 0x1f,
+	0xd8, 0x01, 0x2b, 0x13, 0x60, 0xab, 0x50, 0x79, 0x16, 0x86, 0x40, 0x56, 0x12, 0x27, 0x59, 0x94,
+	0x57, 0xab, 0x39, 0xb8, 0xb8, 0xcd, 0x15, 0x27, 0x9a, 0x70, 0xbf, 0x54, 0xff, 0xa0, 0x8b, 0x21,
+	0xfa, 0x3d, 0xb8, 0xd8, 0xf3, 0x83, 0xd0, 0x55, 0x80, 0x36, 0x3e, 0x7a, 0x2c, 0x04, 0xc2, 0x40,
+	0xfd, 0xc7, 0xaf, 0xd6, 0xc6, 0x47, 0xbb, 0x02, 0xa0, 0xff, 0x55, 0x3c, 0xc0, 0xa9, 0xc3, 0xac,
+	0xcc, 0x00, 0xbf, 0xce, 0x33, 0x45, 0xd2, 0x3d, 0xd2, 0x64, 0xd8, 0x67, 0x1d, 0x4f, 0x5c, 0x9d,
+	0xa9, 0x38, 0x8e, 0x7c, 0x01, 0x37, 0x8b, 0xfe, 0xa0, 0x43, 0xfc, 0xe3, 0xc8, 0x05, 0xab, 0x61,
+	0xc4, 0x80, 0x01, 0x0d, 0xdc, 0xdc, 0x54, 0xf2, 0x7d, 0xdc, 0xc5, 0xdb, 0x1e, 0x0b, 0x1e, 0x10,
+	0xec, 0xc9, 0xec, 0xf3, 0x46, 0x0a, 0xc6, 0x8f, 0x9e, 0x36, 0x3e, 0x6a, 0x7a, 0x58, 0xc5, 0x2b,
+	0x37, 0x8c, 0xe8, 0x1d, 0xbd, 0x05, 0xc3, 0xfc, 0x98, 0xea, 0x79, 0x14, 0xc8, 0x31, 0xe1, 0x8e,
+	0x08, 0xa1, 0x48, 0xce, 0xd1, 0xf5, 0x6f, 0xc2, 0x85, 0x1e, 0x08, 0xdc, 0x08, 0x63, 0x7a, 0x9d,
+	0x70, 0xa4, 0xc5, 0xb3, 0xfe, 0xbb, 0x15, 0x98, 0xfd, 0xc4, 0xc6, 0x8e, 0x7d, 0x2e, 0x46, 0xdc,
+	0xcb, 0x50, 0xe3, 0xd2, 0xcb, 0xe3, 0x7d, 0xdb, 0x09, 0x4d, 0x52, 0xe3, 0x1c, 0xa0, 0xdc, 0x0d,
+	0xa6, 0xd5, 0x1d, 0xc6, 0xe3, 0x43, 0x72, 0x2c, 0x71, 0x86, 0xd4, 0x9f, 0xfe, 0xa2, 0xbb, 0x0d,
+	0x8e, 0xc9, 0x7f, 0x1a, 0x31, 0x27, 0x3a, 0xb5, 0x86, 0x83, 0x83, 0x3d, 0x8a, 0xfd, 0x30, 0x8f,
+	0x60, 0xda, 0x1a, 0x5d, 0xc9, 0x5a, 0xa3, 0xf9, 0x09, 0xd8, 0x09, 0x88, 0xcf, 0x4d, 0x4e, 0xb1,
+	0xd0, 0x9e, 0x04, 0x71, 0xf7, 0x02, 0x0f, 0x07, 0x81, 0x77, 0xe0, 0xe3, 0x20, 0x71, 0xbb, 0x92,
+	0x06, 0x72, 0x25, 0xad, 0x6b, 0x93, 0x27, 0xdb, 0xae, 0x73, 0x2c, 0x16, 0x76, 0x7f, 0xf9, 0x2b,
+	0x85, 0xcf, 0xfb, 0xd9, 0xf2, 0xf1, 0x3e, 0x76, 0xf1, 0xa7, 0xc6, 0x66, 0xf8, 0x63, 0xc7, 0x18,
+	0xc2, 0x17, 0x9c, 0x14, 0x63, 0x78, 0xb1, 0xf2, 0x72, 0x8b, 0x00, 0xfa, 0x8f, 0x2a, 0x80, 0xc4,
+	0xe7, 0x9f, 0x07, 0xd3, 0x5c, 0xcc, 0x33, 0xcd, 0x5a, 0x9a, 0x25, 0x4e, 0x4b, 0xe6, 0x17, 0xfe,
+	0x83, 0xd0, 0x49, 0x30, 0xc9, 0xe1, 0x04, 0x93, 0xd4, 0xff, 0x62, 0x0c, 0xea, 0xa2, 0x5b, 0x67,
+	0x0d, 0xd2, 0x92, 0x36, 0xed, 0x35, 0xd2, 0xa6, 0xf2, 0x72, 0xa2, 0x4c, 0x90, 0x56, 0x96, 0x26,
+	0xe4, 0x02, 0x43, 0x39, 0x2e, 0x30, 0x1c, 0x73, 0x81, 0xb2, 0x01, 0x58, 0x3d, 0xb2, 0xc4, 0x8e,
+	0xf6, 0xce, 0x12, 0xfb, 0x6e, 0xc2, 0xc9, 0x2e, 0x27, 0xe6, 0x15, 0xec, 0xa7, 0x84, 0x7f, 0xdd,
+	0xfb, 0x50, 0xb3, 0xc2, 0x65, 0xad, 0x8d, 0x67, 0x65, 0xe5, 0xa2, 0x65, 0x6f, 0xc4, 0x04, 0x49,
+	0x97, 0xc2, 0x5c, 0xec, 0x78, 0x7e, 0xcd, 0xc4, 0x52, 0x76, 0x46, 0x36, 0x9c, 0xca, 0xcb, 0x86,
+	0xbf, 0xf8, 0x5f, 0xd1, 0xff, 0xb3, 0x7f, 0x36, 0xfe, 0xe7, 0x18, 0x8c, 0x8a, 0xdd, 0xc3, 0xff,
+	0xbb, 0x58, 0xe7, 0x6c, 0xb8, 0x2d, 0xff, 0x61, 0x9a, 0xcf, 0x83, 0x92, 0xfb, 0xc1, 0xa9, 0x91,
+	0xc4, 0xe7, 0xd9, 0x8a, 0x4d, 0xd7, 0xd6, 0xaa, 0xd9, 0xb3, 0x2f, 0xfa, 0x73, 0xae, 0xc1, 0xcb,
+	0xd1, 0x7b, 0x30, 0x21, 0xf2, 0xbf, 0x9a, 0xd4, 0x27, 0x56, 0xf4, 0x67, 0xe0, 0x84, 0xc6, 0x94,
+	0xfa, 0x87, 0xa2, 0x91, 0x42, 0xe6, 0x29, 0x66, 0x5b, 0xe2, 0xb7, 0x35, 0x8a, 0xd9, 0x2e, 0x14,
+	0xff, 0xce, 0xc6, 0x50, 0x58, 0xe8, 0x0e, 0x8c, 0xab, 0x84, 0x52, 0xe1, 0xa1, 0xac, 0xe5, 0xb2,
+	0x64, 0x46, 0xf9, 0x36, 0x42, 0x4c, 0xd1, 0x8a, 0x48, 0x29, 0xab, 0x8d, 0xe6, 0x5a, 0x49, 0xfc,
+	0x2b, 0xc3, 0x50, 0x58, 0xe8, 0x1e, 0x8c, 0x29, 0xb6, 0x5d, 0x3a, 0xfb, 0x43, 0x48, 0xc0, 0x33,
+	0x2c, 0xb6, 0xb9, 0x75, 0x4e, 0x6d, 0xf2, 0xf9, 0x4c, 0x66, 0x10, 0xd5, 0x92, 0xc4, 0xe1, 0x99,
+	0xa4, 0xf9, 0x1e, 0xc2, 0x2d, 0xe2, 0xb2, 0x28, 0x7b, 0x6a, 0x44, 0x90, 0x09, 0xde, 0x36, 0x62,
+	0x5c, 0xde, 0x8a, 0x67, 0x3b, 0x34, 0xfc, 0x43, 0xc1, 0x7c, 0x61, 0x10, 0x8e, 0x21, 0x71, 0x78,
+	0x2b, 0x71, 0x56, 0x9b, 0x0b, 0xd9, 0x56, 0x4e, 0x48, 0x68, 0x73, 0x2f, 0x15, 0x70, 0x11, 0xfe,
+	0xc9, 0xa0, 0xc0, 0x99, 0xb2, 0x20, 0xca, 0xe2, 0x4e, 0xce, 0x21, 0x59, 0xeb, 0x75, 0x7b, 0x9a,
+	0x60, 0x93, 0x9f, 0xc1, 0x42, 0x90, 0xbe, 0x1c, 0x52, 0xb9, 0xcd, 0xb5, 0x46, 0xd6, 0x4a, 0x54,
+	0x78, 0x89, 0x64, 0xf4, 0x20, 0xe7, 0x2a, 0x3d, 0x53, 0x7f, 0x64, 0x98, 0xcc, 0x2e, 0xd0, 0x94,
+	0x25, 0xc4, 0x08, 0xf1, 0xf8, 0x18, 0x1f, 0x72, 0xde, 0xaa, 0x4d, 0x65, 0xc7, 0x38, 0x71, 0x1e,
+	0x1a, 0x12, 0x87, 0xdb, 0x5a, 0xba, 0x5c, 0x92, 0xa6, 0xae, 0xf2, 0x43, 0x0b, 0x5f, 0xc5, 0xd1,
+	0xa7, 0xfe, 0x67, 0x1c, 0xc9, 0x93, 0x33, 0x25, 0x8e, 0xbe, 0x0c, 0x0d, 0x97, 0x1a, 0x7d, 0xd2,
+	0xb5, 0x45, 0x13, 0x9a, 0x14, 0xb2, 0xc2, 0x77, 0x5d, 0x83, 0x85, 0xe2, 0x75, 0xa9, 0x5f, 0x83,
+	0xab, 0x27, 0x32, 0x10, 0x7d, 0x01, 0xe6, 0x8a, 0xc2, 0xf8, 0xf4, 0x5f, 0x86, 0x46, 0xea, 0x47,
+	0x61, 0xe7, 0x9b, 0x15, 0xef, 0xc6, 0x4d, 0xe9, 0x2e, 0x83, 0x26, 0x60, 0x5c, 0xfd, 0xdc, 0xc3,
+	0x9a, 0x7e, 0x8e, 0xbf, 0x39, 0xb4, 0xf5, 0x98, 0xba, 0xce, 0xf1, 0x74, 0x05, 0xd5, 0x79, 0x8b,
+	0xfb, 0xd4, 0x37, 0xc9, 0x74, 0xf5, 0xc6, 0xbb, 0x3d, 0xa2, 0xbf, 0x38, 0xd6, 0xda, 0xfa, 0xfd,
+	0xe5, 0x4f, 0x37, 0x77, 0xa7, 0x9f, 0x43, 0x00, 0xa3, 0xcd, 0x5d, 0x63, 0x63, 0x75, 0x77, 0xba,
+	0x82, 0xc6, 0x60, 0x68, 0xfb, 0xfe, 0xfd, 0xe9, 0xea, 0x8d, 0x57, 0x0b, 0xfc, 0x58, 0xd1, 0x38,
+	0x0c, 0x7f, 0xdc, 0xdc, 0x7e, 0x38, 0xfd, 0x1c, 0x7f, 0xda, 0x5d, 0xff, 0x7c, 0x77, 0xba, 0x72,
+	0xe3, 0x8d, 0xd0, 0xb0, 0xcd, 0xeb, 0x91, 0x16, 0x9a, 0xe9, 0xe7, 0x78, 0x20, 0x7b, 0x64, 0x7a,
+	0x94, 0xbd, 0x52, 0x66, 0xcc, 0xe9, 0xea, 0x0a, 0x7c, 0x19, 0xfd, 0x0a, 0x7e, 0x6f, 0x54, 0x8c,
+	0xc3, 0x9b, 0xff, 0x3b, 0x00, 0x22, 0xba, 0x3d, 0x58, 0x49, 0x7e, 0x00, 0x00,
 }
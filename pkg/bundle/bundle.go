@@ -0,0 +1,235 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package bundle creates and reads self-contained installation bundles, used to install into clusters without access to
+the internet. A bundle is a gzipped tar archive with the following layout:
+
+	bundle.yaml              Metadata, see Metadata.
+	charts/                  The charts root directory, as used for installPackagePath.
+	profiles/default.yaml    The fully resolved IstioOperator CR the bundle was created from.
+	images.txt               The container images referenced by the rendered manifests, one per line.
+	versions.yaml            The operator to Istio versions map.
+
+The resolved profile is named default.yaml so that it is used as a complete profile, without being overlaid on
+another default profile.
+*/
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/mholt/archiver"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/vfs"
+)
+
+const (
+	// MetadataFile is the path of the bundle metadata.
+	MetadataFile = "bundle.yaml"
+	// ChartsDir is the path of the charts root directory.
+	ChartsDir = "charts"
+	// ProfileFile is the path of the resolved profile.
+	ProfileFile = "profiles/default.yaml"
+	// ImagesFile is the path of the images list.
+	ImagesFile = "images.txt"
+	// VersionsFile is the path of the versions map.
+	VersionsFile = "versions.yaml"
+
+	// vfsChartsRoot is the root of the compiled-in charts.
+	vfsChartsRoot = "charts"
+
+	iopAPIVersion = "operator.istio.io/v1alpha1"
+	iopKind       = "IstioOperator"
+)
+
+// Metadata describes a bundle.
+type Metadata struct {
+	// OperatorVersion is the version of the operator binary which created the bundle.
+	OperatorVersion string `json:"operatorVersion"`
+	// Hub and Tag are the hub and tag of the resolved profile.
+	Hub string `json:"hub"`
+	Tag string `json:"tag"`
+	// Created is the creation time of the bundle.
+	Created time.Time `json:"created"`
+}
+
+// Bundle holds the contents of a bundle to be written.
+type Bundle struct {
+	Metadata Metadata
+	// ChartsPath is the local path of the charts root directory. If empty, the compiled-in charts are bundled.
+	ChartsPath string
+	// Profile is the resolved IstioOperator CR YAML.
+	Profile string
+	// Images are the container images referenced by the rendered manifests.
+	Images []string
+	// Versions is the versions map YAML.
+	Versions []byte
+}
+
+// NewBundle returns a bundle of the installation described by iops, which was rendered to manifests. The charts are
+// read from iops.InstallPackagePath, which must be empty or a local path. operatorVersion is the version of the
+// operator binary and versions is the versions map YAML.
+func NewBundle(manifests name.ManifestMap, iops *v1alpha1.IstioOperatorSpec, operatorVersion string,
+	versions []byte) (*Bundle, error) {
+	tree, err := specTree(iops)
+	if err != nil {
+		return nil, err
+	}
+	images, err := Images(manifests, iops)
+	if err != nil {
+		return nil, err
+	}
+	chartsPath, _ := tree["installPackagePath"].(string)
+	delete(tree, "installPackagePath")
+	profile, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": iopAPIVersion,
+		"kind":       iopKind,
+		"spec":       tree,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hub, tag := hubTag(tree)
+	return &Bundle{
+		Metadata: Metadata{
+			OperatorVersion: operatorVersion,
+			Hub:             hub,
+			Tag:             tag,
+			Created:         time.Now().UTC(),
+		},
+		ChartsPath: chartsPath,
+		Profile:    string(profile),
+		Images:     images,
+		Versions:   versions,
+	}, nil
+}
+
+// Write writes a gzipped tar archive of b to w.
+func Write(w io.Writer, b *Bundle) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	md, err := yaml.Marshal(b.Metadata)
+	if err != nil {
+		return err
+	}
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{MetadataFile, md},
+		{ProfileFile, []byte(b.Profile)},
+		{ImagesFile, []byte(strings.Join(b.Images, "\n") + "\n")},
+		{VersionsFile, b.Versions},
+	}
+	for _, f := range files {
+		if err := writeFile(tw, f.name, f.data); err != nil {
+			return err
+		}
+	}
+	if b.ChartsPath == "" {
+		err = writeVFSCharts(tw)
+	} else {
+		err = writeCharts(tw, b.ChartsPath)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to bundle charts: %s", err)
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// Extract extracts the bundle archive at path into dir and returns its metadata.
+func Extract(path, dir string) (*Metadata, error) {
+	targz := archiver.TarGz{Tar: &archiver.Tar{OverwriteExisting: true}}
+	if err := targz.Unarchive(path, dir); err != nil {
+		return nil, fmt.Errorf("failed to extract bundle %s: %s", path, err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, MetadataFile))
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a bundle: %s", path, err)
+	}
+	md := &Metadata{}
+	if err := yaml.Unmarshal(b, md); err != nil {
+		return nil, fmt.Errorf("could not parse bundle metadata: %s", err)
+	}
+	for _, p := range []string{ChartsDir, ProfileFile} {
+		if _, err := os.Stat(filepath.Join(dir, p)); err != nil {
+			return nil, fmt.Errorf("bundle %s is incomplete: %s", path, err)
+		}
+	}
+	return md, nil
+}
+
+func writeCharts(tw *tar.Writer, root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return writeFile(tw, filepath.ToSlash(filepath.Join(ChartsDir, rel)), data)
+	})
+}
+
+func writeVFSCharts(tw *tar.Writer) error {
+	fnames, err := vfs.GetFilesRecursive(vfsChartsRoot)
+	if err != nil {
+		return err
+	}
+	for _, fn := range fnames {
+		data, err := vfs.ReadFile(fn)
+		if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(filepath.ToSlash(fn), vfsChartsRoot+"/")
+		if err := writeFile(tw, ChartsDir+"/"+rel, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
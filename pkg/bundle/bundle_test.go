@@ -0,0 +1,158 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"istio.io/operator/pkg/name"
+)
+
+const testDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: docker.io/istio/proxy_init:1.4.0
+      containers:
+      - name: discovery
+        image: docker.io/istio/pilot:1.4.0
+      - name: istio-proxy
+        image: gcr.io/istio-release/proxyv2:1.4.0
+`
+
+func TestImages(t *testing.T) {
+	manifests := name.ManifestMap{name.PilotComponentName: {testDeployment}}
+	got, err := Images(manifests, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"docker.io/istio/pilot:1.4.0",
+		"docker.io/istio/proxy_init:1.4.0",
+		"gcr.io/istio-release/proxyv2:1.4.0",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	rewritten, err := RewriteImages(manifests, "registry.example.com/mirror/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = Images(rewritten, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{
+		"registry.example.com/mirror/pilot:1.4.0",
+		"registry.example.com/mirror/proxy_init:1.4.0",
+		"registry.example.com/mirror/proxyv2:1.4.0",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got rewritten %v, want %v", got, want)
+	}
+}
+
+func TestRewriteImage(t *testing.T) {
+	tests := []struct {
+		image string
+		hub   string
+		want  string
+	}{
+		{"docker.io/istio/pilot:1.4.0", "registry.local", "registry.local/pilot:1.4.0"},
+		{"pilot:1.4.0", "registry.local/istio", "registry.local/istio/pilot:1.4.0"},
+		{"localhost:5000/istio/pilot@sha256:abcd", "registry.local", "registry.local/pilot@sha256:abcd"},
+		{"docker.io/istio/pilot:1.4.0", "", "docker.io/istio/pilot:1.4.0"},
+	}
+	for _, tt := range tests {
+		if got := RewriteImage(tt.image, tt.hub); got != tt.want {
+			t.Errorf("RewriteImage(%s, %s): got %s, want %s", tt.image, tt.hub, got, tt.want)
+		}
+	}
+}
+
+func TestWriteExtract(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "bundle-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	chartsPath := filepath.Join(tmp, "src")
+	chartFile := filepath.Join("istio-control", "istio-discovery", "Chart.yaml")
+	if err := os.MkdirAll(filepath.Dir(filepath.Join(chartsPath, chartFile)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(chartsPath, chartFile), []byte("name: istio-discovery\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewBundle(name.ManifestMap{name.PilotComponentName: {testDeployment}}, nil, "1.4.0", []byte("versions"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ChartsPath = chartsPath
+	b.Metadata.Hub, b.Metadata.Tag = "docker.io/istio", "1.4.0"
+
+	archive := filepath.Join(tmp, "bundle.tar.gz")
+	f, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Write(f, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	outDir := filepath.Join(tmp, "out")
+	md, err := Extract(archive, outDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md.OperatorVersion != "1.4.0" || md.Hub != "docker.io/istio" || md.Tag != "1.4.0" {
+		t.Errorf("got metadata %+v", md)
+	}
+	for p, want := range map[string]string{
+		filepath.Join(ChartsDir, chartFile): "name: istio-discovery\n",
+		VersionsFile:                        "versions",
+		ImagesFile:                          strings.Join(b.Images, "\n") + "\n",
+		ProfileFile:                         b.Profile,
+	} {
+		got, err := ioutil.ReadFile(filepath.Join(outDir, p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("%s: got %q, want %q", p, got, want)
+		}
+	}
+
+	if _, err := Extract(filepath.Join(tmp, "missing.tar.gz"), filepath.Join(tmp, "missing")); err == nil {
+		t.Error("got no error extracting a missing bundle")
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+)
+
+// containerListKeys are the keys of pod spec fields holding containers.
+var containerListKeys = []string{"containers", "initContainers"}
+
+// Images returns the sorted, unique container images referenced by the pod templates in manifests and the proxy
+// images injected into workloads, which are configured by iops.
+func Images(manifests name.ManifestMap, iops *v1alpha1.IstioOperatorSpec) ([]string, error) {
+	images := make(map[string]bool)
+	for _, ms := range manifests {
+		for _, m := range ms {
+			objs, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range objs {
+				walkImages(o.UnstructuredObject().Object, func(image string) string {
+					images[image] = true
+					return image
+				})
+			}
+		}
+	}
+	proxies, err := proxyImages(iops)
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range proxies {
+		images[image] = true
+	}
+	var out []string
+	for image := range images {
+		out = append(out, image)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// RewriteImages returns manifests with the registry and repository path of every container image replaced by hub,
+// e.g. docker.io/istio/pilot:1.4.0 becomes <hub>/pilot:1.4.0.
+func RewriteImages(manifests name.ManifestMap, hub string) (name.ManifestMap, error) {
+	out := make(name.ManifestMap, len(manifests))
+	for c, ms := range manifests {
+		for _, m := range ms {
+			objs, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				return nil, err
+			}
+			var rewritten object.K8sObjects
+			for _, o := range objs {
+				u := o.UnstructuredObject().DeepCopy()
+				walkImages(u.Object, func(image string) string {
+					return RewriteImage(image, hub)
+				})
+				rewritten = append(rewritten, object.NewK8sObject(u, nil, nil))
+			}
+			ym, err := rewritten.YAMLManifest()
+			if err != nil {
+				return nil, err
+			}
+			out[c] = append(out[c], ym)
+		}
+	}
+	return out, nil
+}
+
+// RewriteImage returns image in hub, keeping only the last path component of its repository.
+func RewriteImage(image, hub string) string {
+	if hub == "" {
+		return image
+	}
+	return strings.TrimSuffix(hub, "/") + "/" + image[strings.LastIndex(image, "/")+1:]
+}
+
+// walkImages calls f for the image of every container found under node, replacing the image with the result.
+func walkImages(node interface{}, f func(string) string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for _, k := range containerListKeys {
+			containers, ok := n[k].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, c := range containers {
+				cm, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if image, ok := cm["image"].(string); ok && image != "" {
+					cm["image"] = f(image)
+				}
+			}
+		}
+		for _, v := range n {
+			walkImages(v, f)
+		}
+	case []interface{}:
+		for _, v := range n {
+			walkImages(v, f)
+		}
+	}
+}
+
+// proxyImages returns the sidecar and init container images injected into workloads, which are not part of the
+// rendered pod templates.
+func proxyImages(iops *v1alpha1.IstioOperatorSpec) ([]string, error) {
+	tree, err := specTree(iops)
+	if err != nil {
+		return nil, err
+	}
+	hub, tag := hubTag(tree)
+	global, _, _ := unstructured.NestedMap(tree, "values", "global")
+	var out []string
+	for _, k := range []string{"proxy", "proxy_init"} {
+		image, _, _ := unstructured.NestedString(global, k, "image")
+		switch {
+		case image == "":
+		case strings.Contains(image, "/"):
+			out = append(out, image)
+		case hub != "" && tag != "":
+			out = append(out, hub+"/"+image+":"+tag)
+		}
+	}
+	return out, nil
+}
+
+// specTree returns iops as a tree.
+func specTree(iops *v1alpha1.IstioOperatorSpec) (map[string]interface{}, error) {
+	tree := make(map[string]interface{})
+	if iops == nil {
+		return tree, nil
+	}
+	if err := yaml.Unmarshal([]byte(util.ToYAMLWithJSONPB(iops)), &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// hubTag returns the hub and tag of the IstioOperatorSpec tree, falling back to the global values.
+func hubTag(tree map[string]interface{}) (string, string) {
+	hub, tag := tree["hub"], tree["tag"]
+	global, _, _ := unstructured.NestedMap(tree, "values", "global")
+	if hub == nil {
+		hub = global["hub"]
+	}
+	if tag == nil {
+		tag = global["tag"]
+	}
+	if hub == nil || tag == nil {
+		return "", ""
+	}
+	return fmt.Sprint(hub), fmt.Sprint(tag)
+}
@@ -225,42 +225,60 @@ func ManifestDiff(a, b string, verbose bool) (string, error) {
 	}
 
 	aom, bom := ao.ToMap(), bo.ToMap()
-	return manifestDiff(aom, bom, nil, verbose)
+	diff, _, err := manifestDiff(aom, bom, nil, verbose)
+	return diff, err
+}
+
+// ManifestDiffSummary lists the objects which differ between two manifests A and B.
+type ManifestDiffSummary struct {
+	// OnlyInA and OnlyInB are the objects missing in the other manifest.
+	OnlyInA []string
+	OnlyInB []string
+	// Changed are the objects in both manifests which have diffs.
+	Changed []string
 }
 
 // ManifestDiffWithSelect checks the manifest differences with selected and ignored resources.
 // The selected filter will apply before the ignored filter.
 func ManifestDiffWithRenameSelectIgnore(a, b, renameResources, selectResources, ignoreResources string, verbose bool) (string, error) {
+	diff, _, err := ManifestDiffWithSummary(a, b, renameResources, selectResources, ignoreResources, verbose)
+	return diff, err
+}
+
+// ManifestDiffWithSummary is ManifestDiffWithRenameSelectIgnore, which also returns a summary of the objects which
+// differ.
+func ManifestDiffWithSummary(a, b, renameResources, selectResources, ignoreResources string,
+	verbose bool) (string, *ManifestDiffSummary, error) {
 	rnm := getKeyValueMap(renameResources)
 	sm := getObjPathMap(selectResources)
 	im := getObjPathMap(ignoreResources)
 
 	ao, err := object.ParseK8sObjectsFromYAMLManifest(a)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	aom := ao.ToMap()
 
 	bo, err := object.ParseK8sObjectsFromYAMLManifest(b)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	bom := bo.ToMap()
 
 	if len(rnm) != 0 {
 		aom, err = renameResource(aom, rnm)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 	}
 
 	aosm, err := filterResourceWithSelectAndIgnore(aom, sm, im)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	bosm, err := filterResourceWithSelectAndIgnore(bom, sm, im)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	return manifestDiff(aosm, bosm, im, verbose)
@@ -349,22 +367,24 @@ func buildResourceRegexp(s string) (*regexp.Regexp, error) {
 }
 
 // manifestDiff an internal function to compare the manifests difference specified in the input.
-func manifestDiff(aom, bom map[string]*object.K8sObject, im map[string]string, verbose bool) (string, error) {
+func manifestDiff(aom, bom map[string]*object.K8sObject, im map[string]string, verbose bool) (string, *ManifestDiffSummary, error) {
 	var sb strings.Builder
+	summary := &ManifestDiffSummary{}
 	out := make(map[string]string)
 	for ak, av := range aom {
 		ay, err := av.YAML()
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		bo := bom[ak]
 		if bo == nil {
 			out[ak] = fmt.Sprintf("\n\nObject %s is missing in B:\n\n", ak)
+			summary.OnlyInA = append(summary.OnlyInA, ak)
 			continue
 		}
 		by, err := bo.YAML()
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 
 		var diff string
@@ -377,15 +397,20 @@ func manifestDiff(aom, bom map[string]*object.K8sObject, im map[string]string, v
 
 		if diff != "" {
 			out[ak] = fmt.Sprintf("\n\nObject %s has diffs:\n\n%s", ak, diff)
+			summary.Changed = append(summary.Changed, ak)
 		}
 	}
 	for bk := range bom {
 		ao := aom[bk]
 		if ao == nil {
 			out[bk] = fmt.Sprintf("\n\nObject %s is missing in A:\n\n", bk)
+			summary.OnlyInB = append(summary.OnlyInB, bk)
 			continue
 		}
 	}
+	sort.Strings(summary.OnlyInA)
+	sort.Strings(summary.OnlyInB)
+	sort.Strings(summary.Changed)
 
 	keys := make([]string, 0, len(out))
 	for k := range out {
@@ -397,7 +422,7 @@ func manifestDiff(aom, bom map[string]*object.K8sObject, im map[string]string, v
 		writeStringSafe(&sb, out[keys[i]])
 	}
 
-	return sb.String(), nil
+	return sb.String(), summary, nil
 }
 
 func getObjPathMap(rs string) map[string]string {
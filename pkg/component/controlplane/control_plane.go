@@ -20,6 +20,7 @@ import (
 	"istio.io/api/operator/v1alpha1"
 	"istio.io/operator/pkg/component/component"
 	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/revision"
 	"istio.io/operator/pkg/translate"
 	"istio.io/operator/pkg/util"
 )
@@ -28,12 +29,19 @@ import (
 type IstioOperator struct {
 	// components is a slice of components that are part of the feature.
 	components []component.IstioComponent
-	started    bool
+	// revision is the control plane revision, empty for the default revision.
+	revision string
+	started  bool
 }
 
 // NewIstioOperator creates a new IstioOperator and returns a pointer to it.
 func NewIstioOperator(installSpec *v1alpha1.IstioOperatorSpec, translator *translate.Translator) (*IstioOperator, error) {
-	out := &IstioOperator{}
+	out := &IstioOperator{
+		revision: revision.FromSpec(installSpec),
+	}
+	if err := revision.Validate(out.revision); err != nil {
+		return nil, err
+	}
 	opts := &component.Options{
 		InstallSpec: installSpec,
 		Translator:  translator,
@@ -113,5 +121,9 @@ func (i *IstioOperator) RenderManifest() (manifests name.ManifestMap, errsOut ut
 	if len(errsOut) > 0 {
 		return nil, errsOut
 	}
+	manifests, err := revision.Apply(manifests, i.revision)
+	if err != nil {
+		return nil, util.NewErrs(err)
+	}
 	return
 }
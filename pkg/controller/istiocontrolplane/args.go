@@ -15,7 +15,12 @@
 package istiocontrolplane
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/helm"
 )
 
 // Options represents the details used to configure the controller.
@@ -26,13 +31,37 @@ type Options struct {
 	// DefaultChartPath is the relative path used added to BaseChartPath when no value is specified in
 	// IstioOperator.Spec.ChartPath
 	DefaultChartPath string
+	// ReadinessTimeout is how long the resources of a component may take to become ready before the components
+	// depending on it are processed, unless overridden by the ReadinessTimeoutKey annotations of the IstioOperator.
+	ReadinessTimeout time.Duration
+	// ChartPollInterval is how often the installation packages of IstioOperators with an installation package URL are
+	// checked for updates.
+	ChartPollInterval time.Duration
+	// MaxConcurrentReconciles is the number of IstioOperator resources which are reconciled concurrently.
+	MaxConcurrentReconciles int
+	// MaxPrune is the maximum number of resources pruned in one reconcile of an IstioOperator, unless overridden by
+	// its MaxPruneKey annotation. If more resources would be pruned, nothing is. 0 means no limit.
+	MaxPrune int
+	// VerificationKeysPath is the path to a file or directory of PEM encoded public keys trusted to sign the
+	// installation packages fetched from URLs, OCI registries and Helm repositories. Signatures are only verified if
+	// it is set.
+	VerificationKeysPath string
+	// InsecureSkipVerification allows installation packages which are unsigned, or not signed by a trusted key.
+	InsecureSkipVerification bool
+	// ChartCacheDir is the directory where charts fetched from OCI registries and Helm repositories are cached.
+	ChartCacheDir string
 }
 
 // ControllerOptions represents the options used by the controller
 var controllerOptions = &Options{
 	// XXX: update this once we add charts to the operator
-	BaseChartPath:    "/etc/istio-operator/helm",
-	DefaultChartPath: "istio",
+	BaseChartPath:           "/etc/istio-operator/helm",
+	DefaultChartPath:        "istio",
+	ReadinessTimeout:        5 * time.Minute,
+	ChartPollInterval:       5 * time.Minute,
+	MaxConcurrentReconciles: 3,
+	MaxPrune:                100,
+	ChartCacheDir:           helm.ChartCacheDir,
 }
 
 // AttachCobraFlags attaches a set of Cobra flags to the given Cobra command.
@@ -40,9 +69,40 @@ var controllerOptions = &Options{
 // Cobra is the command-line processor that Istio uses. This command attaches
 // the set of flags used to configure the IstioOperator reconciler
 func AttachCobraFlags(cmd *cobra.Command) {
-	cmd.PersistentFlags().StringVar(&controllerOptions.BaseChartPath, "base-chart-path", "",
+	cmd.PersistentFlags().StringVar(&controllerOptions.BaseChartPath, "base-chart-path", controllerOptions.BaseChartPath,
 		"The absolute path to a directory containing nested charts, e.g. /etc/istio-operator/helm.  "+
-			"This will be used as the base path for any IstioOperator instances specifying a relative ChartPath.")
-	cmd.PersistentFlags().StringVar(&controllerOptions.BaseChartPath, "default-chart-path", "",
+			"This will be used as the base path for any IstioOperator instances specifying a relative ChartPath. "+
+			"If it exists, IstioOperator instances without an installPackagePath are rendered from it, and "+
+			"reconciled when it changes.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.DefaultChartPath, "default-chart-path", controllerOptions.DefaultChartPath,
 		"A path relative to base-chart-path containing charts to be used when no ChartPath is specified by an IstioOperator resource, e.g. 1.1.0/istio")
+	cmd.PersistentFlags().DurationVar(&controllerOptions.ReadinessTimeout, "readiness-timeout", controllerOptions.ReadinessTimeout,
+		"How long the resources of a component may take to become ready before the components depending on it are "+
+			"processed. 0 disables the readiness gates.")
+	cmd.PersistentFlags().DurationVar(&controllerOptions.ChartPollInterval, "chart-poll-interval", controllerOptions.ChartPollInterval,
+		"How often installation package URLs of IstioOperator instances are checked for updates.")
+	cmd.PersistentFlags().IntVar(&controllerOptions.MaxConcurrentReconciles, "max-concurrent-reconciles",
+		controllerOptions.MaxConcurrentReconciles, "The number of IstioOperator resources which are reconciled concurrently.")
+	cmd.PersistentFlags().IntVar(&controllerOptions.MaxPrune, "max-prune", controllerOptions.MaxPrune,
+		"The maximum number of resources pruned in one reconcile of an IstioOperator. If more resources would be "+
+			"pruned, nothing is and the resources are listed in its status. 0 means no limit.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.VerificationKeysPath, "verification-keys",
+		controllerOptions.VerificationKeysPath, "Path to a file or directory of PEM encoded public keys used to verify "+
+			"the signatures of installation packages fetched from URLs, OCI registries and Helm repositories. If set, "+
+			"unsigned packages and packages not signed by one of the keys are refused.")
+	cmd.PersistentFlags().BoolVar(&controllerOptions.InsecureSkipVerification, "insecure-skip-verification",
+		controllerOptions.InsecureSkipVerification, "Use fetched installation packages without verifying their "+
+			"signatures, even if --verification-keys is set.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.ChartCacheDir, "chart-cache-dir", controllerOptions.ChartCacheDir,
+		"Directory where charts fetched from OCI registries and Helm repositories are cached. It is created with "+
+			"mode 0700 and must not be accessible by other users.")
+}
+
+// signatureVerifier returns the verifier of installation package signatures configured by the controller options.
+func signatureVerifier() (*helm.SignatureVerifier, error) {
+	v, err := helm.NewSignatureVerifier(controllerOptions.VerificationKeysPath, controllerOptions.InsecureSkipVerification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load package verification keys: %s", err)
+	}
+	return v, nil
 }
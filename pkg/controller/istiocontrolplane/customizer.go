@@ -16,14 +16,26 @@ package istiocontrolplane
 
 import (
 	"fmt"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	"istio.io/operator/pkg/apis/istio/v1alpha1"
 	"istio.io/operator/pkg/helmreconciler"
 )
 
-type IstioRenderingCustomizerFactory struct{}
+// IstioRenderingCustomizerFactory creates RenderingCustomizers for IstioOperator resources.
+type IstioRenderingCustomizerFactory struct {
+	// EventRecorder records Kubernetes Events on the IstioOperator resource. No events are recorded if it is nil.
+	EventRecorder record.EventRecorder
+
+	// eventLimitersMu guards eventLimiters.
+	eventLimitersMu sync.Mutex
+	// eventLimiters holds the event rate limiter of each IstioOperator, shared by all its reconciliations.
+	eventLimiters map[types.NamespacedName]*helmreconciler.EventLimiter
+}
 
 var _ helmreconciler.RenderingCustomizerFactory
 
@@ -34,9 +46,30 @@ func (f *IstioRenderingCustomizerFactory) NewCustomizer(instance runtime.Object)
 		return &helmreconciler.SimpleRenderingCustomizer{
 			InputValue:          NewIstioRenderingInput(v),
 			PruningDetailsValue: NewIstioPruningDetails(v),
-			ListenerValue:       NewIstioRenderingListener(v),
+			ListenerValue:       NewIstioRenderingListener(v, f.EventRecorder, f.eventLimiter(v)),
 		}, nil
 	default:
 		return nil, fmt.Errorf("object is not an IstioOperator resource")
 	}
 }
+
+// eventLimiter returns the event rate limiter of instance, creating it if needed.
+func (f *IstioRenderingCustomizerFactory) eventLimiter(instance *v1alpha1.IstioOperator) *helmreconciler.EventLimiter {
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+	f.eventLimitersMu.Lock()
+	defer f.eventLimitersMu.Unlock()
+	if f.eventLimiters == nil {
+		f.eventLimiters = make(map[types.NamespacedName]*helmreconciler.EventLimiter)
+	}
+	if f.eventLimiters[key] == nil {
+		f.eventLimiters[key] = helmreconciler.NewEventLimiter()
+	}
+	return f.eventLimiters[key]
+}
+
+// forget drops the event rate limiter of the IstioOperator key, once it no longer exists.
+func (f *IstioRenderingCustomizerFactory) forget(key types.NamespacedName) {
+	f.eventLimitersMu.Lock()
+	defer f.eventLimitersMu.Unlock()
+	delete(f.eventLimiters, key)
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helmreconciler"
+)
+
+func TestEventLimitSharedAcrossReconcilers(t *testing.T) {
+	recorder := record.NewFakeRecorder(100)
+	f := &IstioRenderingCustomizerFactory{EventRecorder: recorder}
+	instance := &iop.IstioOperator{}
+	instance.Name, instance.Namespace = "test", "istio-system"
+	other := &iop.IstioOperator{}
+	other.Name, other.Namespace = "test", "other"
+
+	// The controller creates a new reconciler, and so new listeners, for every reconciliation.
+	for i := 0; i < 2; i++ {
+		customizer, err := f.NewCustomizer(instance)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := 0; j < helmreconciler.EventBurst; j++ {
+			if err := customizer.Listener().BeginReconcile(instance); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if got := len(recorder.Events); got != helmreconciler.EventBurst {
+		t.Errorf("got %d events for the same IstioOperator, want %d", got, helmreconciler.EventBurst)
+	}
+
+	// Other IstioOperators have their own limit.
+	customizer, err := f.NewCustomizer(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := customizer.Listener().BeginReconcile(other); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(recorder.Events); got != helmreconciler.EventBurst+1 {
+		t.Errorf("got %d events, want an event for another IstioOperator", got-helmreconciler.EventBurst)
+	}
+
+	f.forget(types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name})
+	if _, ok := f.eventLimiters[types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}]; ok {
+		t.Error("got event limiter of a deleted IstioOperator, want it dropped")
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helmreconciler"
+	"istio.io/pkg/log"
+)
+
+const (
+	// SpecHashKey is the annotation holding the hash of the rendered resource, used to detect drift.
+	SpecHashKey = MetadataNamespace + "/spec-hash"
+	// DriftPolicyKey is the IstioOperator annotation selecting what to do when owned resources drift from their
+	// rendered configuration. Valid values are DriftPolicyAlert and DriftPolicyHeal.
+	DriftPolicyKey = MetadataNamespace + "/drift-policy"
+	// DriftPolicyAlert only records a Warning Event on the IstioOperator and counts the drift. This is the default.
+	DriftPolicyAlert = "Alert"
+	// DriftPolicyHeal also re-applies the affected components.
+	DriftPolicyHeal = "Heal"
+
+	eventReasonDriftDetected = "DriftDetected"
+)
+
+var (
+	driftDetectedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "istio_operator",
+		Name:      "drift_detected_total",
+		Help:      "Number of changes made to owned resources outside of the operator, by component and kind.",
+	}, []string{"component", "kind"})
+
+	// drifted holds the drifted components of each IstioOperator until they are handled by Reconcile.
+	drifted = newDriftTracker()
+)
+
+func init() {
+	metrics.Registry.MustRegister(driftDetectedTotal)
+}
+
+// driftTracker records drifted components, indexed by IstioOperator.
+type driftTracker struct {
+	mu sync.Mutex
+	// pending maps an IstioOperator to its drifted components and a description of the drift in each.
+	pending map[types.NamespacedName]map[string][]string
+}
+
+func newDriftTracker() *driftTracker {
+	return &driftTracker{pending: make(map[types.NamespacedName]map[string][]string)}
+}
+
+func (t *driftTracker) add(owner types.NamespacedName, component, description string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pending[owner] == nil {
+		t.pending[owner] = make(map[string][]string)
+	}
+	t.pending[owner][component] = append(t.pending[owner][component], description)
+}
+
+// put records the drifted components of owner, as returned by take, again.
+func (t *driftTracker) put(owner types.NamespacedName, drift map[string][]string) {
+	for component, descriptions := range drift {
+		for _, description := range descriptions {
+			t.add(owner, component, description)
+		}
+	}
+}
+
+// take returns and clears the drifted components of owner.
+func (t *driftTracker) take(owner types.NamespacedName) map[string][]string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := t.pending[owner]
+	delete(t.pending, owner)
+	return out
+}
+
+// ownerKey returns the IstioOperator owning obj. The namespace is empty for resources rendered by older operator
+// versions, which do not record it.
+func ownerKey(obj metav1.Object) types.NamespacedName {
+	labels := obj.GetLabels()
+	return types.NamespacedName{Namespace: labels[OwnerNamespaceKey], Name: labels[OwnerNameKey]}
+}
+
+// driftPolicy returns the drift policy of instance.
+func driftPolicy(instance *iop.IstioOperator) string {
+	if instance.GetAnnotations()[DriftPolicyKey] == DriftPolicyHeal {
+		return DriftPolicyHeal
+	}
+	return DriftPolicyAlert
+}
+
+// detectOwnedResourceDrift reports whether obj, a resource owned by an IstioOperator, drifted from its rendered
+// configuration. Drifted resources are recorded for the owning IstioOperator.
+func detectOwnedResourceDrift(obj runtime.Object) bool {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	owner := ownerKey(u)
+	if owner.Name == "" {
+		return false
+	}
+	drift, reason, err := helmreconciler.DetectDrift(u, SpecHashKey)
+	if err != nil {
+		log.Warnf("could not check %s %s/%s for drift: %s", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+		return false
+	}
+	if !drift {
+		return false
+	}
+	component := u.GetAnnotations()[ChartOwnerKey]
+	description := fmt.Sprintf("%s %s/%s: %s", u.GetKind(), u.GetNamespace(), u.GetName(), reason)
+	log.Infof("detected drift in component %s of %s: %s", component, owner, description)
+	driftDetectedTotal.WithLabelValues(component, u.GetKind()).Inc()
+	drifted.add(owner, component, description)
+	return true
+}
+
+// reconcileDrift handles drift in the given components of instance according to its drift policy. Drift is only
+// healed if the maintenance window of instance is open.
+func (r *ReconcileIstioOperator) reconcileDrift(instance *iop.IstioOperator, drift map[string][]string, open bool) error {
+	var components []string
+	for c := range drift {
+		components = append(components, c)
+	}
+	sort.Strings(components)
+	policy := driftPolicy(instance)
+	for _, c := range components {
+		msg := fmt.Sprintf("Component %s changed outside of the operator: %s", c, strings.Join(drift[c], "; "))
+		switch {
+		case policy == DriftPolicyHeal && open:
+			msg += ", reverting"
+		case policy == DriftPolicyHeal:
+			msg += ", reverting when the maintenance window opens"
+		}
+		if r.recorder != nil {
+			r.recorder.Event(instance, corev1.EventTypeWarning, eventReasonDriftDetected, msg)
+		}
+	}
+	if policy != DriftPolicyHeal {
+		log.Infof("drift policy of %s/%s is %s, not reverting components %s", instance.Namespace, instance.Name,
+			policy, strings.Join(components, ","))
+		return nil
+	}
+	if !open {
+		log.Infof("maintenance window of %s/%s is closed, not reverting components %s yet", instance.Namespace,
+			instance.Name, strings.Join(components, ","))
+		return nil
+	}
+
+	iopMerged, _, err := r.mergeWithProfile(instance)
+	if err != nil {
+		return err
+	}
+	reconciler, err := r.getOrCreateReconciler(iopMerged)
+	if err != nil {
+		return fmt.Errorf("failed to create reconciler: %s", err)
+	}
+	log.Infof("reverting drift in components %s", strings.Join(components, ","))
+	return reconciler.ReconcileComponents(components)
+}
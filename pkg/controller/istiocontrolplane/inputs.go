@@ -15,42 +15,23 @@
 package istiocontrolplane
 
 import (
+	"time"
+
 	"istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/dag"
 	"istio.io/operator/pkg/helmreconciler"
-	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/translate"
+	binversion "istio.io/operator/version"
+	"istio.io/pkg/log"
 )
 
-var (
-	componentDependencies = helmreconciler.ComponentNameToListMap{
-		name.IstioBaseComponentName: {
-			name.PilotComponentName,
-			name.PolicyComponentName,
-			name.TelemetryComponentName,
-			name.GalleyComponentName,
-			name.CitadelComponentName,
-			name.NodeAgentComponentName,
-			name.CertManagerComponentName,
-			name.SidecarInjectorComponentName,
-			name.IngressComponentName,
-			name.EgressComponentName,
-			name.CNIComponentName,
-		},
-	}
-
-	installTree      = make(helmreconciler.ComponentTree)
-	dependencyWaitCh = make(helmreconciler.DependencyWaitCh)
+const (
+	// ReadinessTimeoutKey is the IstioOperator annotation overriding the readiness timeout of all components, e.g.
+	// "10m". The timeout of a single component is set with the annotation ReadinessTimeoutKey followed by "." and the
+	// component name, e.g. install.operator.istio.io/readiness-timeout.Pilot. A timeout of "0" disables the gate.
+	ReadinessTimeoutKey = MetadataNamespace + "/readiness-timeout"
 )
 
-func init() {
-	buildInstallTree()
-	for _, parent := range componentDependencies {
-		for _, child := range parent {
-			dependencyWaitCh[child] = make(chan struct{}, 1)
-		}
-	}
-
-}
-
 // IstioRenderingInput is a RenderingInput specific to an v1alpha1 IstioOperator instance.
 type IstioRenderingInput struct {
 	instance *v1alpha1.IstioOperator
@@ -78,25 +59,30 @@ func (i *IstioRenderingInput) GetTargetNamespace() string {
 	return i.instance.Spec.MeshConfig.RootNamespace
 }
 
-// GetProcessingOrder returns the order in which the rendered charts should be processed.
-func (i *IstioRenderingInput) GetProcessingOrder(m helmreconciler.ChartManifestsMap) (helmreconciler.ComponentNameToListMap, helmreconciler.DependencyWaitCh) {
-	componentNameList := make([]name.ComponentName, 0)
-	dependencyWaitCh := make(helmreconciler.DependencyWaitCh)
-	for c := range m {
-		cn := name.ComponentName(c)
-		if cn == name.IstioBaseComponentName {
-			continue
-		}
-		componentNameList = append(componentNameList, cn)
-		dependencyWaitCh[cn] = make(chan struct{}, 1)
+// GetProcessingOrder returns the component dependencies declared in the translateConfig of the operator version.
+func (i *IstioRenderingInput) GetProcessingOrder(_ helmreconciler.ChartManifestsMap) (dag.Graph, error) {
+	t, err := translate.NewTranslator(binversion.OperatorBinaryVersion.MinorVersion)
+	if err != nil {
+		return nil, err
 	}
-	componentDependencies := helmreconciler.ComponentNameToListMap{
-		name.IstioBaseComponentName: componentNameList,
-	}
-	return componentDependencies, dependencyWaitCh
+	return t.ComponentDependencies, nil
 }
 
-func buildInstallTree() {
-	// Starting with root, recursively insert each first level child into each node.
-	helmreconciler.InsertChildrenRecursive(name.IstioBaseComponentName, installTree, componentDependencies)
+// GetReadinessTimeout returns the readiness timeout of component set in the annotations of the instance, or the
+// --readiness-timeout default.
+func (i *IstioRenderingInput) GetReadinessTimeout(component string) time.Duration {
+	annotations := i.instance.GetAnnotations()
+	for _, key := range []string{ReadinessTimeoutKey + "." + component, ReadinessTimeoutKey} {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			log.Warnf("ignoring invalid readiness timeout %s=%q", key, value)
+			continue
+		}
+		return timeout
+	}
+	return controllerOptions.ReadinessTimeout
 }
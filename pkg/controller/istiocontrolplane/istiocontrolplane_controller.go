@@ -17,6 +17,8 @@ package istiocontrolplane
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -26,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -36,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helm"
 	"istio.io/operator/pkg/helmreconciler"
 	"istio.io/pkg/log"
 )
@@ -44,6 +48,8 @@ const (
 	finalizer = "istio-finalizer.install.istio.io"
 	// finalizerMaxRetries defines the maximum number of attempts to add finalizers.
 	finalizerMaxRetries = 10
+	// eventRecorderName is the source of the Events recorded on IstioOperator resources.
+	eventRecorderName = "istio-operator"
 )
 
 /**
@@ -54,20 +60,33 @@ const (
 // Add creates a new IstioOperator Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
-	return add(mgr, newReconciler(mgr))
+	verifier, err := signatureVerifier()
+	if err != nil {
+		return err
+	}
+	helm.SetChartVerifier(verifier)
+	helm.ChartCacheDir = controllerOptions.ChartCacheDir
+	return add(mgr, newReconciler(mgr, verifier))
 }
 
-// newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	factory := &helmreconciler.Factory{CustomizerFactory: &IstioRenderingCustomizerFactory{}}
-	return &ReconcileIstioOperator{client: mgr.GetClient(), scheme: mgr.GetScheme(), factory: factory}
+// newReconciler returns a new reconcile.Reconciler, which verifies installation packages with verifier.
+func newReconciler(mgr manager.Manager, verifier *helm.SignatureVerifier) reconcile.Reconciler {
+	recorder := mgr.GetEventRecorderFor(eventRecorderName)
+	factory := &helmreconciler.Factory{CustomizerFactory: &IstioRenderingCustomizerFactory{EventRecorder: recorder}}
+	r := &ReconcileIstioOperator{client: mgr.GetClient(), scheme: mgr.GetScheme(), factory: factory, recorder: recorder,
+		reloader: newChartReloader(verifier)}
+	r.reloader.invalidate = r.invalidateReconciler
+	return r
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	log.Info("Adding controller for IstioOperator")
 	// Create a new controller
-	c, err := controller.New("istiocontrolplane-controller", mgr, controller.Options{Reconciler: r})
+	c, err := controller.New("istiocontrolplane-controller", mgr, controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: controllerOptions.MaxConcurrentReconciles,
+	})
 	if err != nil {
 		return err
 	}
@@ -82,19 +101,37 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	if err != nil {
 		return err
 	}
+	// Watch for changes to the charts of IstioOperators
+	if rio, ok := r.(*ReconcileIstioOperator); ok && rio.reloader != nil {
+		err = c.Watch(&source.Channel{Source: rio.reloader.events}, &handler.EnqueueRequestForObject{})
+		if err != nil {
+			return err
+		}
+	}
 	log.Info("Controller added")
 	return nil
 }
 
 var _ reconcile.Reconciler = &ReconcileIstioOperator{}
 
-// ReconcileIstioOperator reconciles a IstioOperator object
+// ReconcileIstioOperator reconciles a IstioOperator object. IstioOperator objects are reconciled independently and
+// concurrently, the controller making sure that the same object is not reconciled by two workers at once.
 type ReconcileIstioOperator struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client  client.Client
 	scheme  *runtime.Scheme
 	factory *helmreconciler.Factory
+	// recorder records Events on IstioOperator resources. It may be nil.
+	recorder record.EventRecorder
+	// reloader reconciles IstioOperators when their charts change. It may be nil, in which case the charts are
+	// rendered from the installation package path as is.
+	reloader *chartReloader
+
+	// reconcilersMu guards reconcilers.
+	reconcilersMu sync.Mutex
+	// reconcilers caches the HelmReconciler of each IstioOperator.
+	reconcilers map[types.NamespacedName]*helmreconciler.HelmReconciler
 }
 
 // Reconcile reads that state of the cluster for a IstioOperator object and makes changes based on the state read
@@ -105,20 +142,23 @@ type ReconcileIstioOperator struct {
 func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	log.Info("Reconciling IstioOperator")
 
-	ns := request.Namespace
-	if ns == "" {
-		ns = defaultNs
-	} else {
-		defaultNs = ns
-	}
-	reqNamespacedName := types.NamespacedName{
-		Name:      request.Name,
-		Namespace: ns,
+	reqNamespacedName := request.NamespacedName
+	if reqNamespacedName.Namespace == "" {
+		// Requests for resources rendered by older operator versions, which do not record the owner namespace.
+		ns, err := r.ownerNamespace(request.Name)
+		if err != nil || ns == "" {
+			return reconcile.Result{}, err
+		}
+		reqNamespacedName.Namespace = ns
 	}
 	// declare read-only iop instance to create the reconciler
 	iop := &iop.IstioOperator{}
 	if err := r.client.Get(context.TODO(), reqNamespacedName, iop); err != nil {
 		if errors.IsNotFound(err) {
+			r.unsubscribeCharts(reqNamespacedName)
+			if f, ok := r.factory.CustomizerFactory.(*IstioRenderingCustomizerFactory); ok {
+				f.forget(reqNamespacedName)
+			}
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
@@ -129,9 +169,16 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 		return reconcile.Result{}, err
 	}
 
+	// A paused IstioOperator is left as is, even if it is deleted, until it is resumed by removing the annotation.
+	if isPaused(iop) {
+		log.Infof("IstioOperator %s is paused", reqNamespacedName)
+		return reconcile.Result{}, r.updateConditions(iop, pausedCondition(iop))
+	}
+
 	deleted := iop.GetDeletionTimestamp() != nil
 	finalizers := sets.NewString(iop.GetFinalizers()...)
 	if deleted {
+		r.unsubscribeCharts(reqNamespacedName)
 		if !finalizers.Has(finalizer) {
 			log.Info("IstioOperator deleted")
 			return reconcile.Result{}, nil
@@ -152,7 +199,7 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 			// workaround for https://github.com/kubernetes/kubernetes/issues/73098 for k8s < 1.14
 			// TODO: make this error message more meaningful.
 			log.Info("conflict during finalizer removal, retrying")
-			_ = r.client.Get(context.TODO(), request.NamespacedName, iop)
+			_ = r.client.Get(context.TODO(), reqNamespacedName, iop)
 			finalizers = sets.NewString(iop.GetFinalizers()...)
 			finalizers.Delete(finalizer)
 			iop.SetFinalizers(finalizers.List())
@@ -174,14 +221,51 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 		}
 	}
 
+	// Outside of the maintenance window, changes are not applied until the window opens.
+	now := time.Now()
+	window, windowErr := maintenanceWindow(iop)
+	open := windowErr == nil && (window == nil || window.Contains(now))
+	var next time.Time
+	var result reconcile.Result
+	if windowErr != nil {
+		log.Errorf("invalid maintenance window of IstioOperator %s: %s", reqNamespacedName, windowErr)
+	} else if !open {
+		next = window.Next(now)
+		if !next.IsZero() {
+			result.RequeueAfter = next.Sub(now)
+		}
+	}
+
+	// Changes to owned resources only require the drifted components to be handled, unless the spec changed too.
+	if drift := drifted.take(request.NamespacedName); len(drift) != 0 && !hasPendingChanges(iop) {
+		err := r.reconcileDrift(iop, drift, open)
+		if err != nil {
+			log.Errorf("reconciling drift err: %s", err)
+		}
+		if !open {
+			// Healed when the window opens.
+			drifted.put(request.NamespacedName, drift)
+		}
+		return result, err
+	}
+
+	if !open {
+		log.Infof("IstioOperator %s is outside of its maintenance window", reqNamespacedName)
+		return result, r.updateConditions(iop, pausedCondition(iop), pendingCondition(iop, open, next, windowErr))
+	}
+
 	log.Info("Updating IstioOperator")
-	var err error
-	iopMerged := *iop
-	iopMerged.Spec, err = helmreconciler.MergeIOPSWithProfile(iop.Spec)
+	iopMerged, chartSource, err := r.mergeWithProfile(iop)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	reconciler, err := r.getOrCreateReconciler(&iopMerged)
+	if r.reloader != nil {
+		if !autoReloadEnabled(iop) {
+			chartSource = ""
+		}
+		r.reloader.subscribe(reqNamespacedName, chartSource)
+	}
+	reconciler, err := r.getOrCreateReconciler(iopMerged)
 	if err == nil {
 		err = reconciler.Reconcile()
 		if err != nil {
@@ -194,13 +278,59 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 	return reconcile.Result{}, err
 }
 
-var (
-	defaultNs   string
-	reconcilers = map[string]*helmreconciler.HelmReconciler{}
-)
+// ownerNamespace returns the namespace of the IstioOperator named name, or "" if there is none or several.
+func (r *ReconcileIstioOperator) ownerNamespace(name string) (string, error) {
+	list := &iop.IstioOperatorList{}
+	if err := r.client.List(context.TODO(), list); err != nil {
+		log.Errorf("error listing IstioOperators: %s", err)
+		return "", err
+	}
+	ns := ""
+	for _, item := range list.Items {
+		if item.Name != name {
+			continue
+		}
+		if ns != "" {
+			log.Warnf("several IstioOperators are named %s, ignoring the request without a namespace", name)
+			return "", nil
+		}
+		ns = item.Namespace
+	}
+	return ns, nil
+}
 
-func reconcilersMapKey(iop *iop.IstioOperator) string {
-	return fmt.Sprintf("%s/%s", iop.Namespace, iop.Name)
+// mergeWithProfile returns instance merged with its profile, with its charts resolved to a local path if they are
+// watched, and the chart source they are watched at.
+func (r *ReconcileIstioOperator) mergeWithProfile(instance *iop.IstioOperator) (*iop.IstioOperator, string, error) {
+	var err error
+	iopMerged := *instance
+	iopMerged.Spec, err = helmreconciler.MergeIOPSWithProfile(instance.Spec)
+	if err != nil {
+		return nil, "", err
+	}
+	if r.reloader == nil {
+		return &iopMerged, "", nil
+	}
+	path, source, err := r.reloader.resolve(&iopMerged)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve the charts: %s", err)
+	}
+	iopMerged.Spec.InstallPackagePath = path
+	return &iopMerged, source, nil
+}
+
+// invalidateReconciler drops the cached reconciler of the IstioOperator key, so that its charts are rendered anew.
+func (r *ReconcileIstioOperator) invalidateReconciler(key types.NamespacedName) {
+	r.reconcilersMu.Lock()
+	defer r.reconcilersMu.Unlock()
+	delete(r.reconcilers, key)
+}
+
+// unsubscribeCharts stops reconciling the IstioOperator key when its charts change.
+func (r *ReconcileIstioOperator) unsubscribeCharts(key types.NamespacedName) {
+	if r.reloader != nil {
+		r.reloader.unsubscribe(key)
+	}
 }
 
 var ownedResourcePredicates = predicate.Funcs{
@@ -224,30 +354,35 @@ var ownedResourcePredicates = predicate.Funcs{
 		return false
 	},
 	UpdateFunc: func(e event.UpdateEvent) bool {
-		// no action
-		return false
+		// only changes which make the resource drift from its rendered configuration
+		return detectOwnedResourceDrift(e.ObjectNew)
 	},
 }
 
 func (r *ReconcileIstioOperator) getOrCreateReconciler(iop *iop.IstioOperator) (*helmreconciler.HelmReconciler, error) {
-	key := reconcilersMapKey(iop)
+	key := types.NamespacedName{Namespace: iop.Namespace, Name: iop.Name}
+	r.reconcilersMu.Lock()
+	defer r.reconcilersMu.Unlock()
+	if r.reconcilers == nil {
+		r.reconcilers = make(map[types.NamespacedName]*helmreconciler.HelmReconciler)
+	}
 	var err error
 	var reconciler *helmreconciler.HelmReconciler
-	if reconciler, ok := reconcilers[key]; ok {
+	if reconciler, ok := r.reconcilers[key]; ok {
 		reconciler.SetNeedUpdateAndPrune(false)
 		oldInstance := reconciler.GetInstance()
 		reconciler.SetInstance(iop)
 		if reconciler.GetInstance() != oldInstance {
 			//regenerate the reconciler
 			if reconciler, err = r.factory.New(iop, r.client); err == nil {
-				reconcilers[key] = reconciler
+				r.reconcilers[key] = reconciler
 			}
 		}
 		return reconciler, err
 	}
 	//not found - generate the reconciler
 	if reconciler, err = r.factory.New(iop, r.client); err == nil {
-		reconcilers[key] = reconciler
+		r.reconcilers[key] = reconciler
 	}
 	return reconciler, err
 }
@@ -264,11 +399,7 @@ func watchIstioResources(c controller.Controller) error {
 		err := c.Watch(&source.Kind{Type: u}, &handler.EnqueueRequestsFromMapFunc{
 			ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
 				log.Debugf("watch a change for istio resource: %s.%s", a.Meta.GetName(), a.Meta.GetNamespace())
-				return []reconcile.Request{
-					{NamespacedName: types.NamespacedName{
-						Name: a.Meta.GetLabels()[OwnerNameKey],
-					}},
-				}
+				return []reconcile.Request{{NamespacedName: ownerKey(a.Meta)}}
 			}),
 		}, ownedResourcePredicates)
 		if err != nil {
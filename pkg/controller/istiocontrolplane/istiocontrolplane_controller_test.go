@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/kr/pretty"
@@ -186,7 +188,62 @@ func testSwitchProfile(t *testing.T, c testCase) {
 	}
 }
 
-func statusExpected(s1, s2 *v1alpha1.InstallStatus_VersionStatus) bool {
+// TestIOPController_MultipleInstances reconciles two IstioOperators concurrently. The second renders the same
+// resources as the first, which it must not take over.
+func TestIOPController_MultipleInstances(t *testing.T) {
+	s := scheme.Scheme
+	var objs []runtime.Object
+	var reqs []reconcile.Request
+	for _, key := range []types.NamespacedName{{Namespace: "team-a", Name: "control-plane"}, {Namespace: "team-b", Name: "control-plane"}} {
+		instance := &iop.IstioOperator{
+			Kind:       "IstioOperator",
+			ApiVersion: "install.istio.io/v1alpha1",
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: &v1alpha1.IstioOperatorSpec{
+				Profile:    "minimal",
+				MeshConfig: &mesh.MeshConfig{RootNamespace: "istio-system"},
+			},
+		}
+		s.AddKnownTypes(iop.SchemeGroupVersion, instance)
+		objs = append(objs, instance)
+		reqs = append(reqs, reconcile.Request{NamespacedName: key})
+	}
+	cl := fake.NewFakeClientWithScheme(s, objs...)
+	factory := &helmreconciler.Factory{CustomizerFactory: &IstioRenderingCustomizerFactory{}}
+	r := &ReconcileIstioOperator{client: cl, scheme: s, factory: factory}
+
+	if _, err := r.Reconcile(reqs[0]); err != nil {
+		t.Fatalf("reconcile: (%v)", err)
+	}
+	for i := 0; i < 2; i++ {
+		var wg sync.WaitGroup
+		for _, req := range reqs {
+			wg.Add(1)
+			go func(req reconcile.Request) {
+				defer wg.Done()
+				_, _ = r.Reconcile(req)
+			}(req)
+		}
+		wg.Wait()
+	}
+
+	if succeed, err := checkIOPStatus(cl, reqs[0].NamespacedName, "minimal"); !succeed || err != nil {
+		t.Fatalf("failed to get expected IstioOperator status of the first instance: (%v)", err)
+	}
+	second := &iop.IstioOperator{}
+	if err := cl.Get(context.TODO(), reqs[1].NamespacedName, second); err != nil {
+		t.Fatal(err)
+	}
+	var base *iop.ComponentStatus
+	if second.Status != nil {
+		base = second.Status.ComponentStatus[string(name.IstioBaseComponentName)]
+	}
+	if base == nil || base.Status != v1alpha1.InstallStatus_ERROR || !strings.Contains(base.Error, "is owned by another instance") {
+		t.Errorf("got base status %v of the second instance, want an ownership conflict", pretty.Sprint(base))
+	}
+}
+
+func statusExpected(s1 *v1alpha1.InstallStatus_VersionStatus, s2 *iop.ComponentStatus) bool {
 	return s1.Status.String() == s2.Status.String()
 }
 
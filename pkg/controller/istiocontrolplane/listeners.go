@@ -17,20 +17,17 @@ package istiocontrolplane
 import (
 	"context"
 	"fmt"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"istio.io/api/operator/v1alpha1"
 	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
 	"istio.io/operator/pkg/helmreconciler"
 	"istio.io/pkg/log"
@@ -39,10 +36,6 @@ import (
 const (
 	// ChartOwnerKey is the annotation key used to store the name of the chart that created the resource
 	ChartOwnerKey = MetadataNamespace + "/chart-owner"
-
-	finalizerRemovalBackoffSteps    = 10
-	finalizerRemovalBackoffDuration = 6 * time.Second
-	finalizerRemovalBackoffFactor   = 1.1
 )
 
 // IstioRenderingListener is a RenderingListener specific to IstioOperator resources
@@ -58,15 +51,27 @@ type IstioStatusUpdater struct {
 	reconciler *helmreconciler.HelmReconciler
 }
 
-// NewIstioRenderingListener returns a new IstioRenderingListener, which is a composite that includes IstioStatusUpdater
-// and IstioChartCustomizerListener.
-func NewIstioRenderingListener(instance *iop.IstioOperator) *IstioRenderingListener {
+// NewIstioRenderingListener returns a new IstioRenderingListener, which is a composite that includes
+// MetricsRenderingListener, IstioStatusUpdater and IstioChartCustomizerListener, and an EventRecordingListener if
+// recorder is not nil, and a SpecHashDecorator used for drift detection. The metrics and event listeners come first
+// so that their EndReconcile sees the aggregate status computed by IstioStatusUpdater. Events are rate limited by
+// eventLimiter, which is shared by all the reconciliations of instance.
+func NewIstioRenderingListener(instance *iop.IstioOperator, recorder record.EventRecorder,
+	eventLimiter *helmreconciler.EventLimiter) *IstioRenderingListener {
+	var listeners []helmreconciler.RenderingListener
+	if recorder != nil {
+		listeners = append(listeners, helmreconciler.NewEventRecordingListener(recorder, eventLimiter))
+	}
+	listeners = append(listeners,
+		helmreconciler.NewMetricsRenderingListener(ChartOwnerKey),
+		NewChartCustomizerListener(),
+		NewIstioStatusUpdater(instance),
+		// must be last to modify resources, so that the hash covers all other changes
+		helmreconciler.NewSpecHashDecorator(SpecHashKey),
+	)
 	return &IstioRenderingListener{
 		&helmreconciler.CompositeRenderingListener{
-			Listeners: []helmreconciler.RenderingListener{
-				NewChartCustomizerListener(),
-				NewIstioStatusUpdater(instance),
-			},
+			Listeners: listeners,
 		},
 	}
 }
@@ -79,18 +84,24 @@ func NewIstioStatusUpdater(instance *iop.IstioOperator) helmreconciler.Rendering
 	}
 }
 
-// EndReconcile updates the status field on the IstioOperator instance based on the resulting err parameter.
-func (u *IstioStatusUpdater) EndReconcile(_ runtime.Object, status *v1alpha1.InstallStatus) error {
-	iop := &iop.IstioOperator{}
+// EndReconcile updates the status field on the IstioOperator instance with the component status of the
+// reconciliation, and the aggregate status and conditions computed from it.
+func (u *IstioStatusUpdater) EndReconcile(instance runtime.Object, status *iop.IstioOperatorStatus) error {
+	current := &iop.IstioOperator{}
 	namespacedName := types.NamespacedName{
 		Name:      u.instance.Name,
 		Namespace: u.instance.Namespace,
 	}
-	if err := u.reconciler.GetClient().Get(context.TODO(), namespacedName, iop); err != nil {
+	if err := u.reconciler.GetClient().Get(context.TODO(), namespacedName, current); err != nil {
 		return fmt.Errorf("failed to get IstioOperator before updating status due to %v", err)
 	}
-	iop.Status = status
-	return u.reconciler.GetClient().Status().Update(context.TODO(), iop)
+	generation := u.instance.GetGeneration()
+	if accessor, err := meta.Accessor(instance); err == nil {
+		generation = accessor.GetGeneration()
+	}
+	aggregateStatus(current.Status, status, generation)
+	current.Status = status
+	return u.reconciler.GetClient().Status().Update(context.TODO(), current)
 }
 
 // RegisterReconciler registers the HelmReconciler with this object
@@ -151,113 +162,6 @@ func NewIstioDefaultChartCustomizer(chartName, chartAnnotationKey string) *Istio
 	}
 }
 
-// EndChart waits for any deployments or stateful sets that were created to become ready
-func (c *IstioDefaultChartCustomizer) EndChart(chartName string) error {
-	// ignore any errors.  things should settle out
-	c.waitForResources()
-	return nil
-}
-
-func (c *IstioDefaultChartCustomizer) waitForResources() {
-	if statefulSets, ok := c.NewResourcesByKind["StatefulSet"]; ok {
-		for _, statefulSet := range statefulSets {
-			c.waitForDeployment(statefulSet)
-		}
-	}
-	if deployments, ok := c.NewResourcesByKind["Deployment"]; ok {
-		for _, deployment := range deployments {
-			c.waitForDeployment(deployment)
-		}
-	}
-	if daemonSets, ok := c.NewResourcesByKind["DaemonSet"]; ok {
-		for _, daemonSet := range daemonSets {
-			c.waitForDeployment(daemonSet)
-		}
-	}
-	if services, ok := c.NewResourcesByKind["Service"]; ok {
-		for _, service := range services {
-			c.waitForService(service)
-		}
-	}
-}
-
-func (c *IstioDefaultChartCustomizer) serviceReady(svc *corev1.Service) bool {
-	// ExternalName Services are external to cluster so they should not be checked
-	if svc.Spec.Type == corev1.ServiceTypeExternalName {
-		return true
-	}
-	// Check if services except the headless services have the IP set
-	if svc.Spec.ClusterIP != corev1.ClusterIPNone && svc.Spec.ClusterIP == "" {
-		log.Info(fmt.Sprintf("Service is not ready: %s/%s", svc.GetNamespace(), svc.GetName()))
-		return false
-	}
-	// Check if the service has a LoadBalancer with an Ingress ready
-	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer && svc.Status.LoadBalancer.Ingress == nil {
-		log.Info(fmt.Sprintf("Service is not ready: %s/%s", svc.GetNamespace(), svc.GetName()))
-		return false
-	}
-	return true
-}
-
-func (c *IstioDefaultChartCustomizer) waitForService(object runtime.Object) {
-	gvk := object.GetObjectKind().GroupVersionKind()
-	objectAccessor, err := meta.Accessor(object)
-	if err != nil {
-		log.Error(fmt.Sprintf("could not get object accessor for %s", gvk.Kind))
-		return
-	}
-	name := objectAccessor.GetName()
-	service, ok := object.(*corev1.Service)
-	if ok {
-		log.Infof("waiting for service to become ready; %s", name)
-		err = wait.ExponentialBackoff(wait.Backoff{
-			Duration: finalizerRemovalBackoffDuration,
-			Steps:    finalizerRemovalBackoffSteps,
-			Factor:   finalizerRemovalBackoffFactor,
-		}, func() (bool, error) {
-			return c.serviceReady(service), nil
-		})
-		if err != nil {
-			log.Errorf("service failed to become ready in a timely manner: %s", name)
-		}
-	}
-}
-
-// XXX: configure wait period
-func (c *IstioDefaultChartCustomizer) waitForDeployment(object runtime.Object) {
-	gvk := object.GetObjectKind().GroupVersionKind()
-	objectAccessor, err := meta.Accessor(object)
-	if err != nil {
-		log.Error(fmt.Sprintf("could not get object accessor for %s", gvk.Kind))
-		return
-	}
-	name := objectAccessor.GetName()
-	namespace := objectAccessor.GetNamespace()
-	deployment := &unstructured.Unstructured{}
-	deployment.SetGroupVersionKind(gvk)
-	// wait for deployment replicas >= 1
-	log.Infof("waiting for deployment to become ready: %s, %s", gvk.Kind, name)
-	err = wait.ExponentialBackoff(wait.Backoff{
-		Duration: finalizerRemovalBackoffDuration,
-		Steps:    finalizerRemovalBackoffSteps,
-		Factor:   finalizerRemovalBackoffFactor,
-	}, func() (bool, error) {
-		err := c.Reconciler.GetClient().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, deployment)
-		if err == nil {
-			val, _, _ := unstructured.NestedInt64(deployment.UnstructuredContent(), "status", "readyReplicas")
-			return val > 0, nil
-		} else if errors.IsNotFound(err) {
-			log.Errorf("attempting to wait on unknown deployment: %s, %s", gvk.Kind, name)
-			return true, nil
-		}
-		log.Errorf("unexpected error occurred waiting for deployment to become ready: %s, %s: %s", gvk.Kind, name, err)
-		return false, err
-	})
-	if err != nil {
-		log.Errorf("deployment failed to become ready in a timely manner: %s, %s", gvk.Kind, name)
-	}
-}
-
 // CitadelChartCustomizer is a ChartCustomizer for the citadel chart
 type CitadelChartCustomizer struct {
 	*IstioDefaultChartCustomizer
@@ -0,0 +1,114 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/util/schedule"
+)
+
+const (
+	// PausedKey is the IstioOperator annotation which pauses the IstioOperator if set to "true": nothing is applied,
+	// pruned or deleted until the annotation is removed.
+	PausedKey = MetadataNamespace + "/paused"
+	// MaintenanceWindowKey is the IstioOperator annotation restricting when changes are applied, e.g. "0 2 * * Sat 4h"
+	// for Saturdays from 02:00 to 06:00 UTC. See package schedule for the format. Outside of the window, spec changes
+	// are reported as pending and drift is not healed.
+	MaintenanceWindowKey = MetadataNamespace + "/maintenance-window"
+
+	reasonPausedByAnnotation       = "PausedByAnnotation"
+	reasonOutsideMaintenanceWindow = "OutsideMaintenanceWindow"
+	reasonInvalidMaintenanceWindow = "InvalidMaintenanceWindow"
+	reasonInsideMaintenanceWindow  = "InsideMaintenanceWindow"
+	reasonNoPendingChanges         = "NoPendingChanges"
+	reasonResumed                  = "Resumed"
+)
+
+// isPaused reports whether instance is paused.
+func isPaused(instance *iop.IstioOperator) bool {
+	return instance.GetAnnotations()[PausedKey] == "true"
+}
+
+// maintenanceWindow returns the maintenance window of instance, or nil if it has none.
+func maintenanceWindow(instance *iop.IstioOperator) (*schedule.Window, error) {
+	spec, ok := instance.GetAnnotations()[MaintenanceWindowKey]
+	if !ok {
+		return nil, nil
+	}
+	return schedule.ParseWindow(spec)
+}
+
+// hasPendingChanges reports whether the spec of instance changed since it was last reconciled.
+func hasPendingChanges(instance *iop.IstioOperator) bool {
+	return instance.Status == nil || instance.Status.ObservedGeneration != instance.Generation
+}
+
+// pausedCondition returns the Paused condition of instance.
+func pausedCondition(instance *iop.IstioOperator) iop.Condition {
+	c := iop.Condition{Type: iop.ConditionPaused, Status: corev1.ConditionFalse, Reason: reasonResumed, LastTransitionTime: metav1.Now()}
+	if isPaused(instance) {
+		c.Status, c.Reason = corev1.ConditionTrue, reasonPausedByAnnotation
+		c.Message = fmt.Sprintf("remove the %s annotation to resume reconciling", PausedKey)
+	}
+	return c
+}
+
+// pendingCondition returns the Pending condition of instance, given whether its maintenance window is open. next is
+// the next time the window opens, zero if it never does. windowErr is the error parsing the window, if any.
+func pendingCondition(instance *iop.IstioOperator, open bool, next time.Time, windowErr error) iop.Condition {
+	c := iop.Condition{Type: iop.ConditionPending, Status: corev1.ConditionFalse, LastTransitionTime: metav1.Now()}
+	switch {
+	case !hasPendingChanges(instance):
+		c.Reason = reasonNoPendingChanges
+	case windowErr != nil:
+		c.Status, c.Reason, c.Message = corev1.ConditionTrue, reasonInvalidMaintenanceWindow, windowErr.Error()
+	case open:
+		c.Reason = reasonInsideMaintenanceWindow
+	case next.IsZero():
+		c.Status, c.Reason = corev1.ConditionTrue, reasonOutsideMaintenanceWindow
+		c.Message = "spec changes are pending, the maintenance window never opens"
+	default:
+		c.Status, c.Reason = corev1.ConditionTrue, reasonOutsideMaintenanceWindow
+		c.Message = fmt.Sprintf("spec changes are pending until the maintenance window opens at %s", next.Format(time.RFC3339))
+	}
+	return c
+}
+
+// updateConditions sets the given conditions in the status of instance, and updates the status if it changed.
+func (r *ReconcileIstioOperator) updateConditions(instance *iop.IstioOperator, conditions ...iop.Condition) error {
+	if instance.Status == nil {
+		instance.Status = &iop.IstioOperatorStatus{}
+	}
+	changed := false
+	for _, c := range conditions {
+		if setOptionalCondition(instance.Status, c) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := r.client.Status().Update(context.TODO(), instance); err != nil {
+		return fmt.Errorf("failed to update the status of IstioOperator %s/%s: %s", instance.Namespace, instance.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+)
+
+func TestPendingCondition(t *testing.T) {
+	next := time.Date(2020, 2, 8, 2, 0, 0, 0, time.UTC)
+	tests := []struct {
+		desc        string
+		status      *iop.IstioOperatorStatus
+		open        bool
+		next        time.Time
+		windowErr   error
+		wantStatus  corev1.ConditionStatus
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			desc:       "no pending changes",
+			status:     &iop.IstioOperatorStatus{ObservedGeneration: 2},
+			wantStatus: corev1.ConditionFalse,
+			wantReason: reasonNoPendingChanges,
+		},
+		{
+			desc:       "window open",
+			open:       true,
+			wantStatus: corev1.ConditionFalse,
+			wantReason: reasonInsideMaintenanceWindow,
+		},
+		{
+			desc:        "window closed",
+			status:      &iop.IstioOperatorStatus{ObservedGeneration: 1},
+			next:        next,
+			wantStatus:  corev1.ConditionTrue,
+			wantReason:  reasonOutsideMaintenanceWindow,
+			wantMessage: "until the maintenance window opens at 2020-02-08T02:00:00Z",
+		},
+		{
+			desc:        "window never opens",
+			wantStatus:  corev1.ConditionTrue,
+			wantReason:  reasonOutsideMaintenanceWindow,
+			wantMessage: "never opens",
+		},
+		{
+			desc:        "invalid window",
+			windowErr:   fmt.Errorf("invalid window"),
+			wantStatus:  corev1.ConditionTrue,
+			wantReason:  reasonInvalidMaintenanceWindow,
+			wantMessage: "invalid window",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			instance := &iop.IstioOperator{Status: tt.status}
+			instance.Generation = 2
+			c := pendingCondition(instance, tt.open, tt.next, tt.windowErr)
+			if c.Type != iop.ConditionPending || c.Status != tt.wantStatus || c.Reason != tt.wantReason {
+				t.Errorf("got %s %s (%s), want %s %s (%s)", c.Type, c.Status, c.Reason, iop.ConditionPending, tt.wantStatus, tt.wantReason)
+			}
+			if !strings.Contains(c.Message, tt.wantMessage) {
+				t.Errorf("got message %q, want it to contain %q", c.Message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestPausedCondition(t *testing.T) {
+	instance := &iop.IstioOperator{}
+	instance.SetAnnotations(map[string]string{PausedKey: "true"})
+	if c := pausedCondition(instance); c.Status != corev1.ConditionTrue || c.Reason != reasonPausedByAnnotation {
+		t.Errorf("got %s (%s), want %s (%s)", c.Status, c.Reason, corev1.ConditionTrue, reasonPausedByAnnotation)
+	}
+
+	// Once resumed, the condition is only recorded if the instance was paused before.
+	instance.SetAnnotations(nil)
+	status := &iop.IstioOperatorStatus{}
+	if setOptionalCondition(status, pausedCondition(instance)) {
+		t.Errorf("got Paused condition %v, want none", status.GetCondition(iop.ConditionPaused))
+	}
+	status.SetCondition(iop.Condition{Type: iop.ConditionPaused, Status: corev1.ConditionTrue, Reason: reasonPausedByAnnotation})
+	if !setOptionalCondition(status, pausedCondition(instance)) {
+		t.Fatal("got Paused condition unchanged, want it resumed")
+	}
+	if c := status.GetCondition(iop.ConditionPaused); c.Status != corev1.ConditionFalse || c.Reason != reasonResumed {
+		t.Errorf("got %s (%s), want %s (%s)", c.Status, c.Reason, corev1.ConditionFalse, reasonResumed)
+	}
+}
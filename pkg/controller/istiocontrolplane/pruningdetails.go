@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"istio.io/operator/pkg/helmreconciler"
+	"istio.io/pkg/log"
 )
 
 const (
@@ -31,6 +32,8 @@ const (
 
 	// OwnerNameKey represents the name of the owner to which the resource relates
 	OwnerNameKey = MetadataNamespace + "/owner-name"
+	// OwnerNamespaceKey represents the namespace of the owner to which the resource relates
+	OwnerNamespaceKey = MetadataNamespace + "/owner-namespace"
 	// OwnerKindKey represents the kind of the owner to which the resource relates
 	OwnerKindKey = MetadataNamespace + "/owner-kind"
 	// OwnerGroupKey represents the group of the owner to which the resource relates
@@ -38,6 +41,13 @@ const (
 
 	// OwnerGenerationKey represents the generation to which the resource was last reconciled
 	OwnerGenerationKey = MetadataNamespace + "/owner-generation"
+
+	// PrunePreviewKey is the IstioOperator annotation which, if set to "true", lists the resources which would be
+	// pruned in the status of the IstioOperator instead of deleting them.
+	PrunePreviewKey = MetadataNamespace + "/prune-preview"
+	// MaxPruneKey is the IstioOperator annotation overriding the --max-prune limit on the number of resources pruned
+	// in one reconcile, "0" meaning no limit.
+	MaxPruneKey = MetadataNamespace + "/max-prune"
 )
 
 var (
@@ -133,6 +143,9 @@ var (
 		{Group: "authentication.istio.io", Version: "v1alpha1", Kind: "MeshPolicy"}:                         false,
 		//{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}: false,
 	}
+
+	// resourceMapsMU guards namespacedResourceMap and nonNamespacedResourceMap, which are shared by all instances.
+	resourceMapsMU sync.Mutex
 )
 
 // NewPruningDetails creates a new PruningDetails object specific to the instance.
@@ -141,15 +154,35 @@ func NewIstioPruningDetails(instance *v1alpha1.IstioOperator) helmreconciler.Pru
 	generation := strconv.FormatInt(instance.GetGeneration(), 10)
 	return &helmreconciler.SimplePruningDetails{
 		OwnerLabels: map[string]string{
-			OwnerNameKey:  name,
-			OwnerGroupKey: v1alpha1.IstioOperatorGVK.Group,
-			OwnerKindKey:  v1alpha1.IstioOperatorGVK.Kind,
+			OwnerNameKey:      name,
+			OwnerNamespaceKey: instance.GetNamespace(),
+			OwnerGroupKey:     v1alpha1.IstioOperatorGVK.Group,
+			OwnerKindKey:      v1alpha1.IstioOperatorGVK.Kind,
 		},
 		OwnerAnnotations: map[string]string{
 			OwnerGenerationKey: generation,
 		},
 		NamespacedResourceMap:    namespacedResourceMap,
 		NonNamespacedResourceMap: nonNamespacedResourceMap,
-		PruningDetailsMU:         &sync.Mutex{},
+		PruningDetailsMU:         &resourceMapsMU,
+		PrunePolicy:              prunePolicy(instance),
+	}
+}
+
+// prunePolicy returns the prune policy set in the annotations of instance, limited by --max-prune by default.
+func prunePolicy(instance *v1alpha1.IstioOperator) helmreconciler.PrunePolicy {
+	annotations := instance.GetAnnotations()
+	policy := helmreconciler.PrunePolicy{
+		Preview:      annotations[PrunePreviewKey] == "true",
+		MaxDeletions: controllerOptions.MaxPrune,
+	}
+	if value, ok := annotations[MaxPruneKey]; ok {
+		max, err := strconv.Atoi(value)
+		if err != nil || max < 0 {
+			log.Warnf("ignoring invalid prune limit %s=%q", MaxPruneKey, value)
+		} else {
+			policy.MaxDeletions = max
+		}
 	}
+	return policy
 }
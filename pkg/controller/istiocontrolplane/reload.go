@@ -0,0 +1,192 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"os"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/util/fswatch"
+	"istio.io/pkg/log"
+)
+
+const (
+	// AutoReloadKey is the IstioOperator annotation which opts out of reconciling the IstioOperator when its charts
+	// change, if set to "false".
+	AutoReloadKey = MetadataNamespace + "/auto-reload"
+)
+
+// chartReloader watches the chart sources of IstioOperators and enqueues the IstioOperators using a source when it
+// changes. Local chart directories are watched with fswatch and installation package URLs are polled.
+type chartReloader struct {
+	mu sync.Mutex
+	// subscribers maps each chart source to the IstioOperators using it.
+	subscribers map[string]map[types.NamespacedName]bool
+	// sources maps each subscribed IstioOperator to its chart source.
+	sources map[types.NamespacedName]string
+	// watched maps each watched chart source to the local directory its charts are rendered from. Watches are not
+	// stopped when a source loses its last subscriber, since the charts of a URL are still rendered from its
+	// directory and a source is typically used again, e.g. when an IstioOperator is recreated.
+	watched map[string]string
+	// events receives the IstioOperators to reconcile.
+	events chan event.GenericEvent
+	// invalidate drops the cached reconciler of an IstioOperator. It may be nil.
+	invalidate func(key types.NamespacedName)
+	// watchDir and pollURL start watches on local directories and URLs.
+	watchDir func(dir string) (<-chan struct{}, error)
+	pollURL  func(url string) (string, <-chan struct{}, error)
+}
+
+// newChartReloader returns a new chartReloader. Packages polled from URLs are only used if they pass verification
+// with verifier.
+func newChartReloader(verifier *helm.SignatureVerifier) *chartReloader {
+	return &chartReloader{
+		subscribers: make(map[string]map[types.NamespacedName]bool),
+		sources:     make(map[types.NamespacedName]string),
+		watched:     make(map[string]string),
+		events:      make(chan event.GenericEvent),
+		watchDir:    fswatch.WatchDirRecursively,
+		pollURL: func(url string) (string, <-chan struct{}, error) {
+			return helm.PollURL(url, controllerOptions.ChartPollInterval, verifier)
+		},
+	}
+}
+
+// autoReloadEnabled reports whether instance did not opt out of auto-reload.
+func autoReloadEnabled(instance *iop.IstioOperator) bool {
+	return instance.GetAnnotations()[AutoReloadKey] != "false"
+}
+
+// resolve returns the local path the charts of instance, which should be merged with its profile, are rendered
+// from and its chart source. This is the installation package path, or the base chart path if it is not set and
+// exists. Chart sources are watched from the first time they are resolved. The source is "" if the charts are not
+// watched: compiled-in charts, and remote chart sources like OCI registries and Helm repositories which the renderer
+// resolves itself.
+func (r *chartReloader) resolve(instance *iop.IstioOperator) (path, source string, err error) {
+	if instance.Spec != nil {
+		source = instance.Spec.InstallPackagePath
+	}
+	switch {
+	case source == "":
+		if !isDir(controllerOptions.BaseChartPath) {
+			return "", "", nil
+		}
+		source = controllerOptions.BaseChartPath
+	case helm.IsChartSourceRef(source):
+		return source, "", nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if path, ok := r.watched[source]; ok {
+		return path, source, nil
+	}
+	var changes <-chan struct{}
+	path = source
+	switch {
+	case util.IsHTTPURL(source):
+		path, changes, err = r.pollURL(source)
+		if err != nil {
+			return "", "", err
+		}
+	case isDir(source):
+		if changes, err = r.watchDir(source); err != nil {
+			log.Warnf("could not watch the charts at %s: %s", source, err)
+			return source, "", nil
+		}
+	default:
+		// Rendering reports the missing charts.
+		return source, "", nil
+	}
+	r.watched[source] = path
+	go func() {
+		for range changes {
+			r.reload(source)
+		}
+	}()
+	return path, source, nil
+}
+
+// subscribe reconciles the IstioOperator key when source changes, replacing any previous subscription of key. key is
+// unsubscribed if source is "".
+func (r *chartReloader) subscribe(key types.NamespacedName, source string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if old, ok := r.sources[key]; ok && old == source {
+		return
+	}
+	r.unsubscribeLocked(key)
+	if source == "" {
+		return
+	}
+	if r.subscribers[source] == nil {
+		r.subscribers[source] = make(map[types.NamespacedName]bool)
+	}
+	r.subscribers[source][key] = true
+	r.sources[key] = source
+	log.Infof("reconciling %s when the charts at %s change", key, source)
+}
+
+// unsubscribe stops reconciling the IstioOperator key on chart changes.
+func (r *chartReloader) unsubscribe(key types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.unsubscribeLocked(key)
+}
+
+func (r *chartReloader) unsubscribeLocked(key types.NamespacedName) {
+	source, ok := r.sources[key]
+	if !ok {
+		return
+	}
+	delete(r.sources, key)
+	delete(r.subscribers[source], key)
+	if len(r.subscribers[source]) == 0 {
+		delete(r.subscribers, source)
+	}
+}
+
+// reload invalidates the cached reconcilers of the IstioOperators using source and enqueues them.
+func (r *chartReloader) reload(source string) {
+	r.mu.Lock()
+	var keys []types.NamespacedName
+	for key := range r.subscribers[source] {
+		keys = append(keys, key)
+	}
+	r.mu.Unlock()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	log.Infof("charts at %s changed, reconciling %d IstioOperators", source, len(keys))
+	for _, key := range keys {
+		if r.invalidate != nil {
+			r.invalidate(key)
+		}
+		instance := &iop.IstioOperator{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+		r.events <- event.GenericEvent{Meta: instance, Object: instance}
+	}
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
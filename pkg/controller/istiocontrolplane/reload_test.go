@@ -0,0 +1,222 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helm"
+)
+
+func TestChartReloader(t *testing.T) {
+	baseChartPath, err := ioutil.TempDir("", "charts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(baseChartPath)
+	defer func(old string) { controllerOptions.BaseChartPath = old }(controllerOptions.BaseChartPath)
+	controllerOptions.BaseChartPath = baseChartPath
+
+	const url = "https://example.com/istio-1.5.0-linux.tar.gz"
+	changes := map[string]chan struct{}{baseChartPath: make(chan struct{}), url: make(chan struct{})}
+	r := newChartReloader(nil)
+	r.events = make(chan event.GenericEvent, 10)
+	r.watchDir = func(dir string) (<-chan struct{}, error) { return changes[dir], nil }
+	r.pollURL = func(url string) (string, <-chan struct{}, error) { return "/tmp/istio-1.5.0/charts", changes[url], nil }
+
+	tests := []struct {
+		name        string
+		installPath string
+		wantPath    string
+		wantSource  string
+	}{
+		{name: "base", wantPath: baseChartPath, wantSource: baseChartPath},
+		{name: "url", installPath: url, wantPath: "/tmp/istio-1.5.0/charts", wantSource: url},
+		{name: "oci", installPath: "oci://registry.example.com/istio/charts:1.5.0", wantPath: "oci://registry.example.com/istio/charts:1.5.0"},
+	}
+	for _, tt := range tests {
+		instance := &iop.IstioOperator{Spec: &v1alpha1.IstioOperatorSpec{InstallPackagePath: tt.installPath}}
+		path, source, err := r.resolve(instance)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != tt.wantPath || source != tt.wantSource {
+			t.Errorf("%s: got path %q and source %q, want %q and %q", tt.name, path, source, tt.wantPath, tt.wantSource)
+		}
+		r.subscribe(types.NamespacedName{Namespace: "istio-system", Name: tt.name}, source)
+	}
+
+	// Only the subscribers of the changed source are reconciled.
+	changes[url] <- struct{}{}
+	assertReloaded(t, r, "url")
+
+	// Unsubscribed IstioOperators are not reconciled.
+	r.unsubscribe(types.NamespacedName{Namespace: "istio-system", Name: "url"})
+	r.subscribe(types.NamespacedName{Namespace: "istio-system", Name: "base"}, "")
+	changes[url] <- struct{}{}
+	changes[baseChartPath] <- struct{}{}
+	assertReloaded(t, r)
+}
+
+func TestChartReloaderVerifiesPackages(t *testing.T) {
+	const pollInterval = 50 * time.Millisecond
+	defer func(old time.Duration) { controllerOptions.ChartPollInterval = old }(controllerOptions.ChartPollInterval)
+	controllerOptions.ChartPollInterval = pollInterval
+
+	dir, err := ioutil.TempDir("", "packages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keysFile := filepath.Join(dir, "keys.pem")
+	if err := ioutil.WriteFile(keysFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := helm.NewSignatureVerifier(keysFile, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer srv.Close()
+
+	const pkg = "istio-1.5.0-linux.tar.gz"
+	// publish serves a package with the given chart version, signed by key if signed is set, or else mis-signed.
+	publish := func(version string, signed bool) {
+		data := packageArchive(t, "istio-1.5.0/"+helm.ChartsFilePath+"/base/Chart.yaml", "version: "+version+"\n")
+		signedData := data
+		if !signed {
+			signedData = []byte("other package")
+		}
+		digest := sha256.Sum256(signedData)
+		sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := sha256.Sum256(data)
+		for fn, content := range map[string][]byte{
+			pkg:                            data,
+			pkg + helm.SHAFileSuffix:       []byte(fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), pkg)),
+			pkg + helm.SignatureFileSuffix: sig,
+		} {
+			if err := ioutil.WriteFile(filepath.Join(dir, fn), content, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	r := newChartReloader(verifier)
+	r.events = make(chan event.GenericEvent, 10)
+	publish("1.5.0", true)
+	instance := &iop.IstioOperator{Spec: &v1alpha1.IstioOperatorSpec{InstallPackagePath: srv.URL + "/" + pkg}}
+	path, source, err := r.resolve(instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.subscribe(types.NamespacedName{Namespace: "istio-system", Name: "url"}, source)
+	assertChartVersion(t, path, "1.5.0")
+
+	// A mis-signed update is neither extracted nor reloaded.
+	publish("1.5.1", false)
+	time.Sleep(5 * pollInterval)
+	assertReloaded(t, r)
+	assertChartVersion(t, path, "1.5.0")
+
+	publish("1.5.2", true)
+	assertReloaded(t, r, "url")
+	assertChartVersion(t, path, "1.5.2")
+}
+
+// packageArchive returns a gzipped tar archive holding a file with the given name and content.
+func packageArchive(t *testing.T, name, content string) []byte {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// assertChartVersion checks the version of the base chart in the charts directory path.
+func assertChartVersion(t *testing.T, path, version string) {
+	t.Helper()
+	b, err := ioutil.ReadFile(filepath.Join(path, "base", "Chart.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "version: " + version + "\n"; string(b) != want {
+		t.Errorf("got chart %q, want %q", b, want)
+	}
+}
+
+// assertReloaded checks that exactly the IstioOperators named want are enqueued by r.
+func assertReloaded(t *testing.T, r *chartReloader, want ...string) {
+	t.Helper()
+	for _, name := range want {
+		select {
+		case e := <-r.events:
+			if e.Meta.GetName() != name {
+				t.Errorf("got IstioOperator %s reconciled, want %s", e.Meta.GetName(), name)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("IstioOperator %s was not reconciled", name)
+		}
+	}
+	select {
+	case e := <-r.events:
+		t.Errorf("got IstioOperator %s reconciled, want none", e.Meta.GetName())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
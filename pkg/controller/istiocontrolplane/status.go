@@ -0,0 +1,137 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+)
+
+const (
+	reasonComponentsReady   = "ComponentsReady"
+	reasonComponentsPending = "ComponentsPending"
+	reasonReplicasNotReady  = "ReplicasNotReady"
+	reasonComponentsFailed  = "ComponentsFailed"
+	reasonNoComponents      = "NoComponents"
+	reasonReconciled        = "Reconciled"
+
+	// maxPrunedResources is the number of pruned resources kept in the status.
+	maxPrunedResources = 50
+)
+
+// aggregateStatus sets the overall status, observed generation, conditions and last error of status, which holds
+// the component status of the reconciliation of the given generation. Condition transition times and the last error
+// are carried over from prev, the status before the reconciliation, and the resources pruned before are appended to
+// the ones pruned by the reconciliation.
+func aggregateStatus(prev, status *iop.IstioOperatorStatus, generation int64) {
+	status.ObservedGeneration = generation
+	if prev != nil {
+		status.Conditions = append([]iop.Condition(nil), prev.Conditions...)
+		status.LastError = prev.LastError
+		status.PrunedResources = append(status.PrunedResources, prev.PrunedResources...)
+	}
+	if len(status.PrunedResources) > maxPrunedResources {
+		status.PrunedResources = status.PrunedResources[:maxPrunedResources]
+	}
+
+	var failed, pending, notReady, errs []string
+	for _, c := range sortedComponentNames(status.ComponentStatus) {
+		cs := status.ComponentStatus[c]
+		switch {
+		case cs.Status == v1alpha1.InstallStatus_ERROR:
+			failed = append(failed, c)
+			errs = append(errs, fmt.Sprintf("%s: %s", c, cs.Error))
+			if status.LastError == nil || cs.ErrorTime != nil && !cs.ErrorTime.Before(&status.LastError.Time) {
+				status.LastError = &iop.ErrorStatus{Component: c, Message: cs.Error, Time: errorTime(cs)}
+			}
+		case cs.Status != v1alpha1.InstallStatus_HEALTHY:
+			pending = append(pending, c)
+		case !cs.IsReady():
+			notReady = append(notReady, fmt.Sprintf("%s (%d/%d)", c, cs.ReadyReplicas, cs.DesiredReplicas))
+		}
+	}
+
+	now := metav1.Now()
+	degraded := iop.Condition{Type: iop.ConditionDegraded, Status: corev1.ConditionFalse, LastTransitionTime: now}
+	progressing := iop.Condition{Type: iop.ConditionProgressing, Status: corev1.ConditionFalse, LastTransitionTime: now}
+	ready := iop.Condition{Type: iop.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: now}
+
+	switch {
+	case len(failed) != 0:
+		status.Status = v1alpha1.InstallStatus_ERROR
+		degraded.Status, degraded.Reason, degraded.Message = corev1.ConditionTrue, reasonComponentsFailed, strings.Join(errs, "; ")
+		ready.Reason, ready.Message = reasonComponentsFailed, "failed components: "+strings.Join(failed, ", ")
+	case len(pending) != 0:
+		status.Status = v1alpha1.InstallStatus_RECONCILING
+		progressing.Status, progressing.Reason = corev1.ConditionTrue, reasonComponentsPending
+		progressing.Message = "pending components: " + strings.Join(pending, ", ")
+		ready.Reason, ready.Message = progressing.Reason, progressing.Message
+	case len(notReady) != 0:
+		status.Status = v1alpha1.InstallStatus_RECONCILING
+		progressing.Status, progressing.Reason = corev1.ConditionTrue, reasonReplicasNotReady
+		progressing.Message = "components with unready replicas: " + strings.Join(notReady, ", ")
+		ready.Reason, ready.Message = progressing.Reason, progressing.Message
+	case len(status.ComponentStatus) == 0:
+		status.Status = v1alpha1.InstallStatus_NONE
+		ready.Reason, ready.Message = reasonNoComponents, "no components are enabled"
+	default:
+		status.Status = v1alpha1.InstallStatus_HEALTHY
+		ready.Status, ready.Reason, ready.Message = corev1.ConditionTrue, reasonComponentsReady, "all components are ready"
+	}
+	status.SetCondition(ready)
+	status.SetCondition(progressing)
+	status.SetCondition(degraded)
+	// Changes are applied, so nothing is paused or pending anymore.
+	for _, t := range []iop.ConditionType{iop.ConditionPaused, iop.ConditionPending} {
+		setOptionalCondition(status, iop.Condition{Type: t, Status: corev1.ConditionFalse, Reason: reasonReconciled, LastTransitionTime: now})
+	}
+}
+
+// setOptionalCondition sets condition c of status, which is not added if it is false. It reports whether status
+// changed.
+func setOptionalCondition(status *iop.IstioOperatorStatus, c iop.Condition) bool {
+	old := status.GetCondition(c.Type)
+	if old == nil && c.Status == corev1.ConditionFalse {
+		return false
+	}
+	if old != nil && old.Status == c.Status && old.Reason == c.Reason && old.Message == c.Message {
+		return false
+	}
+	status.SetCondition(c)
+	return true
+}
+
+func errorTime(cs *iop.ComponentStatus) metav1.Time {
+	if cs.ErrorTime != nil {
+		return *cs.ErrorTime
+	}
+	return metav1.Now()
+}
+
+func sortedComponentNames(m map[string]*iop.ComponentStatus) []string {
+	var out []string
+	for c := range m {
+		out = append(out, c)
+	}
+	sort.Strings(out)
+	return out
+}
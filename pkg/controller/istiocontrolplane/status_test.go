@@ -0,0 +1,137 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+)
+
+func TestAggregateStatus(t *testing.T) {
+	errTime := metav1.NewTime(time.Unix(1000, 0))
+	tests := []struct {
+		desc            string
+		components      map[string]*iop.ComponentStatus
+		wantStatus      v1alpha1.InstallStatus_Status
+		wantReady       corev1.ConditionStatus
+		wantProgressing corev1.ConditionStatus
+		wantDegraded    corev1.ConditionStatus
+		wantLastError   string
+	}{
+		{
+			desc: "healthy",
+			components: map[string]*iop.ComponentStatus{
+				"Pilot": {Status: v1alpha1.InstallStatus_HEALTHY, ReadyReplicas: 1, DesiredReplicas: 1},
+			},
+			wantStatus:      v1alpha1.InstallStatus_HEALTHY,
+			wantReady:       corev1.ConditionTrue,
+			wantProgressing: corev1.ConditionFalse,
+			wantDegraded:    corev1.ConditionFalse,
+		},
+		{
+			desc: "replicas not ready",
+			components: map[string]*iop.ComponentStatus{
+				"Pilot": {Status: v1alpha1.InstallStatus_HEALTHY, ReadyReplicas: 0, DesiredReplicas: 1},
+			},
+			wantStatus:      v1alpha1.InstallStatus_RECONCILING,
+			wantReady:       corev1.ConditionFalse,
+			wantProgressing: corev1.ConditionTrue,
+			wantDegraded:    corev1.ConditionFalse,
+		},
+		{
+			desc: "error",
+			components: map[string]*iop.ComponentStatus{
+				"Pilot":  {Status: v1alpha1.InstallStatus_HEALTHY, ReadyReplicas: 0, DesiredReplicas: 1},
+				"Galley": {Status: v1alpha1.InstallStatus_ERROR, Error: "boom", ErrorTime: &errTime},
+			},
+			wantStatus:      v1alpha1.InstallStatus_ERROR,
+			wantReady:       corev1.ConditionFalse,
+			wantProgressing: corev1.ConditionFalse,
+			wantDegraded:    corev1.ConditionTrue,
+			wantLastError:   "boom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			status := &iop.IstioOperatorStatus{ComponentStatus: tt.components}
+			aggregateStatus(nil, status, 3)
+			if status.Status != tt.wantStatus {
+				t.Errorf("got status %s, want %s", status.Status, tt.wantStatus)
+			}
+			if status.ObservedGeneration != 3 {
+				t.Errorf("got observed generation %d, want 3", status.ObservedGeneration)
+			}
+			for ct, want := range map[iop.ConditionType]corev1.ConditionStatus{
+				iop.ConditionReady:       tt.wantReady,
+				iop.ConditionProgressing: tt.wantProgressing,
+				iop.ConditionDegraded:    tt.wantDegraded,
+			} {
+				if got := status.GetCondition(ct); got == nil || got.Status != want {
+					t.Errorf("condition %s: got %v, want %s", ct, got, want)
+				}
+			}
+			gotLastError := ""
+			if status.LastError != nil {
+				gotLastError = status.LastError.Message
+			}
+			if gotLastError != tt.wantLastError {
+				t.Errorf("got last error %q, want %q", gotLastError, tt.wantLastError)
+			}
+		})
+	}
+}
+
+func TestAggregateStatusKeepsHistory(t *testing.T) {
+	transition := metav1.NewTime(time.Unix(1000, 0))
+	prev := &iop.IstioOperatorStatus{
+		Conditions: []iop.Condition{{Type: iop.ConditionReady, Status: corev1.ConditionTrue, LastTransitionTime: transition}},
+		LastError:  &iop.ErrorStatus{Component: "Pilot", Message: "old error", Time: transition},
+	}
+	status := &iop.IstioOperatorStatus{ComponentStatus: map[string]*iop.ComponentStatus{
+		"Pilot": {Status: v1alpha1.InstallStatus_HEALTHY},
+	}}
+	aggregateStatus(prev, status, 1)
+	if got := status.GetCondition(iop.ConditionReady).LastTransitionTime; !got.Equal(&transition) {
+		t.Errorf("got Ready transition time %v, want %v", got, transition)
+	}
+	if status.LastError == nil || status.LastError.Message != "old error" {
+		t.Errorf("got last error %v, want old error to be kept", status.LastError)
+	}
+}
+
+func TestAggregateStatusPrunedResources(t *testing.T) {
+	prev := &iop.IstioOperatorStatus{}
+	for i := 0; i < maxPrunedResources; i++ {
+		prev.PrunedResources = append(prev.PrunedResources, iop.PrunedResource{Kind: "ConfigMap", Name: fmt.Sprintf("old-%d", i)})
+	}
+	status := &iop.IstioOperatorStatus{
+		PrunedResources: []iop.PrunedResource{{Kind: "ConfigMap", Name: "new"}},
+	}
+	aggregateStatus(prev, status, 1)
+	if got := len(status.PrunedResources); got != maxPrunedResources {
+		t.Fatalf("got %d pruned resources, want %d", got, maxPrunedResources)
+	}
+	if first, last := status.PrunedResources[0].Name, status.PrunedResources[maxPrunedResources-1].Name; first != "new" ||
+		last != fmt.Sprintf("old-%d", maxPrunedResources-2) {
+		t.Errorf("got pruned resources from %s to %s, want the newest first and the oldest dropped", first, last)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dag schedules the processing of components in the order of their dependencies.
+package dag
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/pkg/log"
+)
+
+// Graph maps each component to the components it depends on. A component is only processed once all of its
+// dependencies have been processed.
+type Graph map[name.ComponentName][]name.ComponentName
+
+// Validate returns an error if g has a cycle.
+func (g Graph) Validate() error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[name.ComponentName]int)
+	var path []name.ComponentName
+	var visit func(c name.ComponentName) error
+	visit = func(c name.ComponentName) error {
+		switch state[c] {
+		case visited:
+			return nil
+		case visiting:
+			for i := range path {
+				if path[i] == c {
+					return fmt.Errorf("dependency cycle: %s", joinNames(append(path[i:], c), " -> "))
+				}
+			}
+		}
+		state[c] = visiting
+		path = append(path, c)
+		for _, d := range g[c] {
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[c] = visited
+		return nil
+	}
+	for _, c := range g.components() {
+		if err := visit(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run calls f concurrently for each of components, once f has returned for all of its dependencies. Dependencies
+// which are not in components are skipped, but their own dependencies are still waited for. Run returns once all
+// calls of f have returned. If g has a cycle, f is not called and an error is returned.
+func (g Graph) Run(components []name.ComponentName, f func(c name.ComponentName)) error {
+	if err := g.Validate(); err != nil {
+		return err
+	}
+	done := make(map[name.ComponentName]chan struct{})
+	for _, c := range components {
+		done[c] = make(chan struct{})
+	}
+	var wg sync.WaitGroup
+	for c := range done {
+		c := c
+		deps := g.dependencies(c, done)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[c])
+			for _, d := range deps {
+				log.Infof("%s is waiting on %s...", c, d)
+				<-done[d]
+			}
+			f(c)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// HasDependents reports whether any component depends on c.
+func (g Graph) HasDependents(c name.ComponentName) bool {
+	for _, deps := range g {
+		for _, d := range deps {
+			if d == c {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Path returns the path from a component without dependencies to c, following the first dependency of each
+// component. It ends with c.
+func (g Graph) Path(c name.ComponentName) []name.ComponentName {
+	if len(g[c]) == 0 {
+		return []name.ComponentName{c}
+	}
+	return append(g.Path(g[c][0]), c)
+}
+
+// String returns the dependencies of each component, one component per line.
+func (g Graph) String() string {
+	var sb strings.Builder
+	for _, c := range g.components() {
+		if len(g[c]) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s: %s\n", c, joinNames(g[c], ", ")))
+	}
+	return sb.String()
+}
+
+// dependencies returns the dependencies of c in the set of components, including the dependencies of dependencies
+// which are not in the set.
+func (g Graph) dependencies(c name.ComponentName, components map[name.ComponentName]chan struct{}) []name.ComponentName {
+	var out []name.ComponentName
+	seen := make(map[name.ComponentName]bool)
+	var walk func(c name.ComponentName)
+	walk = func(c name.ComponentName) {
+		for _, d := range g[c] {
+			if seen[d] {
+				continue
+			}
+			seen[d] = true
+			if _, ok := components[d]; ok {
+				out = append(out, d)
+			} else {
+				walk(d)
+			}
+		}
+	}
+	walk(c)
+	return out
+}
+
+// components returns all components in g, sorted by name.
+func (g Graph) components() []name.ComponentName {
+	var out []name.ComponentName
+	for c := range g {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func joinNames(names []name.ComponentName, sep string) string {
+	var s []string
+	for _, n := range names {
+		s = append(s, string(n))
+	}
+	return strings.Join(s, sep)
+}
@@ -0,0 +1,130 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dag
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"istio.io/operator/pkg/name"
+)
+
+var testGraph = Graph{
+	name.PilotComponentName:   {name.IstioBaseComponentName},
+	name.GalleyComponentName:  {name.IstioBaseComponentName},
+	name.IngressComponentName: {name.PilotComponentName, name.GalleyComponentName},
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		desc    string
+		g       Graph
+		wantErr string
+	}{
+		{
+			desc: "no cycle",
+			g:    testGraph,
+		},
+		{
+			desc:    "self dependency",
+			g:       Graph{name.PilotComponentName: {name.PilotComponentName}},
+			wantErr: "dependency cycle: Pilot -> Pilot",
+		},
+		{
+			desc: "cycle",
+			g: Graph{
+				name.PilotComponentName:     {name.IstioBaseComponentName},
+				name.IstioBaseComponentName: {name.IngressComponentName},
+				name.IngressComponentName:   {name.PilotComponentName},
+			},
+			wantErr: "dependency cycle: Base -> IngressGateways -> Pilot -> Base",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.g.Validate()
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tt.wantErr {
+				t.Errorf("got error %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		desc       string
+		components []name.ComponentName
+		// wantBefore maps each component to the components which must be processed before it.
+		wantBefore map[name.ComponentName][]name.ComponentName
+	}{
+		{
+			desc: "all components",
+			components: []name.ComponentName{name.IngressComponentName, name.PilotComponentName,
+				name.GalleyComponentName, name.IstioBaseComponentName},
+			wantBefore: map[name.ComponentName][]name.ComponentName{
+				name.PilotComponentName:   {name.IstioBaseComponentName},
+				name.GalleyComponentName:  {name.IstioBaseComponentName},
+				name.IngressComponentName: {name.IstioBaseComponentName, name.PilotComponentName, name.GalleyComponentName},
+			},
+		},
+		{
+			desc:       "missing intermediate dependency",
+			components: []name.ComponentName{name.IngressComponentName, name.IstioBaseComponentName},
+			wantBefore: map[name.ComponentName][]name.ComponentName{
+				name.IngressComponentName: {name.IstioBaseComponentName},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var mu sync.Mutex
+			var order []name.ComponentName
+			err := testGraph.Run(tt.components, func(c name.ComponentName) {
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, c)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(order) != len(tt.components) {
+				t.Fatalf("got processed components %v, want %v", order, tt.components)
+			}
+			pos := make(map[name.ComponentName]int)
+			for i, c := range order {
+				pos[c] = i
+			}
+			for c, before := range tt.wantBefore {
+				for _, b := range before {
+					if pos[b] > pos[c] {
+						t.Errorf("got %s processed before %s in %v", c, b, order)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	want := []name.ComponentName{name.IstioBaseComponentName, name.PilotComponentName, name.IngressComponentName}
+	if got := testGraph.Path(name.IngressComponentName); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
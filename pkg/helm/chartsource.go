@@ -0,0 +1,292 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mholt/archiver"
+
+	"istio.io/pkg/log"
+)
+
+const (
+	// chartCacheDirectory is the directory under InstallationDirectory where fetched charts are cached by digest.
+	chartCacheDirectory = "charts"
+	// cacheMarkerFile is written to a cache entry once its package passed verification, or was fetched with
+	// verification skipped. Entries without it are not used.
+	cacheMarkerFile = ".istio-cache-entry"
+	// resolveCacheTTL is how long a resolved mutable reference, like an OCI tag or the latest chart version in a
+	// repository, is reused before it is resolved again.
+	resolveCacheTTL = 5 * time.Minute
+)
+
+// ChartSource fetches installation packages from a remote location into a local directory.
+type ChartSource interface {
+	// Supports reports whether ref is a reference this source can fetch.
+	Supports(ref string) bool
+	// Resolve returns the sha256 digest, as a hex string, which identifies the content of the package referenced by
+	// ref. If ref is pinned to a digest, Resolve should return it without contacting the remote location.
+	Resolve(ref string) (string, error)
+	// Fetch downloads the package referenced by ref, verifies that it matches the digest returned by Resolve and
+	// returns the path of the downloaded package archive, which is created in dir.
+	Fetch(ref, digest, dir string) (string, error)
+	// Signature returns the detached signature of the package archive referenced by ref, with the digest returned
+	// by Resolve.
+	Signature(ref, digest string) ([]byte, error)
+}
+
+var (
+	chartSourcesMu sync.RWMutex
+	// chartSources are the registered chart sources, in order of precedence.
+	chartSources = []ChartSource{
+		NewOCIChartSource(),
+		NewRepoIndexChartSource(),
+	}
+
+	// ChartCacheDir is the directory where fetched charts are cached. Charts are stored by digest, so a digest
+	// pinned reference is never downloaded twice. Cached charts are trusted, so the directory must only be writable
+	// by the current user: it is created with mode 0700 and refused if other users have access to it.
+	ChartCacheDir = defaultChartCacheDir()
+
+	chartVerifierMu sync.RWMutex
+	// chartVerifier verifies the signature of fetched packages. Without keys, signatures are not verified.
+	chartVerifier = &SignatureVerifier{}
+
+	resolvedMu sync.Mutex
+	// resolved caches the digests of resolved references.
+	resolved = make(map[string]resolvedRef)
+)
+
+type resolvedRef struct {
+	digest string
+	expiry time.Time
+}
+
+// RegisterChartSource registers a chart source. Sources registered later take precedence over earlier ones.
+func RegisterChartSource(s ChartSource) {
+	chartSourcesMu.Lock()
+	defer chartSourcesMu.Unlock()
+	chartSources = append([]ChartSource{s}, chartSources...)
+}
+
+// SetChartVerifier sets the verifier used by FetchCharts to verify the signature of fetched packages.
+func SetChartVerifier(v *SignatureVerifier) {
+	chartVerifierMu.Lock()
+	defer chartVerifierMu.Unlock()
+	chartVerifier = v
+}
+
+// ChartSourceFor returns the chart source for ref, or nil if ref is not a reference to a remote chart source.
+func ChartSourceFor(ref string) ChartSource {
+	chartSourcesMu.RLock()
+	defer chartSourcesMu.RUnlock()
+	for _, s := range chartSources {
+		if s.Supports(ref) {
+			return s
+		}
+	}
+	return nil
+}
+
+// IsChartSourceRef reports whether ref is a reference to a remote chart source.
+func IsChartSourceRef(ref string) bool {
+	return ChartSourceFor(ref) != nil
+}
+
+// FetchCharts fetches the installation package referenced by ref into the chart cache, unless it is already cached,
+// and returns the local path of its charts root directory. The package signature is verified before it is extracted,
+// unless the chart verifier skips verification, in which case the package is cached apart from verified ones.
+func FetchCharts(ref string) (string, error) {
+	s := ChartSourceFor(ref)
+	if s == nil {
+		return "", fmt.Errorf("unsupported chart source %s", ref)
+	}
+	digest, err := resolve(s, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %s", ref, err)
+	}
+	chartVerifierMu.RLock()
+	verifier := chartVerifier
+	chartVerifierMu.RUnlock()
+	entry := "sha256-" + digest
+	if verifier.skips() {
+		entry = "unverified-sha256-" + digest
+	}
+	dir := filepath.Join(ChartCacheDir, entry)
+	if isCacheEntry(dir, entry) {
+		log.Debugf("using cached charts for %s from %s", ref, dir)
+		return chartsRoot(dir)
+	}
+
+	if err := ensurePrivateDir(ChartCacheDir); err != nil {
+		return "", err
+	}
+	tmp, err := ioutil.TempDir(ChartCacheDir, "fetch-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+	log.Infof("fetching charts from %s", ref)
+	archive, err := s.Fetch(ref, digest, tmp)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch %s: %s", ref, err)
+	}
+	data, err := ioutil.ReadFile(archive)
+	if err != nil {
+		return "", err
+	}
+	if err := verifier.verifyPackage(ref, data, func() ([]byte, error) { return s.Signature(ref, digest) }); err != nil {
+		return "", err
+	}
+	extracted := filepath.Join(tmp, "charts")
+	targz := archiver.TarGz{Tar: &archiver.Tar{OverwriteExisting: true}}
+	if err := targz.Unarchive(archive, extracted); err != nil {
+		return "", fmt.Errorf("failed to extract %s: %s", ref, err)
+	}
+	// The package may hold a file of the same name, which must not be written through.
+	marker := filepath.Join(extracted, cacheMarkerFile)
+	if err := os.RemoveAll(marker); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(marker, []byte(entry), 0600); err != nil {
+		return "", err
+	}
+	// The complete entry is moved into place with a single rename, so that the cache never holds a partially
+	// extracted package. Another process may have populated the cache in the meantime, in which case its copy is
+	// used. Anything else at dir is replaced.
+	if err := os.Rename(extracted, dir); err != nil {
+		if isCacheEntry(dir, entry) {
+			return chartsRoot(dir)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return "", err
+		}
+		if err := os.Rename(extracted, dir); err != nil {
+			return "", err
+		}
+	}
+	return chartsRoot(dir)
+}
+
+// isCacheEntry reports whether dir is a complete chart cache entry with the given name.
+func isCacheEntry(dir, entry string) bool {
+	b, err := ioutil.ReadFile(filepath.Join(dir, cacheMarkerFile))
+	return err == nil && string(b) == entry
+}
+
+// ensurePrivateDir creates dir with mode 0700 if it does not exist, and returns an error if other users have access
+// to it.
+func ensurePrivateDir(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if fi.Mode().Perm()&0077 != 0 {
+		return fmt.Errorf("chart cache directory %s is accessible by other users, its mode must be 0700", dir)
+	}
+	return nil
+}
+
+// defaultChartCacheDir returns the chart cache directory in the cache directory of the user, or in the temporary
+// directory if the user has none.
+func defaultChartCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, InstallationDirectory, chartCacheDirectory)
+}
+
+// resolve returns the digest of ref, using a cached digest if ref was resolved recently.
+func resolve(s ChartSource, ref string) (string, error) {
+	resolvedMu.Lock()
+	r, ok := resolved[ref]
+	resolvedMu.Unlock()
+	if ok && time.Now().Before(r.expiry) {
+		return r.digest, nil
+	}
+	digest, err := s.Resolve(ref)
+	if err != nil {
+		return "", err
+	}
+	resolvedMu.Lock()
+	resolved[ref] = resolvedRef{digest: digest, expiry: time.Now().Add(resolveCacheTTL)}
+	resolvedMu.Unlock()
+	return digest, nil
+}
+
+// chartsRoot returns the charts root directory of the installation package extracted to dir. This is the charts
+// directory of a release archive, or else the top level directory of a chart archive.
+func chartsRoot(dir string) (string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	var dirs []string
+	for _, e := range entries {
+		if e.IsDir() {
+			dirs = append(dirs, filepath.Join(dir, e.Name()))
+		}
+	}
+	if len(dirs) != 1 {
+		return dir, nil
+	}
+	if charts := filepath.Join(dirs[0], ChartsFilePath); isDir(charts) {
+		return charts, nil
+	}
+	return dirs[0], nil
+}
+
+// parseDigest returns the hex value of a digest of the form sha256:<hex>.
+func parseDigest(d string) (string, error) {
+	hexDigest := strings.TrimPrefix(d, "sha256:")
+	if hexDigest == d && strings.Contains(d, ":") {
+		return "", fmt.Errorf("unsupported digest algorithm in %s, only sha256 is supported", d)
+	}
+	if b, err := hex.DecodeString(hexDigest); err != nil || len(b) != sha256.Size {
+		return "", fmt.Errorf("invalid sha256 digest %s", d)
+	}
+	return strings.ToLower(hexDigest), nil
+}
+
+// verifyDigest returns an error if the sha256 digest of data is not the hex string digest.
+func verifyDigest(data []byte, digest string) error {
+	if actual := sha256Hex(data); !strings.EqualFold(actual, digest) {
+		return fmt.Errorf("digest mismatch: got sha256:%s, want sha256:%s", actual, digest)
+	}
+	return nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func isDir(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && fi.IsDir()
+}
@@ -0,0 +1,459 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testChartKey signs the packages served by the test chart sources. It is trusted by the verifier of setupChartCache.
+var testChartKey, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+// testRegistry is an in-process OCI registry serving a single repository.
+type testRegistry struct {
+	srv        *httptest.Server
+	repository string
+	manifests  map[string][]byte
+	blobs      map[string][]byte
+	token      string
+}
+
+func newTestRegistry(repository string) *testRegistry {
+	r := &testRegistry{repository: repository, manifests: make(map[string][]byte), blobs: make(map[string][]byte)}
+	r.srv = httptest.NewServer(http.HandlerFunc(r.serve))
+	return r
+}
+
+// push stores chart under tag, signed by testChartKey, and returns the manifest digest.
+func (r *testRegistry) push(tag string, chart []byte) string {
+	digest := r.pushArtifact(tag, helmChartContentMediaType, chart)
+	r.pushSignature(digest, signChart(chart))
+	return digest
+}
+
+// pushSignature stores sig as the signature of the package with the manifest digest.
+func (r *testRegistry) pushSignature(digest string, sig []byte) {
+	r.pushArtifact(strings.Replace(digest, ":", "-", 1)+SignatureFileSuffix, "application/octet-stream", sig)
+}
+
+// pushArtifact stores an artifact with a single layer under tag and returns the manifest digest.
+func (r *testRegistry) pushArtifact(tag, mediaType string, data []byte) string {
+	layerDigest := "sha256:" + sha256Hex(data)
+	r.blobs[layerDigest] = data
+	m, _ := json.Marshal(&ociManifest{
+		SchemaVersion: 2,
+		Config:        ociDescriptor{MediaType: "application/vnd.cncf.helm.config.v1+json", Digest: "sha256:0"},
+		Layers:        []ociDescriptor{{MediaType: mediaType, Digest: layerDigest, Size: int64(len(data))}},
+	})
+	digest := "sha256:" + sha256Hex(m)
+	r.manifests[tag], r.manifests[digest] = m, m
+	return digest
+}
+
+func (r *testRegistry) ref(reference string) string {
+	sep := ":"
+	if strings.HasPrefix(reference, "sha256:") {
+		sep = "@"
+	}
+	return OCIScheme + strings.TrimPrefix(r.srv.URL, "http://") + "/" + r.repository + sep + reference
+}
+
+func (r *testRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		fmt.Fprintf(w, `{"token": %q}`, r.token)
+		return
+	}
+	if r.token != "" && req.Header.Get("Authorization") != "Bearer "+r.token {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test",scope="repository:%s:pull"`,
+			r.srv.URL, r.repository))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	prefix := "/v2/" + r.repository + "/"
+	p := strings.TrimPrefix(req.URL.Path, prefix)
+	var data []byte
+	switch {
+	case strings.HasPrefix(p, "manifests/"):
+		data = r.manifests[strings.TrimPrefix(p, "manifests/")]
+	case strings.HasPrefix(p, "blobs/"):
+		data = r.blobs[strings.TrimPrefix(p, "blobs/")]
+	}
+	if data == nil {
+		http.NotFound(w, req)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+func TestFetchChartsOCI(t *testing.T) {
+	defer setupChartCache(t)()
+	reg := newTestRegistry("istio/installer")
+	defer reg.srv.Close()
+	reg.token = "secret"
+	digest := reg.push("1.4.0", mustChartArchive(t, "istio-installer", "1.4.0"))
+
+	root, err := FetchCharts(reg.ref("1.4.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkChartVersion(t, root, "1.4.0")
+
+	// A digest pinned reference is served from the cache without contacting the registry.
+	reg.srv.Close()
+	root, err = FetchCharts(reg.ref(digest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkChartVersion(t, root, "1.4.0")
+}
+
+func TestFetchChartsCacheTrust(t *testing.T) {
+	defer setupChartCache(t)()
+	reg := newTestRegistry("istio/installer")
+	defer reg.srv.Close()
+	chart := mustChartArchive(t, "istio-installer", "1.4.0")
+	digest := reg.pushArtifact("1.4.0", helmChartContentMediaType, chart)
+	reg.pushSignature(digest, signChart([]byte("other package")))
+
+	// An entry planted in the cache is not used without its marker, so the package is still verified.
+	planted := filepath.Join(ChartCacheDir, strings.Replace(digest, ":", "-", 1), "istio-installer")
+	if err := os.MkdirAll(planted, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FetchCharts(reg.ref("1.4.0")); err == nil || !strings.Contains(err.Error(), "signature verification") {
+		t.Fatalf("got error %v, want signature verification error", err)
+	}
+
+	// A verified package replaces the planted entry.
+	reg.pushSignature(digest, signChart(chart))
+	root, err := FetchCharts(reg.ref("1.4.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkChartVersion(t, root, "1.4.0")
+
+	// A cache directory other users have access to is refused.
+	if err := os.Chmod(ChartCacheDir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	reg.push("1.4.1", mustChartArchive(t, "istio-installer", "1.4.1"))
+	if _, err := FetchCharts(reg.ref("1.4.1")); err == nil || !strings.Contains(err.Error(), "accessible by other users") {
+		t.Errorf("got error %v, want the shared cache directory refused", err)
+	}
+}
+
+func TestFetchChartsOCIDigestMismatch(t *testing.T) {
+	defer setupChartCache(t)()
+	reg := newTestRegistry("istio/installer")
+	defer reg.srv.Close()
+	reg.push("1.4.0", mustChartArchive(t, "istio-installer", "1.4.0"))
+	for d := range reg.blobs {
+		reg.blobs[d] = mustChartArchive(t, "istio-installer", "9.9.9")
+	}
+	if _, err := FetchCharts(reg.ref("1.4.0")); err == nil || !strings.Contains(err.Error(), "digest mismatch") {
+		t.Errorf("got error %v, want digest mismatch", err)
+	}
+}
+
+func TestFetchChartsOCISignature(t *testing.T) {
+	chart := mustChartArchive(t, "istio-installer", "1.4.0")
+	tests := []struct {
+		desc     string
+		sig      []byte
+		insecure bool
+		noKeys   bool
+		wantErr  string
+	}{
+		{
+			desc:    "unsigned",
+			wantErr: "refusing unsigned package",
+		},
+		{
+			desc:    "mis-signed",
+			sig:     signChart([]byte("other package")),
+			wantErr: "signature verification",
+		},
+		{
+			desc:     "unsigned insecure",
+			insecure: true,
+		},
+		{
+			desc:   "unsigned without keys",
+			noKeys: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			defer setupChartCache(t)()
+			reg := newTestRegistry("istio/installer")
+			defer reg.srv.Close()
+			digest := reg.pushArtifact("1.4.0", helmChartContentMediaType, chart)
+			if tt.sig != nil {
+				reg.pushSignature(digest, tt.sig)
+			}
+			if tt.insecure {
+				SetChartVerifier(&SignatureVerifier{insecure: true})
+			}
+			if tt.noKeys {
+				SetChartVerifier(&SignatureVerifier{})
+			}
+
+			root, err := FetchCharts(reg.ref("1.4.0"))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %s", err, tt.wantErr)
+				}
+				// Packages which failed verification are not extracted to the cache.
+				if entries, _ := ioutil.ReadDir(ChartCacheDir); len(entries) != 0 {
+					t.Errorf("got %d cache entries, want none", len(entries))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkChartVersion(t, root, "1.4.0")
+			if !strings.Contains(root, "unverified-sha256-") {
+				t.Errorf("got unverified package cached in %s, want it apart from verified packages", root)
+			}
+		})
+	}
+}
+
+func TestFetchChartsRepoIndex(t *testing.T) {
+	versions := []string{"1.3.5", "1.4.0", "1.4.2", "1.5.0-beta.1"}
+	digests := make(map[string]string)
+	dir, err := ioutil.TempDir("", "chart-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var index strings.Builder
+	index.WriteString("apiVersion: v1\nentries:\n  istio-installer:\n")
+	for _, v := range versions {
+		data := mustChartArchive(t, "istio-installer", v)
+		digests[v] = sha256Hex(data)
+		fn := fmt.Sprintf("istio-installer-%s.tgz", v)
+		if err := ioutil.WriteFile(filepath.Join(dir, fn), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, fn+SignatureFileSuffix), signChart(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&index, "  - name: istio-installer\n    version: %s\n    digest: %s\n    urls:\n    - %s\n", v,
+			digests[v], fn)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, RepoIndexFile), []byte(index.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer srv.Close()
+	indexURL := srv.URL + "/" + RepoIndexFile
+
+	tests := []struct {
+		desc        string
+		query       string
+		wantVersion string
+		wantErr     string
+	}{
+		{
+			desc:        "latest",
+			wantVersion: "1.4.2",
+		},
+		{
+			desc:        "exact version",
+			query:       "?chart=istio-installer&version=1.3.5",
+			wantVersion: "1.3.5",
+		},
+		{
+			desc:        "version constraint",
+			query:       "?version=~1.4.0",
+			wantVersion: "1.4.2",
+		},
+		{
+			desc:        "minor version constraint",
+			query:       "?version=~1.3",
+			wantVersion: "1.3.5",
+		},
+		{
+			desc:        "major version constraint",
+			query:       "?version=~1",
+			wantVersion: "1.4.2",
+		},
+		{
+			desc:        "go-version constraint",
+			query:       "?version=>=1.3,<1.4",
+			wantVersion: "1.3.5",
+		},
+		{
+			desc:        "pinned digest",
+			query:       "?digest=sha256:" + digests["1.4.0"],
+			wantVersion: "1.4.0",
+		},
+		{
+			desc:    "unknown digest",
+			query:   "?digest=sha256:" + strings.Repeat("0", 64),
+			wantErr: "has digest",
+		},
+		{
+			desc:    "unknown chart",
+			query:   "?chart=other",
+			wantErr: "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			defer setupChartCache(t)()
+			ref := indexURL + tt.query
+			if !IsChartSourceRef(ref) {
+				t.Fatalf("%s is not a chart source reference", ref)
+			}
+			root, err := FetchCharts(ref)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkChartVersion(t, root, tt.wantVersion)
+		})
+	}
+}
+
+func TestParseOCIRef(t *testing.T) {
+	digest := strings.Repeat("a", 64)
+	tests := []struct {
+		ref     string
+		want    ociRef
+		wantErr bool
+	}{
+		{
+			ref:  "oci://registry.example.com/istio/installer:1.4.0",
+			want: ociRef{host: "registry.example.com", repository: "istio/installer", reference: "1.4.0"},
+		},
+		{
+			ref:  "oci://localhost:5000/installer",
+			want: ociRef{host: "localhost:5000", repository: "installer", reference: "latest"},
+		},
+		{
+			ref: "oci://registry.example.com/installer:1.4.0@sha256:" + digest,
+			want: ociRef{host: "registry.example.com", repository: "installer", reference: "sha256:" + digest,
+				digest: digest},
+		},
+		{
+			ref:     "oci://registry.example.com/installer@md5:abc",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := parseOCIRef(tt.ref)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+// setupChartCache points the chart cache to a new temporary directory, clears resolved references and trusts
+// testChartKey. It returns a function restoring the previous cache and verifier.
+func setupChartCache(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "chart-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := ChartCacheDir
+	ChartCacheDir = dir
+	resolvedMu.Lock()
+	resolved = make(map[string]resolvedRef)
+	resolvedMu.Unlock()
+	chartVerifierMu.RLock()
+	oldVerifier := chartVerifier
+	chartVerifierMu.RUnlock()
+	SetChartVerifier(&SignatureVerifier{keys: []crypto.PublicKey{testChartKey.Public()}})
+	return func() {
+		ChartCacheDir = old
+		SetChartVerifier(oldVerifier)
+		os.RemoveAll(dir)
+	}
+}
+
+// signChart returns the signature of chart by testChartKey.
+func signChart(chart []byte) []byte {
+	digest := sha256.Sum256(chart)
+	sig, _ := testChartKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+	return sig
+}
+
+// mustChartArchive returns a gzipped tar archive of a chart with the given name and version.
+func mustChartArchive(t *testing.T, name, version string) []byte {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	chart := []byte(fmt.Sprintf("name: %s\nversion: %s\n", name, version))
+	files := map[string][]byte{
+		name + "/Chart.yaml":           chart,
+		name + "/base/Chart.yaml":      []byte("name: base\nversion: " + version + "\n"),
+		name + "/base/templates/.keep": nil,
+	}
+	for fn, data := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: fn, Mode: 0644, Size: int64(len(data))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func checkChartVersion(t *testing.T, root, version string) {
+	t.Helper()
+	b, err := ioutil.ReadFile(filepath.Join(root, "base", "Chart.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "version: " + version; !strings.Contains(string(b), want) {
+		t.Errorf("got chart %q, want %s", b, want)
+	}
+}
@@ -54,13 +54,20 @@ type TemplateRenderer interface {
 
 // NewHelmRenderer creates a new helm renderer with the given parameters and returns an interface to it.
 // The format of helmBaseDir and profile strings determines the type of helm renderer returned (compiled-in, file,
-// HTTP etc.)
+// HTTP etc.). Charts in remote chart sources, like OCI registries and Helm repositories, are fetched to a local cache
+// and rendered from there.
 func NewHelmRenderer(chartsRootDir, helmBaseDir, componentName, namespace string) (TemplateRenderer, error) {
 	// filepath would remove leading slash here if chartsRootDir is empty.
 	dir := chartsRootDir + "/" + helmBaseDir
 	switch {
 	case chartsRootDir == "":
 		return NewVFSRenderer(helmBaseDir, componentName, namespace), nil
+	case IsChartSourceRef(chartsRootDir):
+		root, err := FetchCharts(chartsRootDir)
+		if err != nil {
+			return nil, err
+		}
+		return NewFileTemplateRenderer(root+"/"+helmBaseDir, componentName, namespace), nil
 	case util.IsFilePath(dir):
 		return NewFileTemplateRenderer(dir, componentName, namespace), nil
 	default:
@@ -0,0 +1,320 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/docker/distribution/reference"
+)
+
+const (
+	// OCIScheme is the scheme of references to installation packages in OCI registries, e.g.
+	// oci://registry.example.com/istio/installer:1.4.0 or oci://registry.example.com/istio/installer@sha256:<digest>.
+	OCIScheme = "oci://"
+
+	ociManifestMediaType        = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType     = "application/vnd.docker.distribution.manifest.v2+json"
+	helmChartContentMediaType   = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	legacyChartContentMediaType = "application/tar+gzip"
+	ociLayerMediaType           = "application/vnd.oci.image.layer.v1.tar+gzip"
+
+	ociRequestTimeout = 5 * time.Minute
+)
+
+var challengeParamRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// OCIChartSource fetches installation packages stored as a chart artifact, or a single layer image, in an OCI
+// registry. Anonymous and token authenticated pulls are supported.
+type OCIChartSource struct {
+	client *http.Client
+}
+
+var _ ChartSource = &OCIChartSource{}
+
+// NewOCIChartSource returns a new OCIChartSource.
+func NewOCIChartSource() *OCIChartSource {
+	return &OCIChartSource{
+		client: &http.Client{
+			Timeout:   ociRequestTimeout,
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+		},
+	}
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+type ociManifest struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	MediaType     string          `json:"mediaType,omitempty"`
+	Config        ociDescriptor   `json:"config"`
+	Layers        []ociDescriptor `json:"layers"`
+}
+
+// ociRef is a parsed OCI reference.
+type ociRef struct {
+	host       string
+	repository string
+	// reference is the tag or the digest.
+	reference string
+	// digest is the hex manifest digest, if the reference is pinned to one.
+	digest string
+}
+
+// Supports implements ChartSource.
+func (s *OCIChartSource) Supports(ref string) bool {
+	return strings.HasPrefix(ref, OCIScheme)
+}
+
+// Resolve implements ChartSource. It returns the digest of the manifest.
+func (s *OCIChartSource) Resolve(ref string) (string, error) {
+	r, err := parseOCIRef(ref)
+	if err != nil {
+		return "", err
+	}
+	if r.digest != "" {
+		return r.digest, nil
+	}
+	body, err := s.get(r, "manifests/"+r.reference, ociManifestMediaType+", "+dockerManifestMediaType)
+	if err != nil {
+		return "", err
+	}
+	return sha256Hex(body), nil
+}
+
+// Fetch implements ChartSource.
+func (s *OCIChartSource) Fetch(ref, digest, dir string) (string, error) {
+	r, err := parseOCIRef(ref)
+	if err != nil {
+		return "", err
+	}
+	body, err := s.get(r, "manifests/sha256:"+digest, ociManifestMediaType+", "+dockerManifestMediaType)
+	if err != nil {
+		return "", err
+	}
+	if err := verifyDigest(body, digest); err != nil {
+		return "", fmt.Errorf("manifest %s: %s", ref, err)
+	}
+	m := &ociManifest{}
+	if err := json.Unmarshal(body, m); err != nil {
+		return "", fmt.Errorf("could not parse manifest %s: %s", ref, err)
+	}
+	layer, err := chartLayer(m)
+	if err != nil {
+		return "", fmt.Errorf("manifest %s: %s", ref, err)
+	}
+	layerDigest, err := parseDigest(layer.Digest)
+	if err != nil {
+		return "", err
+	}
+	data, err := s.get(r, "blobs/"+layer.Digest, "")
+	if err != nil {
+		return "", err
+	}
+	if err := verifyDigest(data, layerDigest); err != nil {
+		return "", fmt.Errorf("layer %s: %s", layer.Digest, err)
+	}
+	saved := filepath.Join(dir, "chart.tgz")
+	return saved, ioutil.WriteFile(saved, data, 0644)
+}
+
+// Signature implements ChartSource. Following the cosign tag convention, the signature of the chart archive is the
+// single layer of the artifact tagged sha256-<manifest digest>.sig in the repository of ref.
+func (s *OCIChartSource) Signature(ref, digest string) ([]byte, error) {
+	r, err := parseOCIRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	body, err := s.get(r, "manifests/sha256-"+digest+SignatureFileSuffix, ociManifestMediaType+", "+dockerManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	m := &ociManifest{}
+	if err := json.Unmarshal(body, m); err != nil {
+		return nil, fmt.Errorf("could not parse signature manifest of %s: %s", ref, err)
+	}
+	if len(m.Layers) != 1 {
+		return nil, fmt.Errorf("signature manifest of %s has %d layers, want 1", ref, len(m.Layers))
+	}
+	layerDigest, err := parseDigest(m.Layers[0].Digest)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.get(r, "blobs/"+m.Layers[0].Digest, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDigest(data, layerDigest); err != nil {
+		return nil, fmt.Errorf("signature layer %s: %s", m.Layers[0].Digest, err)
+	}
+	return data, nil
+}
+
+// chartLayer returns the layer of m holding the chart archive.
+func chartLayer(m *ociManifest) (*ociDescriptor, error) {
+	for i, l := range m.Layers {
+		switch l.MediaType {
+		case helmChartContentMediaType, legacyChartContentMediaType:
+			return &m.Layers[i], nil
+		}
+	}
+	if len(m.Layers) == 1 && m.Layers[0].MediaType == ociLayerMediaType {
+		return &m.Layers[0], nil
+	}
+	return nil, fmt.Errorf("no chart layer found in %d layers", len(m.Layers))
+}
+
+// get sends a GET request for the given path under the repository of r, authenticating with a bearer token if the
+// registry requires one.
+func (s *OCIChartSource) get(r *ociRef, path, accept string) ([]byte, error) {
+	u := fmt.Sprintf("%s://%s/v2/%s/%s", registryScheme(r.host), r.host, r.repository, path)
+	resp, err := s.do(u, accept, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		token, err := s.token(challenge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to authenticate to %s: %s", r.host, err)
+		}
+		if resp, err = s.do(u, accept, token); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch URL %s : %s", u, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (s *OCIChartSource) do(u, accept, token string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return s.client.Do(req)
+}
+
+// token requests an anonymous bearer token as described by the WWW-Authenticate challenge.
+func (s *OCIChartSource) token(challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+	params := parseChallenge(strings.TrimPrefix(challenge, "Bearer "))
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid realm in authentication challenge %q", challenge)
+	}
+	q := realm.Query()
+	for _, k := range []string{"service", "scope"} {
+		if v := params[k]; v != "" {
+			q.Set(k, v)
+		}
+	}
+	realm.RawQuery = q.Encode()
+	resp, err := s.do(realm.String(), "", "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request to %s failed: %s", realm.Host, resp.Status)
+	}
+	t := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return "", err
+	}
+	if t.Token == "" {
+		return t.AccessToken, nil
+	}
+	return t.Token, nil
+}
+
+// parseChallenge parses the key="value" parameters of an authentication challenge.
+func parseChallenge(s string) map[string]string {
+	out := make(map[string]string)
+	for _, m := range challengeParamRegexp.FindAllStringSubmatch(s, -1) {
+		out[m[1]] = m[2]
+	}
+	return out
+}
+
+// parseOCIRef parses an oci:// reference. References without a tag or digest refer to the latest tag.
+func parseOCIRef(ref string) (*ociRef, error) {
+	parsed, err := reference.Parse(strings.TrimPrefix(ref, OCIScheme))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI reference %s: %s", ref, err)
+	}
+	named, ok := parsed.(reference.Named)
+	if !ok {
+		return nil, fmt.Errorf("invalid OCI reference %s: no repository", ref)
+	}
+	host, repository := reference.Domain(named), reference.Path(named)
+	if host == "" {
+		return nil, fmt.Errorf("invalid OCI reference %s: no registry host", ref)
+	}
+	out := &ociRef{host: host, repository: repository, reference: "latest"}
+	if t, ok := parsed.(reference.Tagged); ok {
+		out.reference = t.Tag()
+	}
+	if d, ok := parsed.(reference.Digested); ok {
+		if out.digest, err = parseDigest(d.Digest().String()); err != nil {
+			return nil, err
+		}
+		out.reference = "sha256:" + out.digest
+	}
+	return out, nil
+}
+
+// registryScheme returns the scheme used to connect to the registry host. Registries on the loopback interface are
+// accessed with plain HTTP, all others with HTTPS.
+func registryScheme(host string) string {
+	h, _, err := net.SplitHostPort(host)
+	if err != nil {
+		h = host
+	}
+	if h == "localhost" {
+		return "http"
+	}
+	if ip := net.ParseIP(h); ip != nil && ip.IsLoopback() {
+		return "http"
+	}
+	return "https"
+}
@@ -0,0 +1,249 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/operator/pkg/httprequest"
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// RepoIndexFile is the name of the index file of a Helm chart repository.
+	RepoIndexFile = "index.yaml"
+	// DefaultInstallerChartName is the chart fetched from a Helm repository if the reference does not name one.
+	DefaultInstallerChartName = "istio-installer"
+
+	// Query parameters of Helm repository references.
+	repoChartParam   = "chart"
+	repoVersionParam = "version"
+	repoDigestParam  = "digest"
+)
+
+// RepoIndexChartSource fetches installation packages published as a chart in a Helm chart repository. References are
+// URLs of the repository index with optional query parameters, e.g.
+// https://charts.example.com/index.yaml?chart=istio-installer&version=1.4.0&digest=sha256:<digest>.
+// The version may be a version constraint like ~1.4, the highest matching version is used. If the version is not
+// set, the highest version which is not a pre-release is used. If the digest is set, only the chart archive with that digest is accepted and
+// cached copies are used without fetching the index.
+type RepoIndexChartSource struct{}
+
+var _ ChartSource = &RepoIndexChartSource{}
+
+// NewRepoIndexChartSource returns a new RepoIndexChartSource.
+func NewRepoIndexChartSource() *RepoIndexChartSource {
+	return &RepoIndexChartSource{}
+}
+
+// repoIndex is the subset of a Helm repository index used to fetch charts.
+type repoIndex struct {
+	APIVersion string                       `json:"apiVersion"`
+	Entries    map[string][]*repoIndexEntry `json:"entries"`
+}
+
+type repoIndexEntry struct {
+	Name    string   `json:"name"`
+	Version string   `json:"version"`
+	Digest  string   `json:"digest"`
+	URLs    []string `json:"urls"`
+}
+
+// repoRef is a parsed Helm repository reference.
+type repoRef struct {
+	indexURL *url.URL
+	chart    string
+	version  string
+	// digest is the hex chart archive digest, if the reference is pinned to one.
+	digest string
+}
+
+// Supports implements ChartSource.
+func (s *RepoIndexChartSource) Supports(ref string) bool {
+	u, err := url.Parse(ref)
+	return err == nil && util.IsHTTPURL(ref) && path.Base(u.Path) == RepoIndexFile
+}
+
+// Resolve implements ChartSource. It returns the digest of the chart archive.
+func (s *RepoIndexChartSource) Resolve(ref string) (string, error) {
+	r, err := parseRepoRef(ref)
+	if err != nil {
+		return "", err
+	}
+	if r.digest != "" {
+		return r.digest, nil
+	}
+	e, err := r.findEntry("")
+	if err != nil {
+		return "", err
+	}
+	return parseDigest(e.Digest)
+}
+
+// Fetch implements ChartSource.
+func (s *RepoIndexChartSource) Fetch(ref, digest, dir string) (string, error) {
+	chartURL, err := archiveURL(ref, digest)
+	if err != nil {
+		return "", err
+	}
+	data, err := httprequest.Get(chartURL.String())
+	if err != nil {
+		return "", err
+	}
+	if err := verifyDigest(data, digest); err != nil {
+		return "", fmt.Errorf("chart %s: %s", chartURL, err)
+	}
+	saved := filepath.Join(dir, path.Base(chartURL.Path))
+	return saved, ioutil.WriteFile(saved, data, 0644)
+}
+
+// Signature implements ChartSource. The signature is stored next to the chart archive, in a file with the archive URL
+// and SignatureFileSuffix.
+func (s *RepoIndexChartSource) Signature(ref, digest string) ([]byte, error) {
+	chartURL, err := archiveURL(ref, digest)
+	if err != nil {
+		return nil, err
+	}
+	return httprequest.Get(chartURL.String() + SignatureFileSuffix)
+}
+
+// archiveURL returns the URL of the chart archive with the given digest in the repository referenced by ref.
+func archiveURL(ref, digest string) (*url.URL, error) {
+	r, err := parseRepoRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	e, err := r.findEntry(digest)
+	if err != nil {
+		return nil, err
+	}
+	if len(e.URLs) == 0 {
+		return nil, fmt.Errorf("chart %s %s in %s has no URLs", e.Name, e.Version, r.indexURL)
+	}
+	chartURL, err := r.indexURL.Parse(e.URLs[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %s for chart %s %s: %s", e.URLs[0], e.Name, e.Version, err)
+	}
+	return chartURL, nil
+}
+
+// findEntry fetches the index and returns the entry of the referenced chart. If digest is set, the entry with that
+// digest is returned.
+func (r *repoRef) findEntry(digest string) (*repoIndexEntry, error) {
+	data, err := httprequest.Get(r.indexURL.String())
+	if err != nil {
+		return nil, err
+	}
+	idx := &repoIndex{}
+	if err := yaml.Unmarshal(data, idx); err != nil {
+		return nil, fmt.Errorf("could not parse repository index %s: %s", r.indexURL, err)
+	}
+	entries := idx.Entries[r.chart]
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("chart %s not found in %s", r.chart, r.indexURL)
+	}
+
+	var constraints goversion.Constraints
+	if r.version != "" {
+		if constraints, err = versionConstraints(r.version); err != nil {
+			return nil, fmt.Errorf("invalid chart version %s: %s", r.version, err)
+		}
+	}
+	var best *repoIndexEntry
+	var bestVersion *goversion.Version
+	for _, e := range entries {
+		if digest != "" {
+			if d, err := parseDigest(e.Digest); err == nil && d == digest {
+				return e, nil
+			}
+			continue
+		}
+		v, err := goversion.NewVersion(e.Version)
+		if err != nil || constraints != nil && !constraints.Check(v) || constraints == nil && v.Prerelease() != "" {
+			continue
+		}
+		if best == nil || v.GreaterThan(bestVersion) {
+			best, bestVersion = e, v
+		}
+	}
+	switch {
+	case digest != "":
+		return nil, fmt.Errorf("no version of chart %s in %s has digest sha256:%s", r.chart, r.indexURL, digest)
+	case best == nil:
+		return nil, fmt.Errorf("no version of chart %s in %s matches %q", r.chart, r.indexURL, r.version)
+	case best.Digest == "":
+		return nil, fmt.Errorf("chart %s %s in %s has no digest and cannot be verified", best.Name, best.Version,
+			r.indexURL)
+	}
+	return best, nil
+}
+
+// versionConstraints parses a chart version constraint. Besides the go-version syntax, Helm tilde ranges are
+// supported: ~1.4 and ~1.4.2 match the 1.4 patch releases from the given one, ~1 matches the 1.x releases.
+func versionConstraints(c string) (goversion.Constraints, error) {
+	parts := strings.Split(c, ",")
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		if !strings.HasPrefix(p, "~") || strings.HasPrefix(p, "~>") {
+			continue
+		}
+		v, err := goversion.NewVersion(strings.TrimSpace(p[1:]))
+		if err != nil {
+			return nil, err
+		}
+		s := v.Segments()
+		upper := fmt.Sprintf("%d.%d.0", s[0], s[1]+1)
+		if core := strings.SplitN(strings.SplitN(p[1:], "-", 2)[0], "+", 2)[0]; !strings.Contains(core, ".") {
+			upper = fmt.Sprintf("%d.0.0", s[0]+1)
+		}
+		parts[i] = fmt.Sprintf(">= %s, < %s", v, upper)
+	}
+	return goversion.NewConstraint(strings.Join(parts, ","))
+}
+
+// parseRepoRef parses a Helm repository reference.
+func parseRepoRef(ref string) (*repoRef, error) {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository reference %s: %s", ref, err)
+	}
+	q := u.Query()
+	out := &repoRef{
+		chart:   q.Get(repoChartParam),
+		version: q.Get(repoVersionParam),
+	}
+	if out.chart == "" {
+		out.chart = DefaultInstallerChartName
+	}
+	if d := q.Get(repoDigestParam); d != "" {
+		if out.digest, err = parseDigest(d); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range []string{repoChartParam, repoVersionParam, repoDigestParam} {
+		q.Del(p)
+	}
+	u.RawQuery = q.Encode()
+	out.indexURL = u
+	return out, nil
+}
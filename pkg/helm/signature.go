@@ -0,0 +1,189 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"istio.io/operator/pkg/httprequest"
+	"istio.io/pkg/log"
+)
+
+const (
+	// SignatureFileSuffix is the suffix of the detached signature file of an installation package.
+	SignatureFileSuffix = ".sig"
+)
+
+// SignatureVerifier verifies detached signatures of installation packages against a set of trusted public keys.
+// Signatures are made over the package archive with an ECDSA, RSA (PKCS #1 v1.5) or Ed25519 key, using SHA256 for
+// ECDSA and RSA, and stored raw or base64 encoded in a file with the package URL and SignatureFileSuffix, which is
+// compatible with cosign sign-blob signatures.
+type SignatureVerifier struct {
+	keys []crypto.PublicKey
+	// insecure skips verification.
+	insecure bool
+}
+
+// NewSignatureVerifier returns a SignatureVerifier trusting the PEM encoded public keys in keysPath, which is a file
+// or a directory of files. Verification is optional: if keysPath is empty or insecure is set, signatures are not
+// verified. Otherwise, packages which are unsigned or not signed by one of the keys are refused.
+func NewSignatureVerifier(keysPath string, insecure bool) (*SignatureVerifier, error) {
+	v := &SignatureVerifier{insecure: insecure}
+	if keysPath == "" {
+		return v, nil
+	}
+	keys, err := LoadPublicKeys(keysPath)
+	if err != nil {
+		return nil, err
+	}
+	v.keys = keys
+	return v, nil
+}
+
+// VerifyURL fetches the detached signature of the package downloaded from pkgURL and verifies the package data
+// against it.
+func (v *SignatureVerifier) VerifyURL(pkgURL string, data []byte) error {
+	return v.verifyPackage(pkgURL, data, func() ([]byte, error) {
+		return httprequest.Get(pkgURL + SignatureFileSuffix)
+	})
+}
+
+// verifyPackage verifies data, the archive of the package ref, against the detached signature returned by
+// signature. signature is not called if verification is skipped.
+func (v *SignatureVerifier) verifyPackage(ref string, data []byte, signature func() ([]byte, error)) error {
+	if v.insecure {
+		log.Warnf("skipping signature verification of %s", ref)
+		return nil
+	}
+	if len(v.keys) == 0 {
+		log.Warnf("not verifying the signature of %s, no signature verification keys are configured", ref)
+		return nil
+	}
+	sig, err := signature()
+	if err != nil {
+		return fmt.Errorf("could not get signature of %s, refusing unsigned package: %s", ref, err)
+	}
+	if err := v.Verify(data, sig); err != nil {
+		return fmt.Errorf("signature verification of %s failed: %s", ref, err)
+	}
+	return nil
+}
+
+// skips reports whether packages are used without verifying their signatures.
+func (v *SignatureVerifier) skips() bool {
+	return v.insecure || len(v.keys) == 0
+}
+
+// Verify returns nil if sig is a valid signature of data by one of the trusted keys.
+func (v *SignatureVerifier) Verify(data, sig []byte) error {
+	if v.insecure {
+		return nil
+	}
+	if len(v.keys) == 0 {
+		return fmt.Errorf("no signature verification keys are configured")
+	}
+	sig = decodeSignature(sig)
+	digest := sha256.Sum256(data)
+	for _, k := range v.keys {
+		if verifyWithKey(k, data, digest[:], sig) {
+			return nil
+		}
+	}
+	return fmt.Errorf("signature does not match any of the %d trusted keys", len(v.keys))
+}
+
+// LoadPublicKeys reads the PEM encoded public keys in path, which is a file or a directory of files.
+func LoadPublicKeys(path string) ([]crypto.PublicKey, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if fi.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(path, "*")); err != nil {
+			return nil, err
+		}
+	}
+	var keys []crypto.PublicKey
+	for _, f := range files {
+		if isDir(f) {
+			continue
+		}
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var block *pem.Block
+			block, b = pem.Decode(b)
+			if block == nil {
+				break
+			}
+			if block.Type != "PUBLIC KEY" {
+				continue
+			}
+			k, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse public key in %s: %s", f, err)
+			}
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no PEM encoded public keys found in %s", path)
+	}
+	return keys, nil
+}
+
+func verifyWithKey(key crypto.PublicKey, data, digest, sig []byte) bool {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		var s struct{ R, S *big.Int }
+		if rest, err := asn1.Unmarshal(sig, &s); err != nil || len(rest) != 0 {
+			return false
+		}
+		return ecdsa.Verify(k, digest, s.R, s.S)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest, sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, data, sig)
+	}
+	return false
+}
+
+// decodeSignature returns the decoded signature if sig is base64 encoded, or else sig.
+func decodeSignature(sig []byte) []byte {
+	trimmed := bytes.TrimSpace(sig)
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(trimmed)))
+	n, err := base64.StdEncoding.Decode(decoded, trimmed)
+	if err != nil {
+		return sig
+	}
+	return decoded[:n]
+}
@@ -0,0 +1,217 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSignatureVerifier(t *testing.T) {
+	data := []byte("installation package")
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	mustNotError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	mustNotError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	mustNotError(t, err)
+	_, untrustedKey, err := ed25519.GenerateKey(rand.Reader)
+	mustNotError(t, err)
+
+	dir, err := ioutil.TempDir("", "verification-keys")
+	mustNotError(t, err)
+	defer os.RemoveAll(dir)
+	writePublicKeys(t, filepath.Join(dir, "ec.pem"), ecKey.Public())
+	writePublicKeys(t, filepath.Join(dir, "others.pem"), rsaKey.Public(), edKey.Public())
+
+	tests := []struct {
+		desc     string
+		keysPath string
+		insecure bool
+		sig      []byte
+		wantErr  bool
+	}{
+		{
+			desc:     "ECDSA base64",
+			keysPath: dir,
+			sig:      []byte(base64.StdEncoding.EncodeToString(sign(t, ecKey, data)) + "\n"),
+		},
+		{
+			desc:     "RSA raw",
+			keysPath: dir,
+			sig:      sign(t, rsaKey, data),
+		},
+		{
+			desc:     "Ed25519 from key file",
+			keysPath: filepath.Join(dir, "others.pem"),
+			sig:      sign(t, edKey, data),
+		},
+		{
+			desc:     "key not in file",
+			keysPath: filepath.Join(dir, "others.pem"),
+			sig:      sign(t, ecKey, data),
+			wantErr:  true,
+		},
+		{
+			desc:     "untrusted key",
+			keysPath: dir,
+			sig:      sign(t, untrustedKey, data),
+			wantErr:  true,
+		},
+		{
+			desc:     "tampered",
+			keysPath: dir,
+			sig:      sign(t, ecKey, []byte("other package")),
+			wantErr:  true,
+		},
+		{
+			desc:    "no keys",
+			sig:     sign(t, ecKey, data),
+			wantErr: true,
+		},
+		{
+			desc:     "insecure",
+			insecure: true,
+			sig:      []byte("garbage"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			v, err := NewSignatureVerifier(tt.keysPath, tt.insecure)
+			mustNotError(t, err)
+			if err := v.Verify(data, tt.sig); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFetchVerifiesSignature(t *testing.T) {
+	const pkg = "istio-installer-1.3.0.tar.gz"
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	mustNotError(t, err)
+	root, err := ioutil.TempDir("", InstallationDirectory)
+	mustNotError(t, err)
+	defer os.RemoveAll(root)
+	keysFile := filepath.Join(root, "keys.pem")
+	writePublicKeys(t, keysFile, key.Public())
+
+	server := NewServer(root)
+	defer server.srv.Close()
+	files, err := server.moveFiles("testdata/" + pkg + "*")
+	mustNotError(t, err)
+	data, err := ioutil.ReadFile(files[0])
+	mustNotError(t, err)
+
+	tests := []struct {
+		desc     string
+		sig      []byte
+		insecure bool
+		noKeys   bool
+		wantErr  bool
+	}{
+		{
+			desc: "signed",
+			sig:  sign(t, key, data),
+		},
+		{
+			desc:    "unsigned",
+			wantErr: true,
+		},
+		{
+			desc:     "unsigned insecure",
+			insecure: true,
+		},
+		{
+			desc:   "unsigned without keys",
+			noKeys: true,
+		},
+		{
+			desc:    "mis-signed",
+			sig:     sign(t, key, []byte("other package")),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			sigFile := filepath.Join(root, pkg+SignatureFileSuffix)
+			os.Remove(sigFile)
+			if tt.sig != nil {
+				mustNotError(t, ioutil.WriteFile(sigFile, tt.sig, 0644))
+			}
+			outDir := filepath.Join(root, "testout")
+			os.RemoveAll(outDir)
+			mustNotError(t, os.Mkdir(outDir, 0755))
+
+			f, err := NewURLFetcher(server.URL()+"/"+pkg, outDir)
+			mustNotError(t, err)
+			keysPath := keysFile
+			if tt.noKeys {
+				keysPath = ""
+			}
+			v, err := NewSignatureVerifier(keysPath, tt.insecure)
+			mustNotError(t, err)
+			f.SetSignatureVerifier(v)
+			err = f.FetchBundles().ToError()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if _, statErr := os.Stat(filepath.Join(outDir, "istio-installer")); (statErr == nil) == tt.wantErr {
+				t.Errorf("got extracted package %v, want %v", statErr == nil, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func sign(t *testing.T, key crypto.Signer, data []byte) []byte {
+	if _, ok := key.(ed25519.PrivateKey); ok {
+		sig, err := key.Sign(rand.Reader, data, crypto.Hash(0))
+		mustNotError(t, err)
+		return sig
+	}
+	digest := sha256.Sum256(data)
+	sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	mustNotError(t, err)
+	return sig
+}
+
+func writePublicKeys(t *testing.T, path string, keys ...crypto.PublicKey) {
+	var out []byte
+	for _, k := range keys {
+		b, err := x509.MarshalPKIXPublicKey(k)
+		mustNotError(t, err)
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b})...)
+	}
+	mustNotError(t, ioutil.WriteFile(path, out, 0644))
+}
+
+func mustNotError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
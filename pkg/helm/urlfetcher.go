@@ -56,6 +56,8 @@ type URLFetcher struct {
 	verify bool
 	// destDir is path of charts downloaded to, empty as default to temp dir
 	destDir string
+	// verifier verifies the signature of the downloaded tar, if set
+	verifier *SignatureVerifier
 }
 
 // NewURLFetcher creates an URLFetcher pointing to installation package URL and destination,
@@ -79,11 +81,26 @@ func NewURLFetcher(insPackageURL string, destDir string) (*URLFetcher, error) {
 	return uf, nil
 }
 
+// SetSignatureVerifier sets the verifier used to verify the signature of downloaded installation packages.
+func (f *URLFetcher) SetSignatureVerifier(v *SignatureVerifier) {
+	f.verifier = v
+}
+
 // DestDir returns path of destination dir.
 func (f *URLFetcher) DestDir() string {
 	return f.destDir
 }
 
+// PackageDir returns the path the installation package is extracted to, e.g. DestDir/istio-1.3.0 for
+// istio-1.3.0-linux.tar.gz.
+func (f *URLFetcher) PackageDir() string {
+	name := path.Base(f.url)
+	if idx := strings.LastIndex(name, "-"); idx > 0 {
+		name = name[:idx]
+	}
+	return filepath.Join(f.destDir, name)
+}
+
 // FetchBundles fetches the charts, sha and version file
 func (f *URLFetcher) FetchBundles() util.Errors {
 	errs := util.Errors{}
@@ -91,7 +108,7 @@ func (f *URLFetcher) FetchBundles() util.Errors {
 	fn := path.Base(f.url)
 	_, err := os.Stat(filepath.Join(f.destDir, fn))
 	if err == nil {
-		return errs
+		return util.AppendErr(errs, f.verifySignature(filepath.Join(f.destDir, fn)))
 	}
 	shaF, err := f.fetchSha()
 	errs = util.AppendErr(errs, err)
@@ -134,10 +151,26 @@ func (f *URLFetcher) fetchChart(shaF string) error {
 			return fmt.Errorf("checksum of charts file located at: %s does not match expected SHA file: %s", saved, shaF)
 		}
 	}
+	if err := f.verifySignature(saved); err != nil {
+		os.Remove(saved)
+		return err
+	}
 	targz := archiver.TarGz{Tar: &archiver.Tar{OverwriteExisting: true}}
 	return targz.Unarchive(saved, f.destDir)
 }
 
+// verifySignature verifies the signature of the downloaded tar at saved, if a verifier is set.
+func (f *URLFetcher) verifySignature(saved string) error {
+	if f.verifier == nil {
+		return nil
+	}
+	data, err := ioutil.ReadFile(saved)
+	if err != nil {
+		return err
+	}
+	return f.verifier.VerifyURL(f.url, data)
+}
+
 // fetchsha downloads the SHA file from url
 func (f *URLFetcher) fetchSha() (string, error) {
 	if f.verifyURL == "" {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -52,8 +53,11 @@ func (p *URLPoller) checkUpdate() (bool, error) {
 	newHash := strings.Fields(string(hashAll))[0]
 
 	if !strings.EqualFold(newHash, p.existingHash) {
+		if err := uf.fetchChart(shaF); err != nil {
+			return false, err
+		}
 		p.existingHash = newHash
-		return true, uf.fetchChart(shaF)
+		return true, nil
 	}
 	return false, nil
 }
@@ -72,35 +76,43 @@ func (p *URLPoller) poll(notify chan<- struct{}) {
 	}
 }
 
-// NewPoller returns a poller pointing to given url with specified interval
-func NewPoller(installationURL string, destDir string, interval time.Duration) (*URLPoller, error) {
+// NewPoller returns a poller pointing to given url, which is checked for updates every interval. interval is a
+// duration, e.g. 5 * time.Minute, not a number of minutes. Fetched installation packages are verified with verifier,
+// if set.
+func NewPoller(installationURL string, destDir string, interval time.Duration, verifier *SignatureVerifier) (*URLPoller, error) {
 	uf, err := NewURLFetcher(installationURL, destDir)
 	if err != nil {
 		return nil, err
 	}
+	uf.SetSignatureVerifier(verifier)
 	return &URLPoller{
 		url:        installationURL,
-		ticker:     time.NewTicker(time.Minute * interval),
+		ticker:     time.NewTicker(interval),
 		urlFetcher: uf,
 	}, nil
 }
 
 //PollURL continuously polls the given url, which points to a directory containing an
-//installation package at the given interval and fetches a new copy if it is updated.
-func PollURL(installationURL string, interval time.Duration) (chan<- struct{}, error) {
+//installation package every interval, a duration, and fetches a new copy if it is updated.
+//Packages which fail signature verification with verifier are not used.
+//It returns the local directory of the charts in the fetched package. A signal is sent on
+//the returned channel each time the package is updated.
+func PollURL(installationURL string, interval time.Duration, verifier *SignatureVerifier) (string, <-chan struct{}, error) {
 	destDir, err := ioutil.TempDir("", InstallationDirectory)
 	if err != nil {
-		log.Error("failed to create temp directory for charts")
-		return nil, err
+		return "", nil, fmt.Errorf("failed to create temp directory for charts: %s", err)
 	}
 
-	po, err := NewPoller(installationURL, destDir, interval)
+	po, err := NewPoller(installationURL, destDir, interval, verifier)
 	if err != nil {
-		log.Fatalf("failed to create new poller for: %s", err)
+		os.RemoveAll(destDir)
+		return "", nil, fmt.Errorf("failed to create new poller for %s: %s", installationURL, err)
+	}
+	// Fetch the current package, so that only later updates are signalled.
+	if _, err := po.checkUpdate(); err != nil {
+		log.Errorf("Error polling charts: %v", err)
 	}
 	updated := make(chan struct{}, 1)
 	go po.poll(updated)
-
-	os.RemoveAll(destDir)
-	return updated, nil
+	return filepath.Join(po.urlFetcher.PackageDir(), ChartsFilePath), updated, nil
 }
@@ -79,6 +79,8 @@ type SimplePruningDetails struct {
 	// resource of 'true' to accelerate the pruning loop
 	NonNamespacedResourceMap map[schema.GroupVersionKind]bool
 	PruningDetailsMU         *sync.Mutex
+	// PrunePolicy is the policy for pruning resources which are no longer rendered.
+	PrunePolicy PrunePolicy
 }
 
 var _ PruningDetails = &SimplePruningDetails{}
@@ -104,6 +106,11 @@ func (m *SimplePruningDetails) GetResourceTypes() (map[schema.GroupVersionKind]b
 	return m.NamespacedResourceMap, m.NonNamespacedResourceMap, m.PruningDetailsMU
 }
 
+// GetPrunePolicy returns this.PrunePolicy
+func (m *SimplePruningDetails) GetPrunePolicy() PrunePolicy {
+	return m.PrunePolicy
+}
+
 // DefaultChartCustomizerFactory is a factory for creating DefaultChartCustomizer objects
 type DefaultChartCustomizerFactory struct {
 	// ChartAnnotationKey is the key used to add an annotation identifying the chart that rendered the resource
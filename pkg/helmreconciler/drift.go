@@ -0,0 +1,225 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"istio.io/operator/pkg/util"
+)
+
+// SpecHashDecorator is a RenderingListener which stores a hash of each rendered resource in an annotation, so that
+// changes made to the resource outside of the operator can be detected with DetectDrift. It must be the last
+// listener to modify resources in BeginResource.
+type SpecHashDecorator struct {
+	*DefaultRenderingListener
+	annotationKey string
+}
+
+var _ RenderingListener = &SpecHashDecorator{}
+
+// NewSpecHashDecorator returns a new SpecHashDecorator which stores the hash in the annotationKey annotation.
+func NewSpecHashDecorator(annotationKey string) *SpecHashDecorator {
+	return &SpecHashDecorator{
+		DefaultRenderingListener: &DefaultRenderingListener{},
+		annotationKey:            annotationKey,
+	}
+}
+
+// BeginResource sets the hash annotation on obj.
+func (d *SpecHashDecorator) BeginResource(chart string, obj runtime.Object) (runtime.Object, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return obj, nil
+	}
+	hash, err := SpecHash(u, d.annotationKey)
+	if err != nil {
+		return obj, err
+	}
+	return obj, util.SetAnnotation(obj, d.annotationKey, hash)
+}
+
+// SpecHash returns a hash of the rendered resource u, ignoring the hash annotation given by annotationKey and the
+// last applied configuration annotation.
+func SpecHash(u *unstructured.Unstructured, annotationKey string) (string, error) {
+	c := u.DeepCopy()
+	annotations := c.GetAnnotations()
+	delete(annotations, annotationKey)
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	c.SetAnnotations(annotations)
+	b, err := json.Marshal(c.Object)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// DetectDrift reports whether the live resource has drifted from the configuration last applied by the operator,
+// and if so, describes the drift. The applied configuration is read from the last applied configuration annotation
+// and verified against the hash stored in the annotationKey annotation, which catches resources reapplied by
+// someone else. Live fields set by the API server or by controllers are ignored, only fields that were rendered are
+// compared. Resources without a hash annotation are never reported as drifted.
+func DetectDrift(live *unstructured.Unstructured, annotationKey string) (bool, string, error) {
+	annotations := live.GetAnnotations()
+	hash, ok := annotations[annotationKey]
+	if !ok {
+		return false, "", nil
+	}
+	applied, ok := annotations[corev1.LastAppliedConfigAnnotation]
+	if !ok {
+		return true, "last applied configuration was removed", nil
+	}
+	desired := &unstructured.Unstructured{}
+	if err := desired.UnmarshalJSON([]byte(applied)); err != nil {
+		return false, "", fmt.Errorf("could not parse last applied configuration of %s %s/%s: %s",
+			live.GetKind(), live.GetNamespace(), live.GetName(), err)
+	}
+	desiredHash, err := SpecHash(desired, annotationKey)
+	if err != nil {
+		return false, "", err
+	}
+	if desiredHash != hash {
+		return true, "last applied configuration was replaced", nil
+	}
+
+	if path := diffPath("metadata.labels", desired.GetLabels(), live.GetLabels()); path != "" {
+		return true, path + " changed", nil
+	}
+	var keys []string
+	for k := range desired.Object {
+		switch k {
+		case "apiVersion", "kind", "metadata", "status":
+		default:
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if path := diffPath(k, desired.Object[k], live.Object[k]); path != "" {
+			return true, path + " changed", nil
+		}
+	}
+	return false, "", nil
+}
+
+// diffPath returns the path of the first field in desired which has a different value in live, or "" if live
+// contains all of desired.
+func diffPath(path string, desired, live interface{}) string {
+	switch d := desired.(type) {
+	case nil:
+		return ""
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			if len(d) == 0 && live == nil {
+				return ""
+			}
+			return path
+		}
+		var keys []string
+		for k := range d {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if p := diffPath(path+"."+k, d[k], l[k]); p != "" {
+				return p
+			}
+		}
+		return ""
+	case map[string]string:
+		m := make(map[string]interface{}, len(d))
+		for k, v := range d {
+			m[k] = v
+		}
+		var l map[string]interface{}
+		if ls, ok := live.(map[string]string); ok {
+			l = make(map[string]interface{}, len(ls))
+			for k, v := range ls {
+				l[k] = v
+			}
+		}
+		return diffPath(path, m, l)
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			if len(d) == 0 && live == nil {
+				return ""
+			}
+			return path
+		}
+		if len(d) != len(l) {
+			return path
+		}
+		for i := range d {
+			if p := diffPath(fmt.Sprintf("%s[%d]", path, i), d[i], l[i]); p != "" {
+				return p
+			}
+		}
+		return ""
+	}
+	if scalarEqual(desired, live) {
+		return ""
+	}
+	return path
+}
+
+// scalarEqual compares scalar values, treating numbers of different types and equivalent resource quantities such as
+// 1024Mi and 1Gi as equal.
+func scalarEqual(desired, live interface{}) bool {
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+	if df, ok := toFloat(desired); ok {
+		lf, ok := toFloat(live)
+		return ok && df == lf
+	}
+	ds, ok1 := desired.(string)
+	ls, ok2 := live.(string)
+	if !ok1 || !ok2 {
+		return false
+	}
+	dq, err1 := resource.ParseQuantity(ds)
+	lq, err2 := resource.ParseQuantity(ls)
+	return err1 == nil && err2 == nil && dq.Cmp(lq) == 0
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
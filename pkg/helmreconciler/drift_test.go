@@ -0,0 +1,150 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubectl "k8s.io/kubectl/pkg/util"
+
+	"istio.io/operator/pkg/object"
+)
+
+const (
+	testHashKey = "install.operator.istio.io/spec-hash"
+
+	driftDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+  labels:
+    app: pilot
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: discovery
+        image: pilot:1.5
+        resources:
+          requests:
+            memory: 2048Mi
+`
+)
+
+func TestDetectDrift(t *testing.T) {
+	tests := []struct {
+		desc      string
+		modify    func(live *unstructured.Unstructured)
+		wantDrift bool
+	}{
+		{
+			desc:   "unchanged",
+			modify: func(live *unstructured.Unstructured) {},
+		},
+		{
+			desc: "server side defaults and status",
+			modify: func(live *unstructured.Unstructured) {
+				_ = unstructured.SetNestedField(live.Object, int64(1), "status", "readyReplicas")
+				_ = unstructured.SetNestedField(live.Object, int64(600), "spec", "progressDeadlineSeconds")
+				containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+				containers[0].(map[string]interface{})["resources"] = map[string]interface{}{
+					"requests": map[string]interface{}{"memory": "2Gi"},
+				}
+				containers[0].(map[string]interface{})["imagePullPolicy"] = "IfNotPresent"
+				_ = unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers")
+			},
+		},
+		{
+			desc: "edited spec",
+			modify: func(live *unstructured.Unstructured) {
+				_ = unstructured.SetNestedField(live.Object, int64(3), "spec", "replicas")
+			},
+			wantDrift: true,
+		},
+		{
+			desc: "edited image",
+			modify: func(live *unstructured.Unstructured) {
+				containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+				containers[0].(map[string]interface{})["image"] = "pilot:debug"
+				_ = unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers")
+			},
+			wantDrift: true,
+		},
+		{
+			desc: "removed label",
+			modify: func(live *unstructured.Unstructured) {
+				live.SetLabels(nil)
+			},
+			wantDrift: true,
+		},
+		{
+			desc: "reapplied by someone else",
+			modify: func(live *unstructured.Unstructured) {
+				_ = unstructured.SetNestedField(live.Object, int64(3), "spec", "replicas")
+				annotations := live.GetAnnotations()
+				delete(annotations, corev1.LastAppliedConfigAnnotation)
+				live.SetAnnotations(annotations)
+				if err := kubectl.CreateApplyAnnotation(live, unstructured.UnstructuredJSONScheme); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantDrift: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			live := renderAndApply(t, driftDeployment)
+			tt.modify(live)
+			got, reason, err := DetectDrift(live, testHashKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.wantDrift {
+				t.Errorf("got drift %v (%s), want %v", got, reason, tt.wantDrift)
+			}
+		})
+	}
+}
+
+func TestDetectDriftWithoutHash(t *testing.T) {
+	o, err := object.ParseYAMLToK8sObject([]byte(driftDeployment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _, err := DetectDrift(o.UnstructuredObject(), testHashKey); got || err != nil {
+		t.Errorf("got drift %v, error %v, want no drift for resources without a hash", got, err)
+	}
+}
+
+// renderAndApply returns the live object for the rendered yml, as it would be processed by HelmReconciler.
+func renderAndApply(t *testing.T, yml string) *unstructured.Unstructured {
+	o, err := object.ParseYAMLToK8sObject([]byte(yml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := o.UnstructuredObject()
+	if _, err := NewSpecHashDecorator(testHashKey).BeginResource("Pilot", u); err != nil {
+		t.Fatal(err)
+	}
+	if err := kubectl.CreateApplyAnnotation(u, unstructured.UnstructuredJSONScheme); err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
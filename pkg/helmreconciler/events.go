@@ -0,0 +1,303 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/pkg/log"
+)
+
+const (
+	// Event reasons.
+	EventReasonReconciling      = "Reconciling"
+	EventReasonReconciled       = "Reconciled"
+	EventReasonProgressing      = "Progressing"
+	EventReasonReconcileError   = "ReconcileError"
+	EventReasonResourcesApplied = "ResourcesApplied"
+	EventReasonResourceError    = "ResourceError"
+	EventReasonPruning          = "Pruning"
+	EventReasonPruned           = "Pruned"
+	EventReasonDeleting         = "Deleting"
+	EventReasonDeleted          = "Deleted"
+	EventReasonDeleteError      = "DeleteError"
+
+	// EventQPS and EventBurst limit the rate of events recorded for one custom resource.
+	EventQPS   = 0.2
+	EventBurst = 20
+	// maxResourceErrorEvents is the maximum number of individual ResourceError events per reconciliation. Further
+	// errors are only counted in the summary event.
+	maxResourceErrorEvents = 5
+)
+
+// EventLimiter rate limits the events recorded for one custom resource. A new EventRecordingListener is created for
+// every reconciliation, so the EventLimiter must outlive it and be shared by all the listeners of the resource.
+type EventLimiter struct {
+	rateLimiter flowcontrol.RateLimiter
+
+	// mu protects dropped.
+	mu sync.Mutex
+	// dropped is the number of events dropped by the rate limiter since the last recorded event.
+	dropped int
+}
+
+// NewEventLimiter returns a new EventLimiter allowing EventBurst events, then EventQPS events per second.
+func NewEventLimiter() *EventLimiter {
+	return &EventLimiter{rateLimiter: flowcontrol.NewTokenBucketRateLimiter(EventQPS, EventBurst)}
+}
+
+// accept returns whether an event may be recorded and, if so, the number of events dropped since the last one.
+func (e *EventLimiter) accept() (bool, int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.rateLimiter.TryAccept() {
+		e.dropped++
+		return false, 0
+	}
+	dropped := e.dropped
+	e.dropped = 0
+	return true, dropped
+}
+
+// EventRecordingListener is a RenderingListener which records Kubernetes Events on the custom resource being
+// reconciled, so that progress and errors are visible with kubectl describe. Events for individual resources are
+// aggregated into one summary event per reconciliation, and all events are rate limited.
+type EventRecordingListener struct {
+	*DefaultRenderingListener
+	recorder record.EventRecorder
+	limiter  *EventLimiter
+
+	// mu protects the fields below, since resources are processed concurrently.
+	mu       sync.Mutex
+	instance runtime.Object
+	// counts holds the number of resources per operation and kind in the current reconciliation.
+	counts map[string]map[string]int
+	// errors is the number of resource errors in the current reconciliation.
+	errors int
+}
+
+var _ RenderingListener = &EventRecordingListener{}
+
+// NewEventRecordingListener returns a new EventRecordingListener which records events using recorder, as allowed
+// by limiter. If limiter is nil, the events are only limited for the lifetime of the listener.
+func NewEventRecordingListener(recorder record.EventRecorder, limiter *EventLimiter) *EventRecordingListener {
+	if limiter == nil {
+		limiter = NewEventLimiter()
+	}
+	return &EventRecordingListener{
+		DefaultRenderingListener: &DefaultRenderingListener{},
+		recorder:                 recorder,
+		limiter:                  limiter,
+		counts:                   make(map[string]map[string]int),
+	}
+}
+
+// BeginReconcile records a Reconciling event.
+func (l *EventRecordingListener) BeginReconcile(instance runtime.Object) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.instance = instance
+	l.reset()
+	l.event(corev1.EventTypeNormal, EventReasonReconciling, "Reconciling %s", objectDescription(instance))
+	return nil
+}
+
+// BeginDelete records a Deleting event.
+func (l *EventRecordingListener) BeginDelete(instance runtime.Object) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.instance = instance
+	l.reset()
+	l.event(corev1.EventTypeNormal, EventReasonDeleting, "Deleting resources of %s", objectDescription(instance))
+	return nil
+}
+
+// ResourceCreated counts the created resource.
+func (l *EventRecordingListener) ResourceCreated(created runtime.Object) error {
+	l.count(operationCreated, created)
+	return nil
+}
+
+// ResourceUpdated counts the updated resource.
+func (l *EventRecordingListener) ResourceUpdated(updated, old runtime.Object) error {
+	l.count(operationUpdated, updated)
+	return nil
+}
+
+// ResourceDeleted counts the deleted resource.
+func (l *EventRecordingListener) ResourceDeleted(deleted runtime.Object) error {
+	l.count(operationDeleted, deleted)
+	return nil
+}
+
+// ResourceError records a Warning event for the resource, up to maxResourceErrorEvents per reconciliation.
+func (l *EventRecordingListener) ResourceError(obj runtime.Object, err error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors++
+	if l.errors <= maxResourceErrorEvents {
+		l.event(corev1.EventTypeWarning, EventReasonResourceError, "Failed to apply %s: %s", objectDescription(obj), err)
+	}
+	return nil
+}
+
+// BeginPrune records a Pruning event.
+func (l *EventRecordingListener) BeginPrune(all bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.counts, operationDeleted)
+	if all {
+		l.event(corev1.EventTypeNormal, EventReasonPruning, "Pruning all resources")
+	} else {
+		l.event(corev1.EventTypeNormal, EventReasonPruning, "Pruning resources which are no longer rendered")
+	}
+	return nil
+}
+
+// EndPrune records a Pruned event with the number of deleted resources.
+func (l *EventRecordingListener) EndPrune() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.event(corev1.EventTypeNormal, EventReasonPruned, "Pruned %s", countsString(l.counts[operationDeleted]))
+	return nil
+}
+
+// EndDelete records a Deleted or DeleteError event.
+func (l *EventRecordingListener) EndDelete(instance runtime.Object, err error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err != nil {
+		l.event(corev1.EventTypeWarning, EventReasonDeleteError, "Failed to delete resources: %s", err)
+		return nil
+	}
+	l.event(corev1.EventTypeNormal, EventReasonDeleted, "Deleted %s", countsString(l.counts[operationDeleted]))
+	return nil
+}
+
+// EndReconcile records a summary of the applied resources and an event for the resulting status.
+func (l *EventRecordingListener) EndReconcile(instance runtime.Object, status *iop.IstioOperatorStatus) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var applied []string
+	for _, op := range []string{operationCreated, operationUpdated} {
+		if len(l.counts[op]) != 0 {
+			applied = append(applied, op+" "+countsString(l.counts[op]))
+		}
+	}
+	if len(applied) != 0 {
+		l.event(corev1.EventTypeNormal, EventReasonResourcesApplied, "Resources %s", strings.Join(applied, "; "))
+	}
+	if l.errors > maxResourceErrorEvents {
+		l.event(corev1.EventTypeWarning, EventReasonResourceError, "%d more resources failed to apply",
+			l.errors-maxResourceErrorEvents)
+	}
+
+	if status == nil {
+		return nil
+	}
+	switch status.Status {
+	case v1alpha1.InstallStatus_ERROR:
+		msg := "one or more components failed"
+		if c := status.GetCondition(iop.ConditionDegraded); c != nil && c.Message != "" {
+			msg = c.Message
+		}
+		l.event(corev1.EventTypeWarning, EventReasonReconcileError, "Reconcile failed: %s", msg)
+	case v1alpha1.InstallStatus_HEALTHY:
+		l.event(corev1.EventTypeNormal, EventReasonReconciled, "All components are ready")
+	default:
+		msg := status.Status.String()
+		if c := status.GetCondition(iop.ConditionProgressing); c != nil && c.Message != "" {
+			msg = c.Message
+		}
+		l.event(corev1.EventTypeNormal, EventReasonProgressing, "Reconciled, waiting for %s", msg)
+	}
+	return nil
+}
+
+func (l *EventRecordingListener) count(operation string, obj runtime.Object) {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.counts[operation] == nil {
+		l.counts[operation] = make(map[string]int)
+	}
+	l.counts[operation][kind]++
+}
+
+// reset clears the per reconciliation state. It must be called with mu held.
+func (l *EventRecordingListener) reset() {
+	l.counts = make(map[string]map[string]int)
+	l.errors = 0
+}
+
+// event records an event on the current instance if the rate limiter allows it. It must be called with mu held.
+func (l *EventRecordingListener) event(eventType, reason, format string, args ...interface{}) {
+	if l.instance == nil || l.recorder == nil {
+		return
+	}
+	msg := fmt.Sprintf(format, args...)
+	ok, dropped := l.limiter.accept()
+	if !ok {
+		log.Debugf("dropping event %s: %s", reason, msg)
+		return
+	}
+	if dropped != 0 {
+		msg = fmt.Sprintf("%s (%d earlier events suppressed)", msg, dropped)
+	}
+	l.recorder.Event(l.instance, eventType, reason, msg)
+}
+
+// countsString returns a summary like "3 resources (Deployment: 2, Service: 1)".
+func countsString(counts map[string]int) string {
+	total := 0
+	var kinds []string
+	for k, n := range counts {
+		total += n
+		kinds = append(kinds, fmt.Sprintf("%s: %d", k, n))
+	}
+	sort.Strings(kinds)
+	if total == 0 {
+		return "0 resources"
+	}
+	return fmt.Sprintf("%d resources (%s)", total, strings.Join(kinds, ", "))
+}
+
+// objectDescription returns a description like "Deployment istio-system/istio-pilot".
+func objectDescription(obj runtime.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return kind
+	}
+	name := accessor.GetName()
+	if ns := accessor.GetNamespace(); ns != "" {
+		name = ns + "/" + name
+	}
+	if kind == "" {
+		return name
+	}
+	return kind + " " + name
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+)
+
+func TestEventRecordingListener(t *testing.T) {
+	recorder := record.NewFakeRecorder(100)
+	l := NewEventRecordingListener(recorder, nil)
+	instance := &iop.IstioOperator{}
+	instance.Name, instance.Namespace = "test", "istio-system"
+
+	mustNotError(t, l.BeginReconcile(instance))
+	for i := 0; i < 3; i++ {
+		mustNotError(t, l.ResourceCreated(testObject("Deployment", fmt.Sprint("d", i))))
+	}
+	mustNotError(t, l.ResourceUpdated(testObject("Service", "s"), nil))
+	for i := 0; i < maxResourceErrorEvents+2; i++ {
+		mustNotError(t, l.ResourceError(testObject("ConfigMap", fmt.Sprint("c", i)), fmt.Errorf("boom")))
+	}
+	mustNotError(t, l.BeginPrune(false))
+	mustNotError(t, l.ResourceDeleted(testObject("Service", "old")))
+	mustNotError(t, l.EndPrune())
+	mustNotError(t, l.EndReconcile(instance, &iop.IstioOperatorStatus{Status: v1alpha1.InstallStatus_ERROR}))
+
+	got := drainEvents(recorder)
+	want := []string{
+		"Normal Reconciling Reconciling istio-system/test",
+	}
+	for i := 0; i < maxResourceErrorEvents; i++ {
+		want = append(want, fmt.Sprintf("Warning ResourceError Failed to apply ConfigMap istio-system/c%d: boom", i))
+	}
+	want = append(want,
+		"Normal Pruning Pruning resources which are no longer rendered",
+		"Normal Pruned Pruned 1 resources (Service: 1)",
+		"Normal ResourcesApplied Resources created 3 resources (Deployment: 3); updated 1 resources (Service: 1)",
+		"Warning ResourceError 2 more resources failed to apply",
+		"Warning ReconcileError Reconcile failed: one or more components failed",
+	)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got events:\n%s\n\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestEventRecordingListenerRateLimit(t *testing.T) {
+	recorder := record.NewFakeRecorder(100)
+	l := NewEventRecordingListener(recorder, nil)
+	l.limiter.rateLimiter = flowcontrol.NewFakeNeverRateLimiter()
+	instance := &iop.IstioOperator{}
+
+	mustNotError(t, l.BeginReconcile(instance))
+	mustNotError(t, l.BeginPrune(false))
+	if got := drainEvents(recorder); len(got) != 0 {
+		t.Fatalf("got events %v, want none", got)
+	}
+
+	l.limiter.rateLimiter = flowcontrol.NewFakeAlwaysRateLimiter()
+	mustNotError(t, l.EndPrune())
+	got := drainEvents(recorder)
+	if len(got) != 1 || !strings.HasSuffix(got[0], "(2 earlier events suppressed)") {
+		t.Errorf("got events %v, want one event reporting 2 suppressed events", got)
+	}
+}
+
+func testObject(kind, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind(kind)
+	u.SetNamespace("istio-system")
+	u.SetName(name)
+	return u
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var out []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			out = append(out, e)
+		default:
+			return out
+		}
+	}
+}
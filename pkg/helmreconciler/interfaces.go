@@ -16,6 +16,7 @@ package helmreconciler
 
 import (
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -24,8 +25,8 @@ import (
 	"k8s.io/helm/pkg/manifest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"istio.io/api/operator/v1alpha1"
-	"istio.io/operator/pkg/name"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/dag"
 )
 
 // RenderingCustomizer encompasses all the customization details for a specific rendering invocation.
@@ -67,6 +68,9 @@ type PruningDetails interface {
 	// GetResourceTypes returns the types of resources managed by the operator and corresponding mutex. These types are used
 	// when selecting resources to be pruned.
 	GetResourceTypes() (map[schema.GroupVersionKind]bool, map[schema.GroupVersionKind]bool, *sync.Mutex)
+	// GetPrunePolicy returns the policy for pruning resources which are no longer rendered. It does not apply when
+	// all resources are pruned, i.e. when the custom resource is deleted.
+	GetPrunePolicy() PrunePolicy
 }
 
 // ChartManifestsMap is a typedef representing a map of chart-name: []manifest, i.e. the manifests
@@ -82,10 +86,12 @@ type RenderingInput interface {
 	// GetTargetNamespace returns the target namespace which should be applied to namespaced resources
 	// (i.e. used to set Release.Namespace)
 	GetTargetNamespace() string
-	// GetProcessingOrder returns a dependency tree for the given manifests. ComponentNameToListMap is a map of
-	// each component to its dependencies. DependencyWaitCh is a map of channels, indexed by name. The component with
-	// the given name must wait on the channel before starting its processing.
-	GetProcessingOrder(manifests ChartManifestsMap) (ComponentNameToListMap, DependencyWaitCh)
+	// GetProcessingOrder returns the dependency graph for the given manifests. The manifests of a component are only
+	// processed once the manifests of all of its dependencies have been processed.
+	GetProcessingOrder(manifests ChartManifestsMap) (dag.Graph, error)
+	// GetReadinessTimeout returns how long the resources of the given component may take to become ready before
+	// the components depending on it are processed. A zero timeout disables the readiness gate of the component.
+	GetReadinessTimeout(component string) time.Duration
 }
 
 // RenderingListener is the main hook into the rendering process.  The methods represent each stage in the
@@ -147,7 +153,7 @@ type RenderingListener interface {
 	// EndReconcile occurs after reconciliation has completed.  It is similar to EndDelete, but applies to reconciliation.
 	// instance is the custom resource being reconciled
 	// status is the status and errors of components at the end of reconciliation.
-	EndReconcile(instance runtime.Object, status *v1alpha1.InstallStatus) error
+	EndReconcile(instance runtime.Object, status *iop.IstioOperatorStatus) error
 }
 
 // ChartCustomizer defines callbacks used by a listener that manages customizations for a specific chart.
@@ -202,13 +208,3 @@ type ClientProvider interface {
 	// GetClient returns a kubernetes client.
 	GetClient() client.Client
 }
-
-// ComponentNameToListMap is a map of ComponentName to a list of ComponentNames.
-type ComponentNameToListMap map[name.ComponentName][]name.ComponentName
-
-// ComponentTree defines a dependency tree.
-type ComponentTree map[name.ComponentName]interface{}
-
-// DependencyWaitCh defines a map of component name to a channel which signals when its parent dependency has
-// completed.
-type DependencyWaitCh map[name.ComponentName]chan struct{}
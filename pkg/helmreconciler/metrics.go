@@ -0,0 +1,275 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/util"
+	"istio.io/pkg/log"
+)
+
+const (
+	metricsNamespace = "istio_operator"
+
+	// Label names. The IstioOperator labels are prefixed to avoid clashing with the namespace label added by
+	// Prometheus to every scraped series.
+	iopNamespaceLabel = "iop_namespace"
+	iopNameLabel      = "iop_name"
+	resultLabel       = "result"
+	operationLabel    = "operation"
+	groupLabel        = "group"
+	versionLabel      = "version"
+	kindLabel         = "kind"
+	componentLabel    = "component"
+
+	// Values of operationLabel.
+	operationCreated = "created"
+	operationUpdated = "updated"
+	operationDeleted = "deleted"
+	operationError   = "error"
+)
+
+var (
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of IstioOperator reconciliations, by overall status at the end of the reconciliation.",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{iopNamespaceLabel, iopNameLabel, resultLabel})
+
+	reconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "reconcile_total",
+		Help:      "Number of IstioOperator reconciliations, by overall status at the end of the reconciliation.",
+	}, []string{iopNamespaceLabel, iopNameLabel, resultLabel})
+
+	resourceOperationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "resource_operations_total",
+		Help:      "Number of resources created, updated, deleted or failed, by GVK and component.",
+	}, []string{operationLabel, groupLabel, versionLabel, kindLabel, componentLabel})
+
+	componentStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "component_status",
+		Help:      "Status of each component: 0 NONE, 1 UPDATING, 2 HEALTHY, 3 ERROR, 4 RECONCILING.",
+	}, []string{iopNamespaceLabel, iopNameLabel, componentLabel})
+
+	componentReadyReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "component_ready_replicas",
+		Help:      "Number of ready replicas of the workloads of each component.",
+	}, []string{iopNamespaceLabel, iopNameLabel, componentLabel})
+
+	componentDesiredReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "component_desired_replicas",
+		Help:      "Number of desired replicas of the workloads of each component.",
+	}, []string{iopNamespaceLabel, iopNameLabel, componentLabel})
+
+	pruneCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "pruned_resources",
+		Help:      "Number of resources deleted by the most recent prune.",
+	}, []string{iopNamespaceLabel, iopNameLabel})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		reconcileDuration,
+		reconcileTotal,
+		resourceOperationsTotal,
+		componentStatus,
+		componentReadyReplicas,
+		componentDesiredReplicas,
+		pruneCount,
+	)
+}
+
+// MetricsRenderingListener is a RenderingListener which records Prometheus metrics about reconciliations and the
+// resources they process. Metrics are served by the controller manager metrics endpoint.
+type MetricsRenderingListener struct {
+	*DefaultRenderingListener
+	// chartAnnotationKey is the annotation holding the name of the component which rendered a resource.
+	chartAnnotationKey string
+
+	// mu protects the fields below, since resources are processed concurrently.
+	mu        sync.Mutex
+	namespace string
+	name      string
+	start     time.Time
+	pruned    int
+	// components are the components in the instance status before the reconciliation, used to clear stale gauges.
+	components map[string]bool
+}
+
+var _ RenderingListener = &MetricsRenderingListener{}
+
+// NewMetricsRenderingListener returns a new MetricsRenderingListener. chartAnnotationKey is the annotation used to
+// attribute resources to components.
+func NewMetricsRenderingListener(chartAnnotationKey string) *MetricsRenderingListener {
+	return &MetricsRenderingListener{
+		DefaultRenderingListener: &DefaultRenderingListener{},
+		chartAnnotationKey:       chartAnnotationKey,
+	}
+}
+
+// BeginReconcile records the start of the reconciliation.
+func (l *MetricsRenderingListener) BeginReconcile(instance runtime.Object) error {
+	l.setInstance(instance)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.start = time.Now()
+	return nil
+}
+
+// BeginDelete records the instance being deleted.
+func (l *MetricsRenderingListener) BeginDelete(instance runtime.Object) error {
+	l.setInstance(instance)
+	return nil
+}
+
+// ResourceCreated counts the created resource.
+func (l *MetricsRenderingListener) ResourceCreated(created runtime.Object) error {
+	l.countResource(operationCreated, created)
+	return nil
+}
+
+// ResourceUpdated counts the updated resource.
+func (l *MetricsRenderingListener) ResourceUpdated(updated, old runtime.Object) error {
+	l.countResource(operationUpdated, updated)
+	return nil
+}
+
+// ResourceDeleted counts the deleted resource.
+func (l *MetricsRenderingListener) ResourceDeleted(deleted runtime.Object) error {
+	l.countResource(operationDeleted, deleted)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pruned++
+	return nil
+}
+
+// ResourceError counts the resource which could not be created, updated or deleted.
+func (l *MetricsRenderingListener) ResourceError(obj runtime.Object, err error) error {
+	l.countResource(operationError, obj)
+	return nil
+}
+
+// BeginPrune resets the prune count.
+func (l *MetricsRenderingListener) BeginPrune(all bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pruned = 0
+	return nil
+}
+
+// EndPrune records the number of resources deleted by the prune.
+func (l *MetricsRenderingListener) EndPrune() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	pruneCount.WithLabelValues(l.namespace, l.name).Set(float64(l.pruned))
+	return nil
+}
+
+// EndDelete removes every series labeled with the deleted instance.
+func (l *MetricsRenderingListener) EndDelete(instance runtime.Object, err error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for c := range l.components {
+		l.deleteComponentGauges(c)
+	}
+	l.components = nil
+	pruneCount.DeleteLabelValues(l.namespace, l.name)
+	// The vendored client has no partial match delete, so delete the series of every possible result instead.
+	results := []string{"unknown"}
+	for _, name := range v1alpha1.InstallStatus_Status_name {
+		results = append(results, name)
+	}
+	for _, result := range results {
+		reconcileTotal.DeleteLabelValues(l.namespace, l.name, result)
+		reconcileDuration.DeleteLabelValues(l.namespace, l.name, result)
+	}
+	return nil
+}
+
+// EndReconcile records the duration and result of the reconciliation and the status of each component.
+func (l *MetricsRenderingListener) EndReconcile(instance runtime.Object, status *iop.IstioOperatorStatus) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	result := "unknown"
+	if status != nil {
+		result = status.Status.String()
+	}
+	reconcileTotal.WithLabelValues(l.namespace, l.name, result).Inc()
+	if !l.start.IsZero() {
+		reconcileDuration.WithLabelValues(l.namespace, l.name, result).Observe(time.Since(l.start).Seconds())
+	}
+	if status == nil {
+		return nil
+	}
+
+	for c, cs := range status.ComponentStatus {
+		componentStatus.WithLabelValues(l.namespace, l.name, c).Set(float64(cs.Status))
+		componentReadyReplicas.WithLabelValues(l.namespace, l.name, c).Set(float64(cs.ReadyReplicas))
+		componentDesiredReplicas.WithLabelValues(l.namespace, l.name, c).Set(float64(cs.DesiredReplicas))
+	}
+	for c := range l.components {
+		if _, ok := status.ComponentStatus[c]; !ok {
+			l.deleteComponentGauges(c)
+		}
+	}
+	return nil
+}
+
+func (l *MetricsRenderingListener) setInstance(instance runtime.Object) {
+	accessor, err := meta.Accessor(instance)
+	if err != nil {
+		log.Errorf("could not get object accessor for %T: %s", instance, err)
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.namespace, l.name = accessor.GetNamespace(), accessor.GetName()
+	// The listener is created for each reconciliation, so the components reported last time come from the status
+	// the instance had before this one.
+	l.components = make(map[string]bool)
+	if iopInstance, ok := instance.(*iop.IstioOperator); ok && iopInstance.Status != nil {
+		for c := range iopInstance.Status.ComponentStatus {
+			l.components[c] = true
+		}
+	}
+}
+
+func (l *MetricsRenderingListener) countResource(operation string, obj runtime.Object) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	component, _ := util.GetAnnotation(obj, l.chartAnnotationKey)
+	resourceOperationsTotal.WithLabelValues(operation, gvk.Group, gvk.Version, gvk.Kind, component).Inc()
+}
+
+func (l *MetricsRenderingListener) deleteComponentGauges(c string) {
+	componentStatus.DeleteLabelValues(l.namespace, l.name, c)
+	componentReadyReplicas.DeleteLabelValues(l.namespace, l.name, c)
+	componentDesiredReplicas.DeleteLabelValues(l.namespace, l.name, c)
+}
@@ -0,0 +1,132 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+)
+
+const testChartKey = "install.operator.istio.io/chart-owner"
+
+func TestMetricsRenderingListener(t *testing.T) {
+	l := NewMetricsRenderingListener(testChartKey)
+	instance := &iop.IstioOperator{}
+	instance.Name, instance.Namespace = "metrics-test", "istio-system"
+
+	deployment := &unstructured.Unstructured{}
+	deployment.SetAPIVersion("apps/v1")
+	deployment.SetKind("Deployment")
+	deployment.SetAnnotations(map[string]string{testChartKey: "Pilot"})
+
+	created := resourceOperationsTotal.WithLabelValues(operationCreated, "apps", "v1", "Deployment", "Pilot")
+	deleted := resourceOperationsTotal.WithLabelValues(operationDeleted, "apps", "v1", "Deployment", "Pilot")
+	createdBefore, deletedBefore := testutil.ToFloat64(created), testutil.ToFloat64(deleted)
+
+	mustNotError(t, l.BeginReconcile(instance))
+	mustNotError(t, l.ResourceCreated(deployment))
+	mustNotError(t, l.BeginPrune(false))
+	mustNotError(t, l.ResourceDeleted(deployment))
+	mustNotError(t, l.ResourceDeleted(deployment))
+	mustNotError(t, l.EndPrune())
+	mustNotError(t, l.EndReconcile(instance, &iop.IstioOperatorStatus{
+		Status: v1alpha1.InstallStatus_HEALTHY,
+		ComponentStatus: map[string]*iop.ComponentStatus{
+			"Pilot":  {Status: v1alpha1.InstallStatus_HEALTHY, ReadyReplicas: 1, DesiredReplicas: 2},
+			"Galley": {Status: v1alpha1.InstallStatus_ERROR},
+		},
+	}))
+
+	for _, tt := range []struct {
+		desc string
+		got  float64
+		want float64
+	}{
+		{"created", testutil.ToFloat64(created) - createdBefore, 1},
+		{"deleted", testutil.ToFloat64(deleted) - deletedBefore, 2},
+		{"pruned", testutil.ToFloat64(pruneCount.WithLabelValues("istio-system", "metrics-test")), 2},
+		{"reconciles", testutil.ToFloat64(reconcileTotal.WithLabelValues("istio-system", "metrics-test", "HEALTHY")), 1},
+		{"galley status", testutil.ToFloat64(componentStatus.WithLabelValues("istio-system", "metrics-test", "Galley")),
+			float64(v1alpha1.InstallStatus_ERROR)},
+		{"pilot ready", testutil.ToFloat64(componentReadyReplicas.WithLabelValues("istio-system", "metrics-test", "Pilot")), 1},
+		{"pilot desired", testutil.ToFloat64(componentDesiredReplicas.WithLabelValues("istio-system", "metrics-test", "Pilot")), 2},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.desc, tt.got, tt.want)
+		}
+	}
+
+	// A component which is no longer reported should not keep a stale gauge. The reconciler creates new listeners
+	// for every reconciliation, so the previous components come from the instance status.
+	instance.Status = &iop.IstioOperatorStatus{
+		ComponentStatus: map[string]*iop.ComponentStatus{
+			"Pilot":  {Status: v1alpha1.InstallStatus_HEALTHY},
+			"Galley": {Status: v1alpha1.InstallStatus_ERROR},
+		},
+	}
+	l = NewMetricsRenderingListener(testChartKey)
+	mustNotError(t, l.BeginReconcile(instance))
+	mustNotError(t, l.EndReconcile(instance, &iop.IstioOperatorStatus{
+		Status:          v1alpha1.InstallStatus_HEALTHY,
+		ComponentStatus: map[string]*iop.ComponentStatus{"Pilot": {Status: v1alpha1.InstallStatus_HEALTHY}},
+	}))
+	if got := componentStatus.DeleteLabelValues("istio-system", "metrics-test", "Galley"); got {
+		t.Errorf("stale Galley status gauge was not removed")
+	}
+}
+
+func TestMetricsRenderingListenerDelete(t *testing.T) {
+	instance := &iop.IstioOperator{}
+	instance.Name, instance.Namespace = "metrics-delete-test", "istio-system"
+	status := &iop.IstioOperatorStatus{
+		Status:          v1alpha1.InstallStatus_ERROR,
+		ComponentStatus: map[string]*iop.ComponentStatus{"Pilot": {Status: v1alpha1.InstallStatus_ERROR}},
+	}
+	l := NewMetricsRenderingListener(testChartKey)
+	mustNotError(t, l.BeginReconcile(instance))
+	mustNotError(t, l.BeginPrune(false))
+	mustNotError(t, l.EndPrune())
+	mustNotError(t, l.EndReconcile(instance, status))
+
+	instance.Status = status
+	l = NewMetricsRenderingListener(testChartKey)
+	mustNotError(t, l.BeginDelete(instance))
+	mustNotError(t, l.EndDelete(instance, nil))
+
+	for desc, deleted := range map[string]bool{
+		"reconciles":    reconcileTotal.DeleteLabelValues("istio-system", "metrics-delete-test", "ERROR"),
+		"duration":      reconcileDuration.DeleteLabelValues("istio-system", "metrics-delete-test", "ERROR"),
+		"pruned":        pruneCount.DeleteLabelValues("istio-system", "metrics-delete-test"),
+		"pilot status":  componentStatus.DeleteLabelValues("istio-system", "metrics-delete-test", "Pilot"),
+		"pilot ready":   componentReadyReplicas.DeleteLabelValues("istio-system", "metrics-delete-test", "Pilot"),
+		"pilot desired": componentDesiredReplicas.DeleteLabelValues("istio-system", "metrics-delete-test", "Pilot"),
+	} {
+		if deleted {
+			t.Errorf("%s series of the deleted instance was not removed", desc)
+		}
+	}
+}
+
+func mustNotError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// OwnershipConflictError is returned when a rendered resource already exists and is owned by another instance, e.g.
+// when two IstioOperator resources render the same object. The existing resource is left unchanged.
+type OwnershipConflictError struct {
+	// Kind, Namespace and Name identify the conflicting resource.
+	Kind, Namespace, Name string
+	// Owner holds the owner labels of the existing resource which differ from those of the instance.
+	Owner map[string]string
+}
+
+func (e *OwnershipConflictError) Error() string {
+	var owner []string
+	for k, v := range e.Owner {
+		owner = append(owner, k+"="+v)
+	}
+	sort.Strings(owner)
+	return fmt.Sprintf("%s %s is owned by another instance (%s)", e.Kind, objectName(e.Namespace, e.Name),
+		strings.Join(owner, ", "))
+}
+
+// checkOwnership returns an OwnershipConflictError if existing has any of the ownerLabels with a different value.
+// Resources without owner labels, e.g. created by an older operator version or outside of the operator, are adopted.
+func checkOwnership(existing *unstructured.Unstructured, ownerLabels map[string]string) error {
+	labels := existing.GetLabels()
+	conflict := make(map[string]string)
+	for k, v := range ownerLabels {
+		if existingValue, ok := labels[k]; ok && existingValue != "" && existingValue != v {
+			conflict[k] = existingValue
+		}
+	}
+	if len(conflict) == 0 {
+		return nil
+	}
+	return &OwnershipConflictError{Kind: existing.GetKind(), Namespace: existing.GetNamespace(), Name: existing.GetName(), Owner: conflict}
+}
+
+func objectName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCheckOwnership(t *testing.T) {
+	ownerLabels := map[string]string{
+		"install.operator.istio.io/owner-name":      "gateways",
+		"install.operator.istio.io/owner-namespace": "team-a",
+		"install.operator.istio.io/owner-kind":      "IstioOperator",
+	}
+	tests := []struct {
+		desc    string
+		labels  map[string]string
+		wantErr string
+	}{
+		{
+			desc:   "same owner",
+			labels: ownerLabels,
+		},
+		{
+			desc: "no owner",
+		},
+		{
+			desc: "older operator version",
+			labels: map[string]string{
+				"install.operator.istio.io/owner-name": "gateways",
+				"install.operator.istio.io/owner-kind": "IstioOperator",
+			},
+		},
+		{
+			desc: "other owner",
+			labels: map[string]string{
+				"install.operator.istio.io/owner-name":      "gateways",
+				"install.operator.istio.io/owner-namespace": "team-b",
+				"install.operator.istio.io/owner-kind":      "IstioOperator",
+			},
+			wantErr: "Service team-a/istio-ingressgateway is owned by another instance " +
+				"(install.operator.istio.io/owner-namespace=team-b)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			existing := &unstructured.Unstructured{}
+			existing.SetKind("Service")
+			existing.SetNamespace("team-a")
+			existing.SetName("istio-ingressgateway")
+			existing.SetLabels(tt.labels)
+			err := checkOwnership(existing, ownerLabels)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("got error %q, want none", err)
+			case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
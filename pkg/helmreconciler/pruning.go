@@ -16,6 +16,7 @@ package helmreconciler
 
 import (
 	"context"
+	"fmt"
 
 	"istio.io/pkg/log"
 
@@ -24,29 +25,77 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/name"
 )
 
+// PrunePolicy controls the pruning of resources which are no longer rendered.
+type PrunePolicy struct {
+	// Preview lists the resources which would be pruned instead of deleting them.
+	Preview bool
+	// MaxDeletions is the maximum number of resources deleted by one prune. If more resources would be pruned,
+	// nothing is deleted. 0 means no limit.
+	MaxDeletions int
+}
+
 // Prune removes any resources not specified in manifests generated by HelmReconciler h. If all is set to true, this
-// function prunes all resources.
+// function prunes all resources. Resources with the do-not-prune annotation are never removed.
 func (h *HelmReconciler) Prune(all bool) error {
-	allErrors := []error{}
-	namespacedResourceMap, nonNamespacedResourceMap, _ := h.customizer.PruningDetails().GetResourceTypes()
+	_, _, err := h.prune(all, PrunePolicy{})
+	return err
+}
+
+// prune removes the resources selected by all according to policy. It returns the removed resources, or the resources
+// which would have been removed if policy.Preview is set or more than policy.MaxDeletions resources would be removed.
+func (h *HelmReconciler) prune(all bool, policy PrunePolicy) (pruned, preview []iop.PrunedResource, err error) {
+	namespacedResourceMap, nonNamespacedResourceMap := h.resourceTypes()
 	targetNamespace := h.customizer.Input().GetTargetNamespace()
-	err := h.PruneResources(namespacedResourceMap, all, targetNamespace)
-	if err != nil {
-		allErrors = append(allErrors, err)
+	objects := h.pruneCandidates(namespacedResourceMap, all, targetNamespace)
+	objects = append(objects, h.pruneCandidates(nonNamespacedResourceMap, all, "")...)
+
+	now := metav1.Now()
+	switch {
+	case policy.Preview:
+		log.Infof("prune preview: %d resources would be pruned", len(objects))
+		return nil, prunedResources(objects, now), nil
+	case policy.MaxDeletions > 0 && len(objects) > policy.MaxDeletions:
+		return nil, prunedResources(objects, now), fmt.Errorf("refusing to prune %d resources, more than the limit of %d",
+			len(objects), policy.MaxDeletions)
 	}
-	err = h.PruneResources(nonNamespacedResourceMap, all, "")
-	if err != nil {
-		allErrors = append(allErrors, err)
+	pruned, err = h.deleteResources(objects, now)
+	return pruned, nil, err
+}
+
+// resourceTypes returns copies of the resource type maps of the pruning details, which may be shared with the
+// HelmReconcilers of other instances.
+func (h *HelmReconciler) resourceTypes() (map[schema.GroupVersionKind]bool, map[schema.GroupVersionKind]bool) {
+	namespacedResourceMap, nonNamespacedResourceMap, mu := h.customizer.PruningDetails().GetResourceTypes()
+	mu.Lock()
+	defer mu.Unlock()
+	return copyResourceMap(namespacedResourceMap), copyResourceMap(nonNamespacedResourceMap)
+}
+
+func copyResourceMap(in map[schema.GroupVersionKind]bool) map[schema.GroupVersionKind]bool {
+	out := make(map[schema.GroupVersionKind]bool, len(in))
+	for gvk, exists := range in {
+		out[gvk] = exists
 	}
-	return utilerrors.NewAggregate(allErrors)
+	return out
 }
 
-// Prune removes any resources not specified resourceMap. If all is set to true, it prunes all
+// PruneResources removes any resources not specified resourceMap. If all is set to true, it prunes all
 // resources.
 func (h *HelmReconciler) PruneResources(resourceMap map[schema.GroupVersionKind]bool, all bool, namespace string) error {
-	allErrors := []error{}
+	_, err := h.deleteResources(h.pruneCandidates(resourceMap, all, namespace), metav1.Now())
+	return err
+}
+
+// pruneCandidates returns the resources of the types in resourceMap which are owned by the instance and not
+// specified in the manifests, or all of them if all is set to true. Resources with the do-not-prune annotation are
+// left out.
+func (h *HelmReconciler) pruneCandidates(resourceMap map[schema.GroupVersionKind]bool, all bool, namespace string) []unstructured.Unstructured {
+	var out []unstructured.Unstructured
 	ownerLabels := h.customizer.PruningDetails().GetOwnerLabels()
 	ownerAnnotations := h.customizer.PruningDetails().GetOwnerAnnotations()
 	for gvk, exists := range resourceMap {
@@ -69,19 +118,45 @@ func (h *HelmReconciler) PruneResources(resourceMap map[schema.GroupVersionKind]
 						continue objectLoop
 					}
 				}
-				err = h.client.Delete(context.TODO(), &object, client.PropagationPolicy(metav1.DeletePropagationBackground))
-				if err == nil {
-					if listenerErr := h.customizer.Listener().ResourceDeleted(&object); listenerErr != nil {
-						log.Errorf("error calling listener: %s", err)
-					}
-				} else {
-					if listenerErr := h.customizer.Listener().ResourceError(&object, err); listenerErr != nil {
-						log.Errorf("error calling listener: %s", err)
-					}
-					allErrors = append(allErrors, err)
+				if annotations[name.DoNotPruneAnnotation] == "true" {
+					log.Infof("not pruning %s, it has the %s annotation", objectName(object.GetNamespace(), object.GetName()), name.DoNotPruneAnnotation)
+					continue
 				}
+				out = append(out, object)
 			}
 		}
 	}
-	return utilerrors.NewAggregate(allErrors)
+	return out
+}
+
+// deleteResources deletes objects, and returns the ones which were deleted.
+func (h *HelmReconciler) deleteResources(objects []unstructured.Unstructured, now metav1.Time) ([]iop.PrunedResource, error) {
+	allErrors := []error{}
+	var deleted []unstructured.Unstructured
+	for i := range objects {
+		object := &objects[i]
+		err := h.client.Delete(context.TODO(), object, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err == nil {
+			deleted = append(deleted, *object)
+			if listenerErr := h.customizer.Listener().ResourceDeleted(object); listenerErr != nil {
+				log.Errorf("error calling listener: %s", listenerErr)
+			}
+		} else {
+			if listenerErr := h.customizer.Listener().ResourceError(object, err); listenerErr != nil {
+				log.Errorf("error calling listener: %s", listenerErr)
+			}
+			allErrors = append(allErrors, err)
+		}
+	}
+	return prunedResources(deleted, now), utilerrors.NewAggregate(allErrors)
+}
+
+// prunedResources returns the pruned resource records of objects.
+func prunedResources(objects []unstructured.Unstructured, now metav1.Time) []iop.PrunedResource {
+	var out []iop.PrunedResource
+	for _, o := range objects {
+		gvk := o.GroupVersionKind()
+		out = append(out, iop.PrunedResource{Group: gvk.Group, Kind: gvk.Kind, Namespace: o.GetNamespace(), Name: o.GetName(), Time: now})
+	}
+	return out
 }
@@ -0,0 +1,96 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/helm/pkg/manifest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"istio.io/operator/pkg/dag"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/readiness"
+)
+
+// readinessPollInterval is the interval at which the objects of a component are checked by its readiness gate.
+var readinessPollInterval = 5 * time.Second
+
+// waitForReady waits up to timeout for the objects in m to become ready, as reported by the readiness checker of
+// each type. The returned error lists the objects which are still not ready once timeout has elapsed.
+func (h *HelmReconciler) waitForReady(m manifest.Manifest, timeout time.Duration) error {
+	objects, err := object.ParseK8sObjectsFromYAMLManifest(m.Content)
+	if err != nil {
+		return fmt.Errorf("could not parse manifest %s to check readiness: %s", m.Name, err)
+	}
+	get := h.readinessGetter()
+	var notReady []string
+	err = wait.PollImmediate(readinessPollInterval, timeout, func() (bool, error) {
+		notReady = nil
+		for _, o := range objects {
+			live, err := get(o.GroupVersionKind(), o.Namespace, o.Name)
+			ready := false
+			if err == nil {
+				if ready, err = readiness.IsReady(get, live); err != nil {
+					return false, err
+				}
+			}
+			if !ready {
+				notReady = append(notReady, fmt.Sprintf("%s %s/%s", o.Kind, o.Namespace, o.Name))
+			}
+		}
+		return len(notReady) == 0, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("readiness gate timed out after %s waiting for %s to become ready",
+			timeout, strings.Join(notReady, ", "))
+	}
+	if err != nil {
+		return fmt.Errorf("readiness gate failed: %s", err)
+	}
+	return nil
+}
+
+// readinessGetter returns a readiness.Getter which reads live objects with the client of h.
+func (h *HelmReconciler) readinessGetter() readiness.Getter {
+	return func(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		if err := h.client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, u); err != nil {
+			return nil, err
+		}
+		return u, nil
+	}
+}
+
+// blockingDependency returns a dependency of c, possibly indirect, which is in notReady, or "" if there is none.
+func blockingDependency(deps dag.Graph, c name.ComponentName, notReady map[name.ComponentName]bool) name.ComponentName {
+	for _, d := range deps[c] {
+		if notReady[d] {
+			return d
+		}
+		if b := blockingDependency(deps, d, notReady); b != "" {
+			return b
+		}
+	}
+	return ""
+}
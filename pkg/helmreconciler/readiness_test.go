@@ -0,0 +1,93 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/helm/pkg/manifest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"istio.io/operator/pkg/dag"
+	"istio.io/operator/pkg/name"
+)
+
+const pilotDeploymentYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+`
+
+func TestWaitForReady(t *testing.T) {
+	defer func(d time.Duration) { readinessPollInterval = d }(readinessPollInterval)
+	readinessPollInterval = time.Millisecond
+	m := manifest.Manifest{Name: "Pilot", Content: pilotDeploymentYAML}
+
+	for _, tt := range []struct {
+		desc          string
+		readyReplicas int32
+		wantErr       string
+	}{
+		{
+			desc:          "ready",
+			readyReplicas: 1,
+		},
+		{
+			desc:    "not ready",
+			wantErr: "readiness gate timed out after 10ms waiting for Deployment istio-system/istio-pilot to become ready",
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "istio-pilot", Namespace: "istio-system"},
+				Status:     appsv1.DeploymentStatus{UpdatedReplicas: 1, ReadyReplicas: tt.readyReplicas},
+			}
+			h := &HelmReconciler{client: fake.NewFakeClient(deployment)}
+			err := h.waitForReady(m, 10*time.Millisecond)
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tt.wantErr {
+				t.Errorf("got error %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+
+	h := &HelmReconciler{client: fake.NewFakeClient()}
+	if err := h.waitForReady(m, 10*time.Millisecond); err == nil || !strings.Contains(err.Error(), "istio-pilot") {
+		t.Errorf("got error %v for a missing deployment, want a timeout", err)
+	}
+}
+
+func TestBlockingDependency(t *testing.T) {
+	deps := dag.Graph{
+		name.PilotComponentName:   {name.IstioBaseComponentName},
+		name.IngressComponentName: {name.PilotComponentName},
+	}
+	notReady := map[name.ComponentName]bool{name.IstioBaseComponentName: true}
+	if got := blockingDependency(deps, name.IngressComponentName, notReady); got != name.IstioBaseComponentName {
+		t.Errorf("got %q, want %q", got, name.IstioBaseComponentName)
+	}
+	if got := blockingDependency(deps, name.IstioBaseComponentName, notReady); got != "" {
+		t.Errorf("got %q for a component without dependencies, want none", got)
+	}
+}
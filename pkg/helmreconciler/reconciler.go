@@ -15,8 +15,12 @@
 package helmreconciler
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -108,53 +112,104 @@ func (h *HelmReconciler) Reconcile() error {
 	//	}
 	//	manifestMap[chartName] = newManifests
 	//}
-	status := h.processRecursive(manifestMap)
+	status, gateErr := h.processRecursive(manifestMap)
 
-	// Delete any resources not in the manifest but managed by operator.
-	var errs util.Errors
-	if h.needUpdateAndPrune {
+	// Delete any resources not in the manifest but managed by operator. Nothing is pruned while components are held
+	// back by a readiness gate, since their resources were not updated.
+	errs := util.AppendErr(nil, gateErr)
+	if h.needUpdateAndPrune && gateErr == nil {
 		errs = util.AppendErr(errs, h.customizer.Listener().BeginPrune(false))
-		errs = util.AppendErr(errs, h.Prune(false))
+		pruned, preview, err := h.prune(false, h.customizer.PruningDetails().GetPrunePolicy())
+		errs = util.AppendErr(errs, err)
+		status.PrunedResources, status.PrunePreview = pruned, preview
 		errs = util.AppendErr(errs, h.customizer.Listener().EndPrune())
+	} else {
+		keepPrunePreview(h.instance, status)
 	}
 	errs = util.AppendErr(errs, h.customizer.Listener().EndReconcile(h.instance, status))
 	return errs.ToError()
 }
 
-// processRecursive processes the given manifests in an order of dependencies defined in h. Dependencies are a tree,
-// where a child must wait for the parent to complete before starting.
-func (h *HelmReconciler) processRecursive(manifests ChartManifestsMap) *v1alpha1.InstallStatus {
-	deps, dch := h.customizer.Input().GetProcessingOrder(manifests)
-	componentStatus := make(map[string]*v1alpha1.InstallStatus_VersionStatus)
+// ReconcileComponents applies the resources of the given components only, e.g. to revert changes made to them
+// outside of the operator. Nothing is pruned, and the status of other components is kept.
+func (h *HelmReconciler) ReconcileComponents(components []string) error {
+	if err := h.customizer.Listener().BeginReconcile(h.instance); err != nil {
+		return err
+	}
+	manifestMap, err := h.renderCharts(h.customizer.Input())
+	if err != nil {
+		return err
+	}
+	filtered := make(ChartManifestsMap)
+	for _, c := range components {
+		if m, ok := manifestMap[c]; ok {
+			filtered[c] = m
+		}
+	}
+	h.needUpdateAndPrune = true
+	status, gateErr := h.processRecursive(filtered)
+	if h.instance.Status != nil {
+		for c, cs := range h.instance.Status.ComponentStatus {
+			if _, ok := status.ComponentStatus[c]; !ok {
+				status.ComponentStatus[c] = cs
+			}
+		}
+	}
+	keepPrunePreview(h.instance, status)
+	errs := util.AppendErr(nil, gateErr)
+	return util.AppendErr(errs, h.customizer.Listener().EndReconcile(h.instance, status)).ToError()
+}
+
+// keepPrunePreview copies the prune preview of instance to status, when nothing was pruned.
+func keepPrunePreview(instance *iop.IstioOperator, status *iop.IstioOperatorStatus) {
+	if instance.Status != nil {
+		status.PrunePreview = instance.Status.PrunePreview
+	}
+}
+
+// processRecursive processes the given manifests in the order of the component dependencies returned by the
+// rendering input. Components are processed concurrently once all of their dependencies have been processed and,
+// if they have dependents, they pass their readiness gate once their resources are ready. The components whose
+// gate timed out and their dependents, which are not processed, are reported in the returned error.
+func (h *HelmReconciler) processRecursive(manifests ChartManifestsMap) (*iop.IstioOperatorStatus, error) {
+	componentStatus := make(map[string]*iop.ComponentStatus)
+	// notReady holds the components whose readiness gate timed out, and their dependents.
+	notReady := make(map[name.ComponentName]bool)
 
-	// mu protects the shared InstallStatus componentStatus across goroutines
+	// mu protects the shared InstallStatus componentStatus and notReady across goroutines
 	var mu sync.Mutex
-	// wg waits for all manifest processing goroutines to finish
-	var wg sync.WaitGroup
 
-	for c, m := range manifests {
-		c, m := c, m
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			cn := name.ComponentName(c)
-			if s := dch[cn]; s != nil {
-				log.Infof("%s is waiting on dependency...", c)
-				<-s
-				log.Infof("Dependency for %s has completed, proceeding.", c)
-			}
+	var components []name.ComponentName
+	for c := range manifests {
+		components = append(components, name.ComponentName(c))
+	}
+	deps, err := h.customizer.Input().GetProcessingOrder(manifests)
+	if err == nil {
+		err = deps.Run(components, func(cn name.ComponentName) {
+			c, m := string(cn), manifests[string(cn)]
 
 			// Set status when reconciling starts
 			status := v1alpha1.InstallStatus_RECONCILING
 			mu.Lock()
 			if _, ok := componentStatus[c]; !ok {
-				componentStatus[c] = &v1alpha1.InstallStatus_VersionStatus{}
+				componentStatus[c] = &iop.ComponentStatus{}
 				componentStatus[c].Status = status
 			}
+			blocking := blockingDependency(deps, cn, notReady)
+			if blocking != "" {
+				// Left as reconciling, to be processed once the dependency is available.
+				notReady[cn] = true
+				componentStatus[c].StatusString = v1alpha1.InstallStatus_Status_name[int32(status)]
+				componentStatus[c].Error = fmt.Sprintf("waiting for %s to become ready", blocking)
+			}
 			mu.Unlock()
+			if blocking != "" {
+				return
+			}
 
 			// Process manifests and get the status result
 			errString := ""
+			var ready, desired int32
 			if len(m) == 0 {
 				status = v1alpha1.InstallStatus_NONE
 			} else {
@@ -165,36 +220,67 @@ func (h *HelmReconciler) processRecursive(manifests ChartManifestsMap) *v1alpha1
 				} else if cnt == 0 {
 					status = v1alpha1.InstallStatus_NONE
 				}
+				if status == v1alpha1.InstallStatus_HEALTHY && deps.HasDependents(cn) {
+					if timeout := h.customizer.Input().GetReadinessTimeout(c); timeout > 0 {
+						if err := h.waitForReady(m[0], timeout); err != nil {
+							errString = err.Error()
+							status = v1alpha1.InstallStatus_ERROR
+							mu.Lock()
+							notReady[cn] = true
+							mu.Unlock()
+						}
+					}
+				}
+				if status != v1alpha1.InstallStatus_NONE {
+					ready, desired = h.componentReplicas(m[0])
+				}
 			}
 
 			// Update status based on the result
 			mu.Lock()
+			defer mu.Unlock()
 			if status == v1alpha1.InstallStatus_NONE {
 				delete(componentStatus, c)
-			} else {
-				componentStatus[c].Status = status
-				componentStatus[c].StatusString = v1alpha1.InstallStatus_Status_name[int32(status)]
-				if errString != "" {
-					componentStatus[c].Error = errString
-				}
+				return
 			}
-			mu.Unlock()
-
-			// Signal all the components that depend on us.
-			for _, ch := range deps[cn] {
-				log.Infof("Unblocking dependency %s.", ch)
-				dch[ch] <- struct{}{}
+			cs := componentStatus[c]
+			cs.Status = status
+			cs.StatusString = v1alpha1.InstallStatus_Status_name[int32(status)]
+			cs.ReadyReplicas, cs.DesiredReplicas = ready, desired
+			if errString != "" {
+				now := metav1.Now()
+				cs.Error = errString
+				cs.ErrorTime = &now
+			}
+		})
+	}
+	if err != nil {
+		// Nothing was processed, since the processing order is unknown.
+		log.Errorf("could not determine the processing order of components: %s", err)
+		now := metav1.Now()
+		for _, c := range components {
+			componentStatus[string(c)] = &iop.ComponentStatus{
+				Status:       v1alpha1.InstallStatus_ERROR,
+				StatusString: v1alpha1.InstallStatus_Status_name[int32(v1alpha1.InstallStatus_ERROR)],
+				Error:        err.Error(),
+				ErrorTime:    &now,
 			}
-		}()
+		}
 	}
-	wg.Wait()
 
-	out := &v1alpha1.InstallStatus{
-		//TODO: add overall status logic
+	// The overall status and conditions are computed by the status listener, which has access to the previous status.
+	status := &iop.IstioOperatorStatus{
 		ComponentStatus: componentStatus,
 	}
-
-	return out
+	if len(notReady) == 0 {
+		return status, nil
+	}
+	var held []string
+	for c := range notReady {
+		held = append(held, string(c))
+	}
+	sort.Strings(held)
+	return status, fmt.Errorf("components held back by readiness gates: %s", strings.Join(held, ", "))
 }
 
 // Delete resources associated with the custom resource instance
@@ -250,6 +250,10 @@ func (h *HelmReconciler) ProcessObject(chartName string, obj *unstructured.Unstr
 				}
 			}
 		}
+	} else if err = checkOwnership(receiver, h.customizer.PruningDetails().GetOwnerLabels()); err != nil {
+		if listenerErr := h.customizer.Listener().ResourceError(mutatedObj, err); listenerErr != nil {
+			log.Errorf("unexpected error occurred invoking ResourceError on listener: %s", listenerErr)
+		}
 	} else if h.needUpdateAndPrune {
 		if patch, err = h.CreatePatch(receiver, mutatedObj); err == nil && patch != nil {
 			log.Info("updating existing resource")
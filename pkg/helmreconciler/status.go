@@ -0,0 +1,77 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmreconciler
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/helm/pkg/manifest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"istio.io/operator/pkg/object"
+	"istio.io/pkg/log"
+)
+
+// componentReplicas returns the total number of ready and desired replicas of the Deployments, StatefulSets and
+// DaemonSets in the given manifest, as currently reported by the cluster.
+func (h *HelmReconciler) componentReplicas(m manifest.Manifest) (ready, desired int32) {
+	objects, err := object.ParseK8sObjectsFromYAMLManifest(m.Content)
+	if err != nil {
+		log.Errorf("could not parse manifest %s to get replica counts: %s", m.Name, err)
+		return 0, 0
+	}
+	for _, o := range objects {
+		switch o.Kind {
+		case "Deployment", "StatefulSet", "DaemonSet":
+		default:
+			continue
+		}
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(o.GroupVersionKind())
+		if err := h.client.Get(context.TODO(), client.ObjectKey{Namespace: o.Namespace, Name: o.Name}, live); err != nil {
+			log.Warnf("could not get %s %s/%s to get replica counts: %s", o.Kind, o.Namespace, o.Name, err)
+			desired += specReplicas(o.UnstructuredObject())
+			continue
+		}
+		r, d := workloadReplicas(live)
+		ready += r
+		desired += d
+	}
+	return ready, desired
+}
+
+// workloadReplicas returns the ready and desired replicas of the Deployment, StatefulSet or DaemonSet u.
+func workloadReplicas(u *unstructured.Unstructured) (ready, desired int32) {
+	if u.GetKind() == "DaemonSet" {
+		r, _, _ := unstructured.NestedInt64(u.Object, "status", "numberReady")
+		d, _, _ := unstructured.NestedInt64(u.Object, "status", "desiredNumberScheduled")
+		return int32(r), int32(d)
+	}
+	r, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+	return int32(r), specReplicas(u)
+}
+
+// specReplicas returns spec.replicas of u, which defaults to 1. DaemonSets have no desired count until scheduled.
+func specReplicas(u *unstructured.Unstructured) int32 {
+	if u.GetKind() == "DaemonSet" {
+		return 0
+	}
+	d, found, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !found {
+		return 1
+	}
+	return int32(d)
+}
@@ -0,0 +1,469 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	"github.com/hashicorp/go-version"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/precheck"
+)
+
+const (
+	// mixerAPIVersion is the API version of the mixer config resources.
+	mixerAPIVersion = "config.istio.io/v1alpha2"
+	// proxyContainerName is the name of the sidecar container injected into the pods of the data plane.
+	proxyContainerName = "istio-proxy"
+	// maxListed is the maximum number of objects listed in the message of a result.
+	maxListed = 5
+)
+
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}
+
+// checkInitCRDJobs fails if the istio-init-crd jobs of a non-operator install exist in the Istio namespace.
+func checkInitCRDJobs(kubeClient manifest.ExecClient, hc *HookCommonParams, _ []byte, _ bool) precheck.Results {
+	pl, err := kubeClient.PodsForSelector(hc.IstioNamespace, "")
+	if err != nil {
+		return precheck.Fail("failed to list pods: %s", err)
+	}
+	return precheck.CheckInitCRDPods(pl, hc.IstioNamespace)
+}
+
+// migrateCRDStorageVersionParams are the params of migrateCRDStorageVersion.
+type migrateCRDStorageVersionParams struct {
+	// CRDs are the names of the CRDs whose objects are migrated.
+	CRDs []string `json:"crds"`
+}
+
+// migrateCRDStorageVersion rewrites all objects of each CRD, so that they are stored at the current storage version
+// of the CRD, and then drops the other versions from the stored versions of the CRD. This allows the old versions to
+// be removed from the CRD in a later release.
+func migrateCRDStorageVersion(kubeClient manifest.ExecClient, _ *HookCommonParams, params []byte, dryRun bool) precheck.Results {
+	p := &migrateCRDStorageVersionParams{}
+	if err := decodeParams(params, p); err != nil {
+		return precheck.Fail("%s", err)
+	}
+	var out precheck.Results
+	for _, name := range p.CRDs {
+		out = append(out, migrateCRD(kubeClient, name, dryRun))
+	}
+	return out
+}
+
+func migrateCRD(kubeClient manifest.ExecClient, name string, dryRun bool) *precheck.Result {
+	crd, err := kubeClient.GetResource(crdGVK, "", name)
+	switch {
+	case errors.IsNotFound(err):
+		return precheck.NewResult(precheck.StatusPass, "%s is not installed, nothing to migrate", name)
+	case err != nil:
+		return precheck.NewResult(precheck.StatusFail, "could not get CRD %s: %s", name, err)
+	}
+	storage := storageVersion(crd)
+	if storage == "" {
+		return precheck.NewResult(precheck.StatusFail, "CRD %s has no storage version", name)
+	}
+	stored, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	if len(stored) == 1 && stored[0] == storage {
+		return precheck.NewResult(precheck.StatusPass, "all objects of %s are stored at %s", name, storage)
+	}
+
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	objects, err := kubeClient.ListResources(schema.GroupVersionKind{Group: group, Version: storage, Kind: kind}, "")
+	if err != nil {
+		return precheck.NewResult(precheck.StatusFail, "could not list the objects of %s: %s", name, err)
+	}
+	if dryRun {
+		return precheck.NewResult(precheck.StatusWarn, "would migrate %d objects of %s from %s to %s", len(objects), name,
+			strings.Join(stored, ", "), storage)
+	}
+	for i := range objects {
+		// An unchanged update stores the object again at the storage version.
+		if err := kubeClient.UpdateResource(&objects[i], false); err != nil && !errors.IsNotFound(err) {
+			return precheck.NewResult(precheck.StatusFail, "could not migrate %s %s: %s", kind, objects[i].GetName(), err)
+		}
+	}
+	if err := unstructured.SetNestedStringSlice(crd.Object, []string{storage}, "status", "storedVersions"); err != nil {
+		return precheck.NewResult(precheck.StatusFail, "could not set the stored versions of %s: %s", name, err)
+	}
+	if err := kubeClient.UpdateResource(crd, true); err != nil {
+		return precheck.NewResult(precheck.StatusFail, "could not update the stored versions of %s: %s", name, err)
+	}
+	return precheck.NewResult(precheck.StatusPass, "migrated %d objects of %s to %s", len(objects), name, storage)
+}
+
+// storageVersion returns the version which the objects of crd are stored at.
+func storageVersion(crd *unstructured.Unstructured) string {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		vm, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storage, _ := vm["storage"].(bool); storage {
+			name, _ := vm["name"].(string)
+			return name
+		}
+	}
+	v, _, _ := unstructured.NestedString(crd.Object, "spec", "version")
+	return v
+}
+
+// resourceRef identifies an object.
+type resourceRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Namespace defaults to the Istio namespace. It is ignored for cluster scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// removeDeprecatedResourcesParams are the params of removeDeprecatedResources.
+type removeDeprecatedResourcesParams struct {
+	Resources []resourceRef `json:"resources"`
+}
+
+// removeDeprecatedResources deletes resources which are no longer used by the target version, and which are not
+// pruned since they were not created by the operator.
+func removeDeprecatedResources(kubeClient manifest.ExecClient, hc *HookCommonParams, params []byte, dryRun bool) precheck.Results {
+	p := &removeDeprecatedResourcesParams{}
+	if err := decodeParams(params, p); err != nil {
+		return precheck.Fail("%s", err)
+	}
+	var removed []string
+	var out precheck.Results
+	for _, r := range p.Resources {
+		gvk := schema.FromAPIVersionAndKind(r.APIVersion, r.Kind)
+		namespace := r.Namespace
+		if namespace == "" {
+			namespace = hc.IstioNamespace
+		}
+		id := fmt.Sprintf("%s %s", r.Kind, r.Name)
+		_, err := kubeClient.GetResource(gvk, namespace, r.Name)
+		switch {
+		case errors.IsNotFound(err) || meta.IsNoMatchError(err):
+			continue
+		case err != nil:
+			out = append(out, precheck.NewResult(precheck.StatusFail, "could not get %s: %s", id, err))
+			continue
+		}
+		if !dryRun {
+			if err := kubeClient.DeleteResource(gvk, namespace, r.Name); err != nil {
+				out = append(out, precheck.NewResult(precheck.StatusFail, "could not remove %s: %s", id, err))
+				continue
+			}
+		}
+		removed = append(removed, id)
+	}
+	switch {
+	case len(removed) == 0:
+		if len(out) == 0 {
+			out = append(out, precheck.NewResult(precheck.StatusPass, "no deprecated resources found"))
+		}
+	case dryRun:
+		out = append(out, precheck.NewResult(precheck.StatusWarn, "would remove deprecated resources: %s", summarize(removed)))
+	default:
+		out = append(out, precheck.NewResult(precheck.StatusPass, "removed deprecated resources: %s", summarize(removed)))
+	}
+	return out
+}
+
+// convertMixerConfigParams are the params of convertMixerConfig.
+type convertMixerConfigParams struct {
+	// Adapters and Templates are the kinds of the legacy mixer adapter and template resources.
+	Adapters  []string `json:"adapters"`
+	Templates []string `json:"templates"`
+}
+
+// convertMixerConfig converts the mixer config resources of legacy adapter and template kinds, e.g. a prometheus
+// resource, to handler and instance resources of the compiled adapter and template, and updates the references to them
+// in rules. The legacy kinds are no longer supported by the target version. The converted resources are named
+// name-kind after the legacy resources, since legacy resources of different kinds may have the same name. Converted
+// resources which already exist are updated, so the conversion can be run again if it failed part way.
+func convertMixerConfig(kubeClient manifest.ExecClient, _ *HookCommonParams, params []byte, dryRun bool) precheck.Results {
+	p := &convertMixerConfigParams{}
+	if err := decodeParams(params, p); err != nil {
+		return precheck.Fail("%s", err)
+	}
+	adapters, templates := make(map[string]bool), make(map[string]bool)
+	var legacy []unstructured.Unstructured
+	for _, c := range []struct {
+		kinds     []string
+		seen      map[string]bool
+		kind      string
+		kindField string
+	}{
+		{kinds: p.Adapters, seen: adapters, kind: "handler", kindField: "compiledAdapter"},
+		{kinds: p.Templates, seen: templates, kind: "instance", kindField: "compiledTemplate"},
+	} {
+		for _, k := range c.kinds {
+			c.seen[k] = true
+			objects, err := kubeClient.ListResources(mixerGVK(k), "")
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			if err != nil {
+				return precheck.Fail("could not list the %s resources: %s", k, err)
+			}
+			for i := range objects {
+				if !dryRun {
+					if err := createOrUpdate(kubeClient, convertMixerResource(&objects[i], c.kind, c.kindField)); err != nil {
+						return precheck.Fail("could not convert %s %s/%s: %s", k, objects[i].GetNamespace(), objects[i].GetName(), err)
+					}
+				}
+				legacy = append(legacy, objects[i])
+			}
+		}
+	}
+
+	rules, err := kubeClient.ListResources(mixerGVK("rule"), "")
+	if err != nil && !meta.IsNoMatchError(err) {
+		return precheck.Fail("could not list the rule resources: %s", err)
+	}
+	converted := 0
+	for i := range rules {
+		if !convertRule(&rules[i], adapters, templates) {
+			continue
+		}
+		converted++
+		if dryRun {
+			continue
+		}
+		if err := kubeClient.UpdateResource(&rules[i], false); err != nil {
+			return precheck.Fail("could not convert rule %s/%s: %s", rules[i].GetNamespace(), rules[i].GetName(), err)
+		}
+	}
+
+	if !dryRun {
+		// The legacy resources are only deleted once no rule references them.
+		for _, o := range legacy {
+			if err := kubeClient.DeleteResource(o.GroupVersionKind(), o.GetNamespace(), o.GetName()); err != nil {
+				return precheck.Fail("could not remove %s %s/%s: %s", o.GetKind(), o.GetNamespace(), o.GetName(), err)
+			}
+		}
+	}
+	switch {
+	case len(legacy) == 0 && converted == 0:
+		return precheck.Pass("no legacy mixer adapter or template resources found")
+	case dryRun:
+		return precheck.Warn("would convert %d legacy mixer resources and %d rules", len(legacy), converted)
+	}
+	return precheck.Pass("converted %d legacy mixer resources and %d rules", len(legacy), converted)
+}
+
+func mixerGVK(kind string) schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(mixerAPIVersion, kind)
+}
+
+// createOrUpdate creates o, or updates the spec of the existing resource with the same name.
+func createOrUpdate(kubeClient manifest.ExecClient, o *unstructured.Unstructured) error {
+	err := kubeClient.CreateResource(o)
+	if !errors.IsAlreadyExists(err) {
+		return err
+	}
+	cur, err := kubeClient.GetResource(o.GroupVersionKind(), o.GetNamespace(), o.GetName())
+	if err != nil {
+		return err
+	}
+	cur.Object["spec"] = o.Object["spec"]
+	return kubeClient.UpdateResource(cur, false)
+}
+
+// convertedName returns the name of the resource replacing the legacy resource name of the given kind.
+func convertedName(name, kind string) string {
+	return name + "-" + kind
+}
+
+// convertMixerResource returns the resource of the given kind which replaces the legacy resource o. The legacy kind
+// is set in kindField of the spec, and the legacy spec becomes the params of the new resource.
+func convertMixerResource(o *unstructured.Unstructured, kind, kindField string) *unstructured.Unstructured {
+	spec, _, _ := unstructured.NestedMap(o.Object, "spec")
+	out := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			kindField: o.GetKind(),
+			"params":  spec,
+		},
+	}}
+	out.SetGroupVersionKind(mixerGVK(kind))
+	out.SetNamespace(o.GetNamespace())
+	out.SetName(convertedName(o.GetName(), o.GetKind()))
+	out.SetLabels(o.GetLabels())
+	out.SetAnnotations(o.GetAnnotations())
+	return out
+}
+
+// convertRule rewrites the references of rule to legacy adapter and template resources, which have the form
+// name.kind[.namespace], to references to the handler and instance resources replacing them. It returns true if rule
+// was changed.
+func convertRule(rule *unstructured.Unstructured, adapters, templates map[string]bool) bool {
+	actions, _, _ := unstructured.NestedSlice(rule.Object, "spec", "actions")
+	changed := false
+	for _, a := range actions {
+		am, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if h, ok := am["handler"].(string); ok {
+			if ref, ok := convertRef(h, adapters); ok {
+				am["handler"], changed = ref, true
+			}
+		}
+		instances, _ := am["instances"].([]interface{})
+		for i, in := range instances {
+			if s, ok := in.(string); ok {
+				if ref, ok := convertRef(s, templates); ok {
+					instances[i], changed = ref, true
+				}
+			}
+		}
+	}
+	if changed {
+		_ = unstructured.SetNestedSlice(rule.Object, actions, "spec", "actions")
+	}
+	return changed
+}
+
+// convertRef returns the reference to the resource replacing the legacy resource referenced by ref, if ref has the
+// form name.kind[.namespace] with a kind in kinds.
+func convertRef(ref string, kinds map[string]bool) (string, bool) {
+	parts := strings.Split(ref, ".")
+	if len(parts) < 2 || !kinds[parts[1]] {
+		return ref, false
+	}
+	return strings.Join(append([]string{convertedName(parts[0], parts[1])}, parts[2:]...), "."), true
+}
+
+// verifySidecarVersionsParams are the params of verifySidecarVersions.
+type verifySidecarVersionsParams struct {
+	// MaxMinorSkew is the number of minor versions which sidecars may be behind the target version. Older sidecars
+	// fail the hook. If unset, sidecars of any older version only cause a warning.
+	MaxMinorSkew *int `json:"maxMinorSkew,omitempty"`
+}
+
+// verifySidecarVersions checks the versions of the sidecars of the data plane against the target version. Sidecars
+// which are older than the target version must be restarted to be upgraded.
+func verifySidecarVersions(kubeClient manifest.ExecClient, hc *HookCommonParams, params []byte, _ bool) precheck.Results {
+	p := &verifySidecarVersionsParams{}
+	if err := decodeParams(params, p); err != nil {
+		return precheck.Fail("%s", err)
+	}
+	target, err := version.NewVersion(hc.TargetVer)
+	if err != nil {
+		return precheck.Fail("could not parse the target version %s: %s", hc.TargetVer, err)
+	}
+	pl, err := kubeClient.PodsForSelector("", "")
+	if err != nil {
+		return precheck.Fail("failed to list pods: %s", err)
+	}
+
+	var outdated, tooOld, unknown []string
+	sidecars := 0
+	for _, pod := range pl.Items {
+		// The gateways in the Istio namespace are upgraded with the control plane.
+		if pod.Namespace == hc.IstioNamespace {
+			continue
+		}
+		for _, c := range pod.Spec.Containers {
+			if c.Name != proxyContainerName {
+				continue
+			}
+			sidecars++
+			id := pod.Namespace + "/" + pod.Name
+			ver, err := imageVersion(c.Image)
+			if err != nil {
+				unknown = append(unknown, id)
+				continue
+			}
+			if ver.Equal(target) {
+				continue
+			}
+			id = fmt.Sprintf("%s (%s)", id, ver)
+			if p.MaxMinorSkew != nil && minorSkew(ver, target) > *p.MaxMinorSkew {
+				tooOld = append(tooOld, id)
+			} else {
+				outdated = append(outdated, id)
+			}
+		}
+	}
+
+	var out precheck.Results
+	if len(tooOld) != 0 {
+		out = append(out, precheck.NewResult(precheck.StatusFail, "%d sidecars are more than %d minor versions older than %s, "+
+			"restart their pods after upgrading the injector: %s", len(tooOld), *p.MaxMinorSkew, target, summarize(tooOld)))
+	}
+	if len(outdated) != 0 {
+		out = append(out, precheck.NewResult(precheck.StatusWarn, "%d sidecars do not run %s, restart their pods to upgrade "+
+			"them: %s", len(outdated), target, summarize(outdated)))
+	}
+	if len(unknown) != 0 {
+		out = append(out, precheck.NewResult(precheck.StatusWarn, "could not read the version of %d sidecars: %s", len(unknown),
+			summarize(unknown)))
+	}
+	if len(out) == 0 {
+		out = append(out, precheck.NewResult(precheck.StatusPass, "all %d sidecars run %s", sidecars, target))
+	}
+	return out
+}
+
+// imageVersion returns the version in the tag of image.
+func imageVersion(image string) (*version.Version, error) {
+	ref, err := reference.Parse(image)
+	if err != nil {
+		return nil, err
+	}
+	tagged, ok := ref.(reference.Tagged)
+	if !ok {
+		return nil, fmt.Errorf("tag not found in image: %s", image)
+	}
+	return version.NewVersion(tagged.Tag())
+}
+
+// minorSkew returns the number of minor versions that ver is behind target. A different major version counts as an
+// unlimited skew.
+func minorSkew(ver, target *version.Version) int {
+	vs, ts := ver.Segments(), target.Segments()
+	if vs[0] != ts[0] {
+		return int(^uint(0) >> 1)
+	}
+	return ts[1] - vs[1]
+}
+
+// decodeParams decodes the JSON encoded params of a hook into out. Empty params leave out unchanged.
+func decodeParams(params []byte, out interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, out); err != nil {
+		return fmt.Errorf("invalid hook params: %s", err)
+	}
+	return nil
+}
+
+// summarize lists the first few items, followed by the number of the others.
+func summarize(items []string) string {
+	if len(items) <= maxListed {
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(items[:maxListed], ", "), len(items)-maxListed)
+}
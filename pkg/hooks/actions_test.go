@@ -0,0 +1,448 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/precheck"
+)
+
+// fakeExecClient is a manifest.ExecClient holding pods and objects in memory, which records the changes made to the
+// objects.
+type fakeExecClient struct {
+	pods    []v1.Pod
+	objects []*unstructured.Unstructured
+	// unserved are the kinds which are not served by the cluster.
+	unserved map[string]bool
+	// actions records the changes, e.g. "delete DaemonSet istio-system/istio-nodeagent".
+	actions []string
+}
+
+var _ manifest.ExecClient = &fakeExecClient{}
+
+func (f *fakeExecClient) GetIstioVersions(string) ([]manifest.ComponentVersion, error) {
+	return nil, nil
+}
+
+func (f *fakeExecClient) GetPods(namespace string, _ map[string]string) (*v1.PodList, error) {
+	return f.PodsForSelector(namespace, "")
+}
+
+func (f *fakeExecClient) PodsForSelector(namespace, _ string) (*v1.PodList, error) {
+	out := &v1.PodList{}
+	for _, p := range f.pods {
+		if namespace == "" || p.Namespace == namespace {
+			out.Items = append(out.Items, p)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeExecClient) ConfigMapForSelector(string, string) (*v1.ConfigMapList, error) {
+	return &v1.ConfigMapList{}, nil
+}
+
+func (f *fakeExecClient) GetResource(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	if f.unserved[gvk.Kind] {
+		return nil, &meta.NoKindMatchError{GroupKind: gvk.GroupKind()}
+	}
+	i := f.find(gvk.Kind, namespace, name)
+	if i < 0 {
+		return nil, errors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, name)
+	}
+	return f.objects[i].DeepCopy(), nil
+}
+
+func (f *fakeExecClient) ListResources(gvk schema.GroupVersionKind, namespace string) ([]unstructured.Unstructured, error) {
+	if f.unserved[gvk.Kind] {
+		return nil, &meta.NoKindMatchError{GroupKind: gvk.GroupKind()}
+	}
+	var out []unstructured.Unstructured
+	for _, o := range f.objects {
+		if o.GetKind() == gvk.Kind && (namespace == "" || o.GetNamespace() == namespace) {
+			out = append(out, *o.DeepCopy())
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeExecClient) CreateResource(obj *unstructured.Unstructured) error {
+	if f.find(obj.GetKind(), obj.GetNamespace(), obj.GetName()) >= 0 {
+		return errors.NewAlreadyExists(schema.GroupResource{Resource: obj.GetKind()}, obj.GetName())
+	}
+	f.actions = append(f.actions, "create "+objectID(obj))
+	f.objects = append(f.objects, obj.DeepCopy())
+	return nil
+}
+
+func (f *fakeExecClient) UpdateResource(obj *unstructured.Unstructured, status bool) error {
+	i := f.find(obj.GetKind(), obj.GetNamespace(), obj.GetName())
+	if i < 0 {
+		return errors.NewNotFound(schema.GroupResource{Resource: obj.GetKind()}, obj.GetName())
+	}
+	action := "update "
+	if status {
+		action = "update status "
+	}
+	f.actions = append(f.actions, action+objectID(obj))
+	f.objects[i] = obj.DeepCopy()
+	return nil
+}
+
+func (f *fakeExecClient) DeleteResource(gvk schema.GroupVersionKind, namespace, name string) error {
+	i := f.find(gvk.Kind, namespace, name)
+	if i < 0 {
+		return nil
+	}
+	f.actions = append(f.actions, "delete "+objectID(f.objects[i]))
+	f.objects = append(f.objects[:i], f.objects[i+1:]...)
+	return nil
+}
+
+// find returns the index of the object with the given kind, namespace and name, or -1. Cluster scoped objects, which
+// have no namespace, match any namespace.
+func (f *fakeExecClient) find(kind, namespace, name string) int {
+	for i, o := range f.objects {
+		if o.GetKind() == kind && o.GetName() == name && (o.GetNamespace() == "" || o.GetNamespace() == namespace) {
+			return i
+		}
+	}
+	return -1
+}
+
+func objectID(o *unstructured.Unstructured) string {
+	if o.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", o.GetKind(), o.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", o.GetKind(), o.GetNamespace(), o.GetName())
+}
+
+func TestMigrateCRDStorageVersion(t *testing.T) {
+	kc := &fakeExecClient{objects: mustParseObjects(t, `
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: authorizationpolicies.security.istio.io
+spec:
+  group: security.istio.io
+  names:
+    kind: AuthorizationPolicy
+  versions:
+  - name: v1alpha1
+    storage: false
+  - name: v1beta1
+    storage: true
+status:
+  storedVersions: [v1alpha1, v1beta1]
+---
+apiVersion: security.istio.io/v1beta1
+kind: AuthorizationPolicy
+metadata:
+  name: deny-all
+  namespace: default
+`)}
+	params := []byte(`{"crds": ["authorizationpolicies.security.istio.io", "missing.istio.io"]}`)
+
+	got := migrateCRDStorageVersion(kc, &HookCommonParams{}, params, true)
+	assertResults(t, got, []string{
+		"Warn would migrate 1 objects of authorizationpolicies.security.istio.io from v1alpha1, v1beta1 to v1beta1",
+		"Pass missing.istio.io is not installed, nothing to migrate",
+	})
+	if len(kc.actions) != 0 {
+		t.Fatalf("got changes %v with dry-run", kc.actions)
+	}
+
+	got = migrateCRDStorageVersion(kc, &HookCommonParams{}, params, false)
+	assertResults(t, got, []string{
+		"Pass migrated 1 objects of authorizationpolicies.security.istio.io to v1beta1",
+		"Pass missing.istio.io is not installed, nothing to migrate",
+	})
+	assertActions(t, kc, []string{
+		"update AuthorizationPolicy default/deny-all",
+		"update status CustomResourceDefinition authorizationpolicies.security.istio.io",
+	})
+	stored, _, _ := unstructured.NestedStringSlice(kc.objects[0].Object, "status", "storedVersions")
+	if !reflect.DeepEqual(stored, []string{"v1beta1"}) {
+		t.Errorf("got stored versions %v, want [v1beta1]", stored)
+	}
+}
+
+func TestRemoveDeprecatedResources(t *testing.T) {
+	kc := &fakeExecClient{
+		objects: mustParseObjects(t, `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: istio-nodeagent
+  namespace: istio-system
+`),
+		unserved: map[string]bool{"Widget": true},
+	}
+	hc := &HookCommonParams{IstioNamespace: "istio-system"}
+	params := []byte(`{"resources": [
+{"apiVersion": "apps/v1", "kind": "DaemonSet", "name": "istio-nodeagent"},
+{"apiVersion": "v1", "kind": "ServiceAccount", "name": "istio-nodeagent-service-account"},
+{"apiVersion": "example.com/v1", "kind": "Widget", "name": "unserved"}]}`)
+
+	got := removeDeprecatedResources(kc, hc, params, true)
+	assertResults(t, got, []string{"Warn would remove deprecated resources: DaemonSet istio-nodeagent"})
+	assertActions(t, kc, nil)
+
+	got = removeDeprecatedResources(kc, hc, params, false)
+	assertResults(t, got, []string{"Pass removed deprecated resources: DaemonSet istio-nodeagent"})
+	assertActions(t, kc, []string{"delete DaemonSet istio-system/istio-nodeagent"})
+
+	got = removeDeprecatedResources(kc, hc, params, false)
+	assertResults(t, got, []string{"Pass no deprecated resources found"})
+}
+
+func TestConvertMixerConfig(t *testing.T) {
+	kc := &fakeExecClient{
+		objects: mustParseObjects(t, `
+apiVersion: config.istio.io/v1alpha2
+kind: prometheus
+metadata:
+  name: handler
+  namespace: istio-system
+spec:
+  metrics:
+  - name: requests_total
+---
+apiVersion: config.istio.io/v1alpha2
+kind: metric
+metadata:
+  name: requestcount
+  namespace: istio-system
+spec:
+  value: "1"
+---
+apiVersion: config.istio.io/v1alpha2
+kind: logentry
+metadata:
+  name: requestcount
+  namespace: istio-system
+spec:
+  severity: '"Info"'
+---
+apiVersion: config.istio.io/v1alpha2
+kind: rule
+metadata:
+  name: promhttp
+  namespace: istio-system
+spec:
+  actions:
+  - handler: handler.prometheus
+    instances:
+    - requestcount.metric.istio-system
+    - requestcount.logentry
+    - requestsize.instance
+`),
+		unserved: map[string]bool{"stdio": true},
+	}
+	legacy := []*unstructured.Unstructured{kc.objects[0].DeepCopy(), kc.objects[1].DeepCopy(), kc.objects[2].DeepCopy()}
+	params := []byte(`{"adapters": ["prometheus", "stdio"], "templates": ["metric", "logentry"]}`)
+
+	got := convertMixerConfig(kc, &HookCommonParams{}, params, true)
+	assertResults(t, got, []string{"Warn would convert 3 legacy mixer resources and 1 rules"})
+	assertActions(t, kc, nil)
+
+	got = convertMixerConfig(kc, &HookCommonParams{}, params, false)
+	assertResults(t, got, []string{"Pass converted 3 legacy mixer resources and 1 rules"})
+	assertActions(t, kc, []string{
+		"create handler istio-system/handler-prometheus",
+		"create instance istio-system/requestcount-metric",
+		"create instance istio-system/requestcount-logentry",
+		"update rule istio-system/promhttp",
+		"delete prometheus istio-system/handler",
+		"delete metric istio-system/requestcount",
+		"delete logentry istio-system/requestcount",
+	})
+
+	want := mustParseObjects(t, `
+apiVersion: config.istio.io/v1alpha2
+kind: rule
+metadata:
+  name: promhttp
+  namespace: istio-system
+spec:
+  actions:
+  - handler: handler-prometheus
+    instances:
+    - requestcount-metric.istio-system
+    - requestcount-logentry
+    - requestsize.instance
+---
+apiVersion: config.istio.io/v1alpha2
+kind: handler
+metadata:
+  name: handler-prometheus
+  namespace: istio-system
+spec:
+  compiledAdapter: prometheus
+  params:
+    metrics:
+    - name: requests_total
+---
+apiVersion: config.istio.io/v1alpha2
+kind: instance
+metadata:
+  name: requestcount-metric
+  namespace: istio-system
+spec:
+  compiledTemplate: metric
+  params:
+    value: "1"
+---
+apiVersion: config.istio.io/v1alpha2
+kind: instance
+metadata:
+  name: requestcount-logentry
+  namespace: istio-system
+spec:
+  compiledTemplate: logentry
+  params:
+    severity: '"Info"'
+`)
+	assertObjects(t, kc, want)
+
+	// A conversion which failed before the legacy resources were deleted is completed by running it again.
+	kc.objects = append(kc.objects, legacy...)
+	got = convertMixerConfig(kc, &HookCommonParams{}, params, false)
+	assertResults(t, got, []string{"Pass converted 3 legacy mixer resources and 0 rules"})
+	assertActions(t, kc, []string{
+		"update handler istio-system/handler-prometheus",
+		"update instance istio-system/requestcount-metric",
+		"update instance istio-system/requestcount-logentry",
+		"delete prometheus istio-system/handler",
+		"delete metric istio-system/requestcount",
+		"delete logentry istio-system/requestcount",
+	})
+	assertObjects(t, kc, want)
+}
+
+// assertObjects checks that kc holds the objects in want, in order.
+func assertObjects(t *testing.T, kc *fakeExecClient, want []*unstructured.Unstructured) {
+	t.Helper()
+	if len(kc.objects) != len(want) {
+		t.Fatalf("got %d objects, want %d", len(kc.objects), len(want))
+	}
+	for i := range want {
+		gotYAML, _ := yaml.Marshal(kc.objects[i].Object)
+		wantYAML, _ := yaml.Marshal(want[i].Object)
+		if string(gotYAML) != string(wantYAML) {
+			t.Errorf("got object:\n%s\nwant:\n%s", gotYAML, wantYAML)
+		}
+	}
+}
+
+func TestVerifySidecarVersions(t *testing.T) {
+	pod := func(namespace, name, image string) v1.Pod {
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "app", Image: "example.com/app:2.0.0"},
+				{Name: proxyContainerName, Image: image},
+			}},
+		}
+	}
+	kc := &fakeExecClient{pods: []v1.Pod{
+		pod("default", "current", "docker.io/istio/proxyv2:1.5.0"),
+		pod("default", "previous", "docker.io/istio/proxyv2:1.4.3"),
+		pod("default", "ancient", "docker.io/istio/proxyv2:1.2.0"),
+		pod("default", "custom", "docker.io/istio/proxyv2:latest"),
+		pod("istio-system", "istio-ingressgateway", "docker.io/istio/proxyv2:1.1.0"),
+	}}
+	hc := &HookCommonParams{TargetVer: "1.5.0", IstioNamespace: "istio-system"}
+
+	got := verifySidecarVersions(kc, hc, nil, false)
+	assertResults(t, got, []string{
+		"Warn 2 sidecars do not run 1.5.0, restart their pods to upgrade them: default/previous (1.4.3), " +
+			"default/ancient (1.2.0)",
+		"Warn could not read the version of 1 sidecars: default/custom",
+	})
+
+	got = verifySidecarVersions(kc, hc, []byte(`{"maxMinorSkew": 2}`), false)
+	assertResults(t, got, []string{
+		"Fail 1 sidecars are more than 2 minor versions older than 1.5.0, restart their pods after upgrading the " +
+			"injector: default/ancient (1.2.0)",
+		"Warn 1 sidecars do not run 1.5.0, restart their pods to upgrade them: default/previous (1.4.3)",
+		"Warn could not read the version of 1 sidecars: default/custom",
+	})
+
+	kc.pods = kc.pods[:1]
+	got = verifySidecarVersions(kc, hc, nil, false)
+	assertResults(t, got, []string{"Pass all 1 sidecars run 1.5.0"})
+}
+
+func TestCheckInitCRDJobs(t *testing.T) {
+	kc := &fakeExecClient{pods: []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-init-crd-10-1.4.3-abcde"}},
+	}}
+	got := checkInitCRDJobs(kc, &HookCommonParams{IstioNamespace: "istio-system"}, nil, false)
+	assertResults(t, got, []string{"Fail istio-init-crd pods exist: istio-init-crd-10-1.4.3-abcde. Istio was " +
+		"installed with non-operator methods, please migrate to operator installation first"})
+
+	got = checkInitCRDJobs(kc, &HookCommonParams{IstioNamespace: "istio-control"}, nil, false)
+	assertResults(t, got, []string{"Pass no istio-init-crd pods found in istio-control"})
+}
+
+func assertResults(t *testing.T, results precheck.Results, want []string) {
+	t.Helper()
+	var got []string
+	for _, r := range results {
+		got = append(got, string(r.Status)+" "+r.Message)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got results:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// assertActions checks the changes recorded by kc since the last call.
+func assertActions(t *testing.T, kc *fakeExecClient, want []string) {
+	t.Helper()
+	if !reflect.DeepEqual(kc.actions, want) {
+		t.Errorf("got changes:\n%s\nwant:\n%s", strings.Join(kc.actions, "\n"), strings.Join(want, "\n"))
+	}
+	kc.actions = nil
+}
+
+func mustParseObjects(t *testing.T, y string) []*unstructured.Unstructured {
+	var out []*unstructured.Unstructured
+	for _, doc := range strings.Split(y, "\n---\n") {
+		j, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(j); err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, u)
+	}
+	return out
+}
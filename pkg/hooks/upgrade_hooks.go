@@ -15,30 +15,47 @@
 package hooks
 
 import (
+	"encoding/json"
 	"fmt"
-	"reflect"
-	"runtime"
-	"strings"
+	"sync"
 
 	"github.com/hashicorp/go-version"
+	"sigs.k8s.io/yaml"
 
 	"istio.io/api/operator/v1alpha1"
 	"istio.io/operator/pkg/manifest"
-	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/precheck"
+	"istio.io/operator/pkg/vfs"
 	"istio.io/pkg/log"
 )
 
-// hook is a callout function that may be called during an upgrade to check state or modify the cluster.
-// hooks should only be used for version-specific actions.
-type hook func(kubeClient manifest.ExecClient, sourceIOPS, targetIOPS *v1alpha1.IstioOperatorSpec) util.Errors
-type hooks []hook
-
-// hookVersionMapping is a mapping between a hashicorp/go-version formatted constraints for the source and target
-// versions and the list of hooks that should be run if the constraints match.
-type hookVersionMapping struct {
-	sourceVersionConstraint string
-	targetVersionConstraint string
-	hooks                   hooks
+// registryFile is the file in the installation package vfs holding the built-in hooks.
+const registryFile = "upgrade-hooks.yaml"
+
+// Action is a built-in callout that a hook may run during an upgrade to check state or modify the cluster. params
+// holds the JSON encoded params of the hook. If dryRun is set, the cluster must not be modified, and the changes
+// which would have been made are reported instead.
+type Action func(kubeClient manifest.ExecClient, hc *HookCommonParams, params []byte, dryRun bool) precheck.Results
+
+// Hook is an entry of the hook registry. It runs an action if the source and target versions of the upgrade match its
+// constraints. hooks should only be used for version-specific actions.
+type Hook struct {
+	// Name identifies the hook in its results.
+	Name string `json:"name"`
+	// SourceVersions and TargetVersions are hashicorp/go-version formatted constraints for the source and target
+	// versions of the upgrade.
+	SourceVersions string `json:"sourceVersions"`
+	TargetVersions string `json:"targetVersions"`
+	// Action is the name of the built-in action run by the hook.
+	Action string `json:"action"`
+	// Params are the params of the action.
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// Registry holds the hooks run before and after an upgrade, in order.
+type Registry struct {
+	PreUpgrade  []*Hook `json:"preUpgrade,omitempty"`
+	PostUpgrade []*Hook `json:"postUpgrade,omitempty"`
 }
 
 // HookCommonParams is a set of common params passed to all hooks.
@@ -47,84 +64,155 @@ type HookCommonParams struct {
 	TargetVer  string
 	SourceIOPS *v1alpha1.IstioOperatorSpec
 	TargetIOPS *v1alpha1.IstioOperatorSpec
+	// IstioNamespace is the namespace of the control plane.
+	IstioNamespace string
 }
 
 var (
-	// preUpgradeHooks is a list of hook version constraint pairs mapping to a slide of corresponding hooks to run
-	// before upgrade.
-	preUpgradeHooks = []hookVersionMapping{
-		{
-			sourceVersionConstraint: ">=1.3",
-			targetVersionConstraint: ">=1.3",
-			hooks:                   []hook{checkInitCrdJobs},
-		},
-	}
-	// postUpgradeHooks is a list of hook version constraint pairs mapping to a slide of corresponding hooks to run
-	// before upgrade.
-	postUpgradeHooks []hookVersionMapping
+	// actions holds the built-in actions by name.
+	actions = map[string]Action{
+		"checkInitCRDJobs":          checkInitCRDJobs,
+		"migrateCRDStorageVersion":  migrateCRDStorageVersion,
+		"removeDeprecatedResources": removeDeprecatedResources,
+		"convertMixerConfig":        convertMixerConfig,
+		"verifySidecarVersions":     verifySidecarVersions,
+	}
+	actionsMu sync.RWMutex
 )
 
-func RunPreUpgradeHooks(kubeClient manifest.ExecClient, hc *HookCommonParams, dryRun bool) util.Errors {
-	return runUpgradeHooks(preUpgradeHooks, kubeClient, hc, dryRun)
+// ParseRegistry parses a YAML formatted hook registry and checks that all its hooks are valid.
+func ParseRegistry(b []byte) (*Registry, error) {
+	r := &Registry{}
+	if err := yaml.UnmarshalStrict(b, r); err != nil {
+		return nil, fmt.Errorf("could not parse the hook registry: %s", err)
+	}
+	for _, h := range append(append([]*Hook{}, r.PreUpgrade...), r.PostUpgrade...) {
+		if err := h.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
 }
 
-func RunPostUpgradeHooks(kubeClient manifest.ExecClient, hc *HookCommonParams, dryRun bool) util.Errors {
-	return runUpgradeHooks(postUpgradeHooks, kubeClient, hc, dryRun)
+// DefaultRegistry returns the built-in hook registry of the installation package.
+func DefaultRegistry() (*Registry, error) {
+	b, err := vfs.ReadFile(registryFile)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegistry(b)
 }
 
-// runUpgradeHooks checks a list of hook version map entries and runs the hooks in each entry whose constraints match
-// the source/target versions in hc.
-func runUpgradeHooks(hml []hookVersionMapping, kubeClient manifest.ExecClient, hc *HookCommonParams, dryRun bool) util.Errors {
-	var errs util.Errors
-	_, err := version.NewVersion(hc.SourceVer)
-	if err != nil {
-		return util.NewErrs(err)
+// Merge appends the hooks of other to r.
+func (r *Registry) Merge(other *Registry) {
+	r.PreUpgrade = append(r.PreUpgrade, other.PreUpgrade...)
+	r.PostUpgrade = append(r.PostUpgrade, other.PostUpgrade...)
+}
+
+// RunPreUpgradeHooks runs the pre-upgrade hooks of r which match the versions in hc, and returns their results.
+func (r *Registry) RunPreUpgradeHooks(kubeClient manifest.ExecClient, hc *HookCommonParams, dryRun bool) (precheck.Results, error) {
+	return runUpgradeHooks(r.PreUpgrade, kubeClient, hc, dryRun)
+}
+
+// RunPostUpgradeHooks runs the post-upgrade hooks of r which match the versions in hc, and returns their results.
+func (r *Registry) RunPostUpgradeHooks(kubeClient manifest.ExecClient, hc *HookCommonParams, dryRun bool) (precheck.Results, error) {
+	return runUpgradeHooks(r.PostUpgrade, kubeClient, hc, dryRun)
+}
+
+// HasPostUpgradeHooks reports whether any post-upgrade hook of r matches the versions in hc.
+func (r *Registry) HasPostUpgradeHooks(hc *HookCommonParams) (bool, error) {
+	for _, h := range r.PostUpgrade {
+		matches, err := h.matches(hc)
+		if err != nil || matches {
+			return matches, err
+		}
 	}
-	_, err = version.NewVersion(hc.TargetVer)
-	if err != nil {
-		return util.NewErrs(err)
+	return false, nil
+}
+
+// runUpgradeHooks runs each hook in hl whose constraints match the source/target versions in hc. The results of each
+// hook are named after the hook. An error is returned if the versions in hc are malformed.
+func runUpgradeHooks(hl []*Hook, kubeClient manifest.ExecClient, hc *HookCommonParams, dryRun bool) (precheck.Results, error) {
+	if _, err := version.NewVersion(hc.SourceVer); err != nil {
+		return nil, err
+	}
+	if _, err := version.NewVersion(hc.TargetVer); err != nil {
+		return nil, err
 	}
 
-	for _, h := range hml {
-		matches, err := checkHookListEntry(h, hc)
+	var out precheck.Results
+	for _, h := range hl {
+		matches, err := h.matches(hc)
 		if err != nil {
-			errs = util.AppendErr(errs, err)
-			continue
+			return nil, err
 		}
 		if !matches {
 			continue
 		}
-		log.Infof("Running the following hooks which match source->target versions %s->%s: %s", hc.SourceVer, hc.TargetVer, h.hooks)
-		if dryRun {
-			log.Info("(Skipping running hooks due to dry-run being set.)")
-			continue
+		a, err := lookupAction(h.Action)
+		if err != nil {
+			return nil, err
 		}
-		for _, hf := range h.hooks {
-			log.Infof("Running hook %s", hf)
-			errs = util.AppendErrs(errs, hf(kubeClient, hc.SourceIOPS, hc.TargetIOPS))
+		log.Infof("Running hook %s, which matches source->target versions %s->%s", h.Name, hc.SourceVer, hc.TargetVer)
+		for _, res := range a(kubeClient, hc, h.Params, dryRun) {
+			res.Check = h.Name
+			out = append(out, res)
 		}
 	}
-	return errs
+	return out, nil
 }
 
-// checkHookListEntry checks a hookVersionMapping against the source/target versions in hc and returns true if it
-// matches.
-func checkHookListEntry(h hookVersionMapping, hc *HookCommonParams) (bool, error) {
-	ch, err := checkConstraint(hc.SourceVer, h.sourceVersionConstraint)
+// RegisterAction adds a built-in action with the given name, which hooks can then run. Any action already registered
+// with that name is replaced.
+func RegisterAction(name string, a Action) {
+	actionsMu.Lock()
+	defer actionsMu.Unlock()
+	actions[name] = a
+}
+
+func lookupAction(name string) (Action, error) {
+	actionsMu.RLock()
+	defer actionsMu.RUnlock()
+	a, ok := actions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown hook action %q", name)
+	}
+	return a, nil
+}
+
+// validate checks that h has a name, valid constraints and a known action.
+func (h *Hook) validate() error {
+	if h.Name == "" {
+		return fmt.Errorf("hook with action %q has no name", h.Action)
+	}
+	for _, c := range []string{h.SourceVersions, h.TargetVersions} {
+		if _, err := version.NewConstraint(c); err != nil {
+			return fmt.Errorf("hook %s: %s", h.Name, err)
+		}
+	}
+	if _, err := lookupAction(h.Action); err != nil {
+		return fmt.Errorf("hook %s: %s", h.Name, err)
+	}
+	return nil
+}
+
+// matches checks h against the source/target versions in hc and returns true if it matches.
+func (h *Hook) matches(hc *HookCommonParams) (bool, error) {
+	ch, err := checkConstraint(hc.SourceVer, h.SourceVersions)
 	if err != nil {
 		return false, err
 	}
 	if !ch {
-		log.Infof("Source version %s does not satisfy source constraint %s, skip hooks", hc.SourceVer, h.sourceVersionConstraint)
+		log.Infof("Source version %s does not satisfy source constraint %s, skip hook %s", hc.SourceVer, h.SourceVersions, h.Name)
 		return false, nil
 	}
 
-	ch, err = checkConstraint(hc.TargetVer, h.targetVersionConstraint)
+	ch, err = checkConstraint(hc.TargetVer, h.TargetVersions)
 	if err != nil {
 		return false, err
 	}
 	if !ch {
-		log.Infof("Target version %s does not satisfy target constraint %s, skip hooks", hc.TargetVer, h.targetVersionConstraint)
+		log.Infof("Target version %s does not satisfy target constraint %s, skip hook %s", hc.TargetVer, h.TargetVersions, h.Name)
 		return false, nil
 	}
 	return true, nil
@@ -143,35 +231,3 @@ func checkConstraint(verStr, constraintStr string) (bool, error) {
 	}
 	return constraint.Check(ver), nil
 }
-
-func checkInitCrdJobs(kubeClient manifest.ExecClient, currentIOPS, _ *v1alpha1.IstioOperatorSpec) util.Errors {
-	pl, err := kubeClient.PodsForSelector(currentIOPS.MeshConfig.RootNamespace, "")
-	if err != nil {
-		return util.NewErrs(fmt.Errorf("failed to list pods: %v", err))
-	}
-
-	for _, p := range pl.Items {
-		if strings.Contains(p.Name, "istio-init-crd") {
-			return util.NewErrs(fmt.Errorf("istio-init-crd pods exist: %v. Istio was installed with non-operator methods, "+
-				"please migrate to operator installation first", p.Name))
-		}
-	}
-
-	return nil
-}
-
-func (h hooks) String() string {
-	var out []string
-	for _, hh := range h {
-		out = append(out, hh.String())
-	}
-	return strings.Join(out, ", ")
-}
-
-func (h hook) String() string {
-	return getFunctionName(h)
-}
-
-func getFunctionName(i interface{}) string {
-	return runtime.FuncForPC(reflect.ValueOf(i).Pointer()).Name()
-}
@@ -15,107 +15,223 @@
 package hooks
 
 import (
-	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
-	"github.com/pkg/errors"
-
-	"istio.io/api/operator/v1alpha1"
 	"istio.io/operator/pkg/manifest"
-	"istio.io/operator/pkg/util"
-)
-
-var (
-	err1 = fmt.Errorf("err1")
-	err2 = fmt.Errorf("err2")
-	err3 = fmt.Errorf("err3")
+	"istio.io/operator/pkg/precheck"
 )
 
-func h1(_ manifest.ExecClient, _, _ *v1alpha1.IstioOperatorSpec) util.Errors {
-	return util.NewErrs(err1)
-}
-func h2(_ manifest.ExecClient, _, _ *v1alpha1.IstioOperatorSpec) util.Errors {
-	return util.NewErrs(err2)
-}
-func h3(_ manifest.ExecClient, _, _ *v1alpha1.IstioOperatorSpec) util.Errors {
-	return util.NewErrs(err3)
+func testAction(status precheck.Status) Action {
+	return func(_ manifest.ExecClient, _ *HookCommonParams, params []byte, _ bool) precheck.Results {
+		return precheck.Results{precheck.NewResult(status, "%s", params)}
+	}
 }
 
 func TestRunUpgradeHooks(t *testing.T) {
-	testUpgradeHooks := []hookVersionMapping{
-		{
-			sourceVersionConstraint: ">0",
-			targetVersionConstraint: ">0",
-			hooks:                   []hook{h1},
-		},
-		{
-			sourceVersionConstraint: ">=1.3, <1.4",
-			targetVersionConstraint: ">=1.5",
-			hooks:                   []hook{h2},
-		},
-		{
-			sourceVersionConstraint: ">=1.5",
-			targetVersionConstraint: ">=1.5",
-			hooks:                   []hook{h3},
-		},
+	RegisterAction("testPass", testAction(precheck.StatusPass))
+	RegisterAction("testFail", testAction(precheck.StatusFail))
+	defer func() {
+		actionsMu.Lock()
+		defer actionsMu.Unlock()
+		delete(actions, "testPass")
+		delete(actions, "testFail")
+	}()
+
+	r, err := ParseRegistry([]byte(`
+preUpgrade:
+- name: h1
+  sourceVersions: ">0"
+  targetVersions: ">0"
+  action: testPass
+  params: h1
+- name: h2
+  sourceVersions: ">=1.3, <1.4"
+  targetVersions: ">=1.5"
+  action: testFail
+  params: h2
+- name: h3
+  sourceVersions: ">=1.5"
+  targetVersions: ">=1.5"
+  action: testPass
+  params: h3
+`))
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	malformedStr := "Malformed version: bad ver"
 	tests := []struct {
 		desc      string
 		sourceVer string
 		targetVer string
-		dryRun    bool
-		wantErrs  util.Errors
+		want      []string
+		wantErr   string
 	}{
 		{
 			desc:      "bad ver",
 			sourceVer: "bad ver",
 			targetVer: "1.3",
-			wantErrs:  util.Errors{errors.New(malformedStr)},
+			wantErr:   "Malformed version: bad ver",
 		},
 		{
 			desc:      "h1",
 			sourceVer: "1.2",
 			targetVer: "1.3",
-			wantErrs:  util.Errors{err1},
+			want:      []string{`h1 Pass "h1"`},
 		},
 		{
 			desc:      "h2 boundary outside",
 			sourceVer: "1.4",
 			targetVer: "1.5",
-			wantErrs:  util.Errors{err1},
+			want:      []string{`h1 Pass "h1"`},
 		},
 		{
 			desc:      "h2 boundary inside",
 			sourceVer: "1.3",
 			targetVer: "1.5",
-			wantErrs:  util.Errors{err1, err2},
+			want:      []string{`h1 Pass "h1"`, `h2 Fail "h2"`},
 		},
 		{
 			desc:      "h2 range",
 			sourceVer: "1.3.5",
 			targetVer: "1.6.1",
-			wantErrs:  util.Errors{err1, err2},
+			want:      []string{`h1 Pass "h1"`, `h2 Fail "h2"`},
 		},
 		{
 			desc:      "h3 range",
 			sourceVer: "1.5.2",
 			targetVer: "1.5.1",
-			wantErrs:  util.Errors{err1, err3},
+			want:      []string{`h1 Pass "h1"`, `h3 Pass "h3"`},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			hc := HookCommonParams{
+			hc := &HookCommonParams{
 				SourceVer: tt.sourceVer,
 				TargetVer: tt.targetVer,
 			}
-			gotErrs := runUpgradeHooks(testUpgradeHooks, nil, &hc, tt.dryRun)
-			if !util.EqualErrors(gotErrs, tt.wantErrs) {
-				t.Errorf("%s: got: %s, wantErrs: %s", tt.desc, gotErrs.String(), tt.wantErrs.String())
+			results, err := r.RunPreUpgradeHooks(nil, hc, false)
+			if gotErr := errToString(err); gotErr != tt.wantErr {
+				t.Fatalf("got error %q, want %q", gotErr, tt.wantErr)
+			}
+			var got []string
+			for _, res := range results {
+				got = append(got, res.Check+" "+string(res.Status)+" "+res.Message)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasPostUpgradeHooks(t *testing.T) {
+	r, err := ParseRegistry([]byte(`
+postUpgrade:
+- name: sidecars
+  sourceVersions: ">=1.4"
+  targetVersions: ">=1.5"
+  action: verifySidecarVersions
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		sourceVer string
+		want      bool
+	}{
+		{sourceVer: "1.4.3", want: true},
+		{sourceVer: "1.3.0", want: false},
+	} {
+		got, err := r.HasPostUpgradeHooks(&HookCommonParams{SourceVer: tt.sourceVer, TargetVer: "1.5.0"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got post-upgrade hooks %v, want %v", tt.sourceVer, got, tt.want)
+		}
+	}
+}
+
+func TestParseRegistry(t *testing.T) {
+	tests := []struct {
+		desc    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			yaml: `
+postUpgrade:
+- name: remove
+  sourceVersions: ">=1.4"
+  targetVersions: ">=1.5"
+  action: removeDeprecatedResources
+  params:
+    resources:
+    - apiVersion: v1
+      kind: ConfigMap
+      name: old
+`,
+		},
+		{
+			desc: "unknown action",
+			yaml: `
+preUpgrade:
+- name: bad
+  sourceVersions: ">=1.4"
+  targetVersions: ">=1.5"
+  action: doesNotExist
+`,
+			wantErr: `hook bad: unknown hook action "doesNotExist"`,
+		},
+		{
+			desc: "bad constraint",
+			yaml: `
+preUpgrade:
+- name: bad
+  sourceVersions: "not a version"
+  targetVersions: ">=1.5"
+  action: checkInitCRDJobs
+`,
+			wantErr: "hook bad: Malformed constraint: not a version",
+		},
+		{
+			desc: "unknown field",
+			yaml: `
+preUpgrade:
+- name: bad
+  source: ">=1.4"
+`,
+			wantErr: "could not parse the hook registry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := ParseRegistry([]byte(tt.yaml))
+			if gotErr := errToString(err); !strings.HasPrefix(gotErr, tt.wantErr) || (gotErr != "") != (tt.wantErr != "") {
+				t.Errorf("got error %q, want %q", gotErr, tt.wantErr)
 			}
 		})
 	}
 }
+
+func TestDefaultRegistry(t *testing.T) {
+	r, err := DefaultRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.PreUpgrade) == 0 || len(r.PostUpgrade) == 0 {
+		t.Errorf("got %d pre-upgrade and %d post-upgrade hooks, want some of each", len(r.PreUpgrade), len(r.PostUpgrade))
+	}
+}
+
+// errToString returns the string representation of err and the empty string if err is nil.
+func errToString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -49,14 +49,25 @@ type Options struct {
 
 	// DryRun performs all steps except actually applying the manifests or creating output dirs/files.
 	DryRun bool
+	// ServerDryRun sends all objects to the API server with server-side dry-run, so that they are validated and
+	// admitted without being persisted.
+	ServerDryRun bool
 	// Verbose enables verbose debug output.
 	Verbose bool
 	// Wait for resources to be ready after install.
 	Wait bool
-	// Prune controls whether to pass --prune to kubectl. Unset means don't care (internal logic is free to modify).
+	// Prune controls whether to pass --prune to kubectl, or whether objects are pruned after an apply by the manifest
+	// package. Unset means don't care (internal logic is free to modify).
 	Prune *bool
+	// PrunePreview reports the objects which would be pruned instead of deleting them.
+	PrunePreview bool
+	// MaxPrune is the maximum number of objects pruned per component. If more objects would be pruned, none is. 0
+	// means no limit.
+	MaxPrune int
 	// Maximum amount of time to wait for resources to be ready after install when Wait=true.
 	WaitTimeout time.Duration
+	// UseKubectl applies manifests by running kubectl instead of using the built-in server-side apply client.
+	UseKubectl bool
 
 	// stdin - cmd stdin input as string
 	Stdin string
@@ -75,6 +86,9 @@ func (c *Client) Apply(manifest string, opts *Options) (string, string, error) {
 		return "", "", nil
 	}
 	subcmds := []string{"apply"}
+	if opts.ServerDryRun {
+		subcmds = append(subcmds, "--server-dry-run")
+	}
 	opts.Stdin = manifest
 	return c.kubectl(subcmds, opts)
 }
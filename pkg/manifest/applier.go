@@ -0,0 +1,422 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/utils/pointer"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/readiness"
+	"istio.io/operator/pkg/util"
+	"istio.io/pkg/log"
+)
+
+const (
+	// FieldManager is the field manager name used for server-side apply.
+	FieldManager = "istio-operator"
+)
+
+// ApplyResult is the outcome of applying or deleting a single object.
+type ApplyResult string
+
+const (
+	// ApplyResultCreated means the object did not exist and was created.
+	ApplyResultCreated ApplyResult = "created"
+	// ApplyResultConfigured means the object existed and was changed.
+	ApplyResultConfigured ApplyResult = "configured"
+	// ApplyResultUnchanged means the object existed and the apply was a no-op.
+	ApplyResultUnchanged ApplyResult = "unchanged"
+	// ApplyResultDeleted means the object was pruned from the cluster.
+	ApplyResultDeleted ApplyResult = "deleted"
+	// ApplyResultFailed means the operation on the object returned an error.
+	ApplyResultFailed ApplyResult = "failed"
+	// ApplyResultSkipped means the object could not be validated in server dry run mode, because its type is defined
+	// by a CRD, or its namespace is created, by the same installation, and neither is persisted in that mode.
+	ApplyResultSkipped ApplyResult = "skipped"
+	// ApplyResultProtected means the object was not pruned because it has the do-not-prune annotation.
+	ApplyResultProtected ApplyResult = "protected"
+	// ApplyResultWouldPrune means the object was not pruned in prune preview mode, or because more objects than
+	// allowed would have been pruned.
+	ApplyResultWouldPrune ApplyResult = "would be pruned"
+)
+
+// PruneOptions control how Prune deletes objects.
+type PruneOptions struct {
+	// DryRun deletes the objects with server-side dry-run.
+	DryRun bool
+	// Preview reports the objects which would be pruned without deleting them.
+	Preview bool
+	// MaxDeletions is the maximum number of objects deleted. If more objects would be pruned, none is and an error is
+	// returned. 0 means no limit.
+	MaxDeletions int
+}
+
+// ObjectApplyResult is the result of applying or deleting a single object.
+type ObjectApplyResult struct {
+	// Group, Kind, Namespace and Name identify the object.
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+	// Result is the outcome of the operation.
+	Result ApplyResult
+	// Err is set when Result is ApplyResultFailed.
+	Err error
+}
+
+// String implements the Stringer interface, using the same format as kubectl apply output.
+func (r *ObjectApplyResult) String() string {
+	id := strings.ToLower(r.Kind) + "/" + r.Name
+	if r.Namespace != "" {
+		id = r.Namespace + "/" + id
+	}
+	if r.Err != nil {
+		return fmt.Sprintf("%s %s: %s", id, r.Result, r.Err)
+	}
+	return fmt.Sprintf("%s %s", id, r.Result)
+}
+
+func (r *ObjectApplyResult) groupKind() schema.GroupKind {
+	return schema.GroupKind{Group: r.Group, Kind: r.Kind}
+}
+
+// ObjectApplyResults is a list of per object results.
+type ObjectApplyResults []*ObjectApplyResult
+
+// Errors returns the errors for all failed objects in the list.
+func (rs ObjectApplyResults) Errors() util.Errors {
+	var errs util.Errors
+	for _, r := range rs {
+		if r.Result == ApplyResultFailed {
+			errs = util.AppendErr(errs, fmt.Errorf("%s/%s/%s: %s", r.Kind, r.Namespace, r.Name, r.Err))
+		}
+	}
+	return errs
+}
+
+// String returns a newline separated report of all results.
+func (rs ObjectApplyResults) String() string {
+	var sb strings.Builder
+	for _, r := range rs {
+		sb.WriteString(r.String() + "\n")
+	}
+	return sb.String()
+}
+
+// prunableGVKs is the list of types which are considered for pruning. It extends the default `kubectl apply --prune`
+// whitelist with the cluster scoped and RBAC types that Istio components create. Namespaces are deliberately
+// left out since deleting them would cascade to user resources.
+var prunableGVKs = []schema.GroupVersionKind{
+	{Version: "v1", Kind: "ConfigMap"},
+	{Version: "v1", Kind: "Endpoints"},
+	{Version: "v1", Kind: "PersistentVolumeClaim"},
+	{Version: "v1", Kind: "Pod"},
+	{Version: "v1", Kind: "ReplicationController"},
+	{Version: "v1", Kind: "Secret"},
+	{Version: "v1", Kind: "Service"},
+	{Version: "v1", Kind: "ServiceAccount"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Group: "batch", Version: "v1", Kind: "Job"},
+	{Group: "batch", Version: "v1beta1", Kind: "CronJob"},
+	{Group: "autoscaling", Version: "v2beta1", Kind: "HorizontalPodAutoscaler"},
+	{Group: "policy", Version: "v1beta1", Kind: "PodDisruptionBudget"},
+	{Group: "extensions", Version: "v1beta1", Kind: "Ingress"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "MutatingWebhookConfiguration"},
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "ValidatingWebhookConfiguration"},
+}
+
+// ServerSideApplier applies objects to the cluster using server-side apply through a dynamic client.
+type ServerSideApplier struct {
+	client dynamic.Interface
+	mapper meta.RESTMapper
+}
+
+// NewServerSideApplier creates a ServerSideApplier from the given dynamic client and REST mapper.
+func NewServerSideApplier(client dynamic.Interface, mapper meta.RESTMapper) *ServerSideApplier {
+	return &ServerSideApplier{
+		client: client,
+		mapper: mapper,
+	}
+}
+
+var (
+	applier       *ServerSideApplier
+	applierConfig *rest.Config
+	applierMu     sync.Mutex
+)
+
+// getServerSideApplier returns a ServerSideApplier for the current k8sRESTConfig, creating one if required.
+func getServerSideApplier() (*ServerSideApplier, error) {
+	applierMu.Lock()
+	defer applierMu.Unlock()
+	if applier != nil && applierConfig == k8sRESTConfig {
+		return applier, nil
+	}
+	if k8sRESTConfig == nil {
+		return nil, fmt.Errorf("k8s client is not initialized")
+	}
+	a, err := newServerSideApplierForConfig(k8sRESTConfig)
+	if err != nil {
+		return nil, err
+	}
+	applier, applierConfig = a, k8sRESTConfig
+	return applier, nil
+}
+
+// newServerSideApplierForConfig returns a ServerSideApplier for the cluster of config.
+func newServerSideApplierForConfig(config *rest.Config) (*ServerSideApplier, error) {
+	dc, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("k8s dynamic client error: %s", err)
+	}
+	disc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("k8s discovery client error: %s", err)
+	}
+	return NewServerSideApplier(dc, restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(disc))), nil
+}
+
+// Apply applies all objects in order and returns a result for each one.
+func (a *ServerSideApplier) Apply(objs object.K8sObjects, dryRun bool) ObjectApplyResults {
+	var out ObjectApplyResults
+	for _, o := range objs {
+		out = append(out, a.applyObject(o, dryRun))
+	}
+	return out
+}
+
+// Delete deletes all the given objects and returns a result for each one. Objects which are already gone are
+// not reported as failures.
+func (a *ServerSideApplier) Delete(objs object.K8sObjects, dryRun bool) ObjectApplyResults {
+	var out ObjectApplyResults
+	for _, o := range objs {
+		r := newObjectApplyResult(o)
+		ri, err := a.resourceInterface(o.GroupVersionKind(), o.Namespace)
+		if err == nil {
+			err = ri.Delete(o.Name, deleteOptions(dryRun))
+		}
+		switch {
+		case err == nil || errors.IsNotFound(err):
+			r.Result = ApplyResultDeleted
+		default:
+			r.Result, r.Err = ApplyResultFailed, err
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// Get returns the live version of o, or nil if it does not exist in the cluster.
+func (a *ServerSideApplier) Get(o *object.K8sObject) (*object.K8sObject, error) {
+	ri, err := a.resourceInterface(o.GroupVersionKind(), o.Namespace)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	u, err := ri.Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return object.NewK8sObject(u, nil, nil), nil
+}
+
+// readinessGetter returns a readiness.Getter which reads live objects with a.
+func (a *ServerSideApplier) readinessGetter() readiness.Getter {
+	return func(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+		ri, err := a.resourceInterface(gvk, namespace)
+		if err != nil {
+			return nil, err
+		}
+		return ri.Get(name, metav1.GetOptions{})
+	}
+}
+
+// ListBySelector returns all objects of prunable types that match the given label selector.
+func (a *ServerSideApplier) ListBySelector(selector string) (object.K8sObjects, error) {
+	var out object.K8sObjects
+	for _, gvk := range prunableGVKs {
+		mapping, err := a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				// Type not served by this cluster, nothing to list.
+				continue
+			}
+			return nil, err
+		}
+		ul, err := a.client.Resource(mapping.Resource).List(metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			if errors.IsNotFound(err) || errors.IsMethodNotSupported(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s: %s", gvk.Kind, err)
+		}
+		for i := range ul.Items {
+			u := ul.Items[i]
+			u.SetGroupVersionKind(gvk)
+			out = append(out, object.NewK8sObject(&u, nil, nil))
+		}
+	}
+	return out, nil
+}
+
+// Prune deletes all objects matching selector which are not present in keep, according to opts. Objects with the
+// do-not-prune annotation are never deleted.
+func (a *ServerSideApplier) Prune(selector string, keep object.K8sObjects, opts PruneOptions) (ObjectApplyResults, error) {
+	live, err := a.ListBySelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	keepMap := keep.ToMap()
+	var del object.K8sObjects
+	var out ObjectApplyResults
+	for _, o := range live {
+		if _, ok := keepMap[o.Hash()]; ok {
+			continue
+		}
+		if isPruneProtected(o) {
+			r := newObjectApplyResult(o)
+			r.Result = ApplyResultProtected
+			out = append(out, r)
+			continue
+		}
+		del = append(del, o)
+	}
+	if opts.Preview || opts.MaxDeletions > 0 && len(del) > opts.MaxDeletions {
+		for _, o := range del {
+			r := newObjectApplyResult(o)
+			r.Result = ApplyResultWouldPrune
+			out = append(out, r)
+		}
+		if opts.Preview {
+			return out, nil
+		}
+		return out, fmt.Errorf("refusing to prune %d objects matching %s, more than the limit of %d", len(del), selector,
+			opts.MaxDeletions)
+	}
+	return append(out, a.Delete(del, opts.DryRun)...), nil
+}
+
+// isPruneProtected reports whether o has the do-not-prune annotation.
+func isPruneProtected(o *object.K8sObject) bool {
+	return o.UnstructuredObject().GetAnnotations()[name.DoNotPruneAnnotation] == "true"
+}
+
+func (a *ServerSideApplier) applyObject(o *object.K8sObject, dryRun bool) *ObjectApplyResult {
+	r := newObjectApplyResult(o)
+	fail := func(err error) *ObjectApplyResult {
+		r.Result, r.Err = ApplyResultFailed, err
+		return r
+	}
+	ri, err := a.resourceInterface(o.GroupVersionKind(), o.Namespace)
+	if err != nil {
+		return fail(err)
+	}
+	data, err := o.JSON()
+	if err != nil {
+		return fail(err)
+	}
+
+	current, err := ri.Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fail(err)
+		}
+		current = nil
+	}
+
+	patchOpts := metav1.PatchOptions{
+		FieldManager: FieldManager,
+		Force:        pointer.BoolPtr(true),
+	}
+	if dryRun {
+		patchOpts.DryRun = []string{metav1.DryRunAll}
+	}
+	applied, err := ri.Patch(o.Name, types.ApplyPatchType, data, patchOpts)
+	if err != nil {
+		return fail(err)
+	}
+
+	switch {
+	case current == nil:
+		r.Result = ApplyResultCreated
+	case applied.GetResourceVersion() == current.GetResourceVersion():
+		r.Result = ApplyResultUnchanged
+	default:
+		r.Result = ApplyResultConfigured
+	}
+	log.Debugf("%s", r)
+	return r
+}
+
+// resourceInterface returns a dynamic client for the resource identified by gvk in the given namespace.
+func (a *ServerSideApplier) resourceInterface(gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	mapping, err := a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return a.client.Resource(mapping.Resource), nil
+	}
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return a.client.Resource(mapping.Resource).Namespace(namespace), nil
+}
+
+func newObjectApplyResult(o *object.K8sObject) *ObjectApplyResult {
+	return &ObjectApplyResult{
+		Group:     o.Group,
+		Kind:      o.Kind,
+		Namespace: o.Namespace,
+		Name:      o.Name,
+	}
+}
+
+func deleteOptions(dryRun bool) *metav1.DeleteOptions {
+	propagation := metav1.DeletePropagationBackground
+	opts := &metav1.DeleteOptions{PropagationPolicy: &propagation}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	return opts
+}
@@ -0,0 +1,272 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+var (
+	configMapGVK  = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	deploymentGVK = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+)
+
+func TestServerSideApplierApply(t *testing.T) {
+	a := newFakeServerSideApplier(t)
+	tests := []struct {
+		desc string
+		yaml string
+		want ApplyResult
+	}{
+		{
+			desc: "create",
+			yaml: configMapYAML("cm1", "v1"),
+			want: ApplyResultCreated,
+		},
+		{
+			desc: "unchanged",
+			yaml: configMapYAML("cm1", "v1"),
+			want: ApplyResultUnchanged,
+		},
+		{
+			desc: "configured",
+			yaml: configMapYAML("cm1", "v2"),
+			want: ApplyResultConfigured,
+		},
+		{
+			desc: "unknown kind",
+			yaml: `
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: u1
+  namespace: istio-system
+`,
+			want: ApplyResultFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := a.Apply(mustParseObjects(t, tt.yaml), false)
+			if len(got) != 1 {
+				t.Fatalf("got %d results, want 1", len(got))
+			}
+			if got[0].Result != tt.want {
+				t.Errorf("%s: got result %s, want %s (err: %v)", tt.desc, got[0].Result, tt.want, got[0].Err)
+			}
+			if gotErr, wantErr := got.Errors() != nil, tt.want == ApplyResultFailed; gotErr != wantErr {
+				t.Errorf("%s: got error %v, want error %v", tt.desc, got.Errors(), wantErr)
+			}
+		})
+	}
+}
+
+func TestServerSideApplierPrune(t *testing.T) {
+	a := newFakeServerSideApplier(t)
+	selector := istioComponentLabelStr + "=Pilot"
+	live := mustParseObjects(t, configMapYAML("keep", "v1")+object.YAMLSeparator+configMapYAML("stale", "v1"))
+	for _, o := range live {
+		o.AddLabels(map[string]string{istioComponentLabelStr: "Pilot"})
+	}
+	if errs := a.Apply(live, false).Errors(); errs != nil {
+		t.Fatal(errs)
+	}
+
+	got, err := a.Prune(selector, live[:1], PruneOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Name != "stale" || got[0].Result != ApplyResultDeleted {
+		t.Fatalf("got prune results:\n%s\nwant only stale deleted", got)
+	}
+
+	remaining, err := a.ListBySelector(selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 1 || remaining[0].Name != "keep" {
+		t.Errorf("got remaining objects %v, want only keep", remaining)
+	}
+}
+
+func TestServerSideApplierPruneSafety(t *testing.T) {
+	tests := []struct {
+		desc          string
+		opts          PruneOptions
+		wantErr       bool
+		wantResults   map[string]ApplyResult
+		wantRemaining int
+	}{
+		{
+			desc: "protected",
+			wantResults: map[string]ApplyResult{
+				"protected": ApplyResultProtected,
+				"stale1":    ApplyResultDeleted,
+				"stale2":    ApplyResultDeleted,
+			},
+			wantRemaining: 2,
+		},
+		{
+			desc: "preview",
+			opts: PruneOptions{Preview: true},
+			wantResults: map[string]ApplyResult{
+				"protected": ApplyResultProtected,
+				"stale1":    ApplyResultWouldPrune,
+				"stale2":    ApplyResultWouldPrune,
+			},
+			wantRemaining: 4,
+		},
+		{
+			desc:    "over the limit",
+			opts:    PruneOptions{MaxDeletions: 1},
+			wantErr: true,
+			wantResults: map[string]ApplyResult{
+				"protected": ApplyResultProtected,
+				"stale1":    ApplyResultWouldPrune,
+				"stale2":    ApplyResultWouldPrune,
+			},
+			wantRemaining: 4,
+		},
+		{
+			desc: "within the limit",
+			opts: PruneOptions{MaxDeletions: 2},
+			wantResults: map[string]ApplyResult{
+				"protected": ApplyResultProtected,
+				"stale1":    ApplyResultDeleted,
+				"stale2":    ApplyResultDeleted,
+			},
+			wantRemaining: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			a := newFakeServerSideApplier(t)
+			selector := istioComponentLabelStr + "=Pilot"
+			var yamls []string
+			for _, n := range []string{"keep", "protected", "stale1", "stale2"} {
+				yamls = append(yamls, configMapYAML(n, "v1"))
+			}
+			live := mustParseObjects(t, strings.Join(yamls, object.YAMLSeparator))
+			for _, o := range live {
+				o.AddLabels(map[string]string{istioComponentLabelStr: "Pilot"})
+			}
+			live[1].UnstructuredObject().SetAnnotations(map[string]string{name.DoNotPruneAnnotation: "true"})
+			if errs := a.Apply(live, false).Errors(); errs != nil {
+				t.Fatal(errs)
+			}
+
+			got, err := a.Prune(selector, live[:1], tt.opts)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			gotResults := make(map[string]ApplyResult)
+			for _, r := range got {
+				gotResults[r.Name] = r.Result
+			}
+			if !reflect.DeepEqual(gotResults, tt.wantResults) {
+				t.Errorf("got prune results %v, want %v", gotResults, tt.wantResults)
+			}
+			remaining, err := a.ListBySelector(selector)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(remaining) != tt.wantRemaining {
+				t.Errorf("got %d remaining objects, want %d", len(remaining), tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func newFakeServerSideApplier(t *testing.T) *ServerSideApplier {
+	scheme := runtime.NewScheme()
+	client := fake.NewSimpleDynamicClient(scheme)
+	tracker := k8stesting.NewObjectTracker(scheme, serializer.NewCodecFactory(scheme).UniversalDecoder())
+	// The fake tracker does not support server-side apply patches, so handle them with a separate tracker.
+	client.PrependReactor("*", "*", k8stesting.ObjectReaction(tracker))
+	client.PrependReactor("patch", "*", applyPatchReactor(tracker))
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(configMapGVK, meta.RESTScopeNamespace)
+	mapper.Add(deploymentGVK, meta.RESTScopeNamespace)
+	return NewServerSideApplier(client, mapper)
+}
+
+// applyPatchReactor emulates server-side apply by replacing the stored object and bumping its resourceVersion
+// if it changed.
+func applyPatchReactor(tracker k8stesting.ObjectTracker) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pa := action.(k8stesting.PatchAction)
+		if pa.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(pa.GetPatch()); err != nil {
+			return true, nil, err
+		}
+		gvr, ns := pa.GetResource(), pa.GetNamespace()
+		cur, err := tracker.Get(gvr, ns, pa.GetName())
+		switch {
+		case errors.IsNotFound(err):
+			u.SetResourceVersion("1")
+			return true, u, tracker.Create(gvr, u, ns)
+		case err != nil:
+			return true, nil, err
+		}
+		cu := cur.(*unstructured.Unstructured)
+		u.SetResourceVersion(cu.GetResourceVersion())
+		if reflect.DeepEqual(cu.Object, u.Object) {
+			return true, cu, nil
+		}
+		rv, _ := strconv.Atoi(cu.GetResourceVersion())
+		u.SetResourceVersion(strconv.Itoa(rv + 1))
+		return true, u, tracker.Update(gvr, u, ns)
+	}
+}
+
+func configMapYAML(name, value string) string {
+	return `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ` + name + `
+  namespace: istio-system
+data:
+  key: ` + value + `
+`
+}
+
+func mustParseObjects(t *testing.T, yml string) object.K8sObjects {
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(yml)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return objs
+}
@@ -19,6 +19,12 @@ import (
 
 	"github.com/docker/distribution/reference"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 
 	"istio.io/operator/pkg/util"
@@ -28,6 +34,8 @@ import (
 type Client struct {
 	Config *rest.Config
 	*rest.RESTClient
+	// applier accesses objects of any type, it is created on first use.
+	applier *ServerSideApplier
 }
 
 // ComponentVersion is a pair of component name and version
@@ -48,6 +56,18 @@ type ExecClient interface {
 	GetPods(namespace string, params map[string]string) (*v1.PodList, error)
 	PodsForSelector(namespace, labelSelector string) (*v1.PodList, error)
 	ConfigMapForSelector(namespace, labelSelector string) (*v1.ConfigMapList, error)
+	// GetResource returns the object of type gvk with the given namespace and name. The namespace is ignored for
+	// cluster scoped types.
+	GetResource(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error)
+	// ListResources returns the objects of type gvk in namespace, or in all namespaces if namespace is empty.
+	ListResources(gvk schema.GroupVersionKind, namespace string) ([]unstructured.Unstructured, error)
+	// CreateResource creates obj.
+	CreateResource(obj *unstructured.Unstructured) error
+	// UpdateResource writes obj, or only its status if status is set.
+	UpdateResource(obj *unstructured.Unstructured, status bool) error
+	// DeleteResource deletes the object of type gvk with the given namespace and name. It is not an error if the
+	// object does not exist.
+	DeleteResource(gvk schema.GroupVersionKind, namespace, name string) error
 }
 
 // NewClient is the constructor for the client wrapper
@@ -60,7 +80,7 @@ func NewClient(kubeconfig, configContext string) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{config, restClient}, nil
+	return &Client{Config: config, RESTClient: restClient}, nil
 }
 
 // GetIstioVersions gets the version for each Istio component
@@ -165,3 +185,81 @@ func (client *Client) ConfigMapForSelector(namespace, labelSelector string) (*v1
 	}
 	return obj.(*v1.ConfigMapList), nil
 }
+
+// GetResource implements ExecClient.
+func (client *Client) GetResource(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	ri, err := client.resource(gvk, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return ri.Get(name, metav1.GetOptions{})
+}
+
+// ListResources implements ExecClient.
+func (client *Client) ListResources(gvk schema.GroupVersionKind, namespace string) ([]unstructured.Unstructured, error) {
+	ri, err := client.resource(gvk, namespace)
+	if err != nil {
+		return nil, err
+	}
+	ul, err := ri.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return ul.Items, nil
+}
+
+// CreateResource implements ExecClient.
+func (client *Client) CreateResource(obj *unstructured.Unstructured) error {
+	ri, err := client.resource(obj.GroupVersionKind(), obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	_, err = ri.Create(obj, metav1.CreateOptions{})
+	return err
+}
+
+// UpdateResource implements ExecClient.
+func (client *Client) UpdateResource(obj *unstructured.Unstructured, status bool) error {
+	ri, err := client.resource(obj.GroupVersionKind(), obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if status {
+		_, err = ri.UpdateStatus(obj, metav1.UpdateOptions{})
+	} else {
+		_, err = ri.Update(obj, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+// DeleteResource implements ExecClient.
+func (client *Client) DeleteResource(gvk schema.GroupVersionKind, namespace, name string) error {
+	ri, err := client.resource(gvk, namespace)
+	if err != nil {
+		return err
+	}
+	if err := ri.Delete(name, deleteOptions(false)); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// resource returns the dynamic client for objects of type gvk in namespace. For namespaced types, an empty namespace
+// selects all namespaces.
+func (client *Client) resource(gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	if client.applier == nil {
+		a, err := newServerSideApplierForConfig(client.Config)
+		if err != nil {
+			return nil, err
+		}
+		client.applier = a
+	}
+	mapping, err := client.applier.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return client.applier.client.Resource(mapping.Resource), nil
+	}
+	return client.applier.client.Resource(mapping.Resource).Namespace(namespace), nil
+}
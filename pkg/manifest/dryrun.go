@@ -0,0 +1,118 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+// dryRunScope holds the namespaces and CRD kinds created by an installation. Server dry run does not persist them, so
+// objects in these namespaces or of these kinds cannot be validated by the API server before the real apply.
+type dryRunScope struct {
+	namespaces map[string]bool
+	crdKinds   map[schema.GroupKind]bool
+}
+
+// newDryRunScope returns the scope of all objects in manifests.
+func newDryRunScope(manifests name.ManifestMap) (*dryRunScope, error) {
+	var scope *dryRunScope
+	for _, m := range manifests {
+		objects, err := object.ParseK8sObjectsFromYAMLManifest(strings.Join(m, helm.YAMLSeparator))
+		if err != nil {
+			return nil, err
+		}
+		if scope, err = scope.with(objects); err != nil {
+			return nil, err
+		}
+	}
+	return scope, nil
+}
+
+// with returns a copy of s, which may be nil, extended by the namespaces and CRDs in objects.
+func (s *dryRunScope) with(objects object.K8sObjects) (*dryRunScope, error) {
+	out := &dryRunScope{
+		namespaces: make(map[string]bool),
+		crdKinds:   make(map[schema.GroupKind]bool),
+	}
+	if s != nil {
+		for ns := range s.namespaces {
+			out.namespaces[ns] = true
+		}
+		for gk := range s.crdKinds {
+			out.crdKinds[gk] = true
+		}
+	}
+	for _, o := range nsKindObjects(objects) {
+		out.namespaces[o.Name] = true
+	}
+	for _, o := range cRDKindObjects(objects) {
+		u := o.UnstructuredObject().Object
+		group, _, err := unstructured.NestedString(u, "spec", "group")
+		if err != nil {
+			return nil, err
+		}
+		kind, _, err := unstructured.NestedString(u, "spec", "names", "kind")
+		if err != nil {
+			return nil, err
+		}
+		out.crdKinds[schema.GroupKind{Group: group, Kind: kind}] = true
+	}
+	return out, nil
+}
+
+// skipPending marks the failed results of objects which failed only because their namespace or CRD is in s as
+// skipped.
+func (s *dryRunScope) skipPending(results ObjectApplyResults) {
+	if s == nil {
+		return
+	}
+	for _, r := range results {
+		if r.Result != ApplyResultFailed {
+			continue
+		}
+		switch {
+		case meta.IsNoMatchError(r.Err) && s.crdKinds[r.groupKind()]:
+			r.Err = fmt.Errorf("the CRD of %s is created by this installation", r.Kind)
+		case isNamespaceNotFound(r.Err) && s.namespaces[r.Namespace]:
+			r.Err = fmt.Errorf("namespace %s is created by this installation", r.Namespace)
+		default:
+			continue
+		}
+		r.Result = ApplyResultSkipped
+	}
+}
+
+// isNamespaceNotFound reports whether err was returned because the namespace of an object does not exist.
+func isNamespaceNotFound(err error) bool {
+	if !errors.IsNotFound(err) {
+		return false
+	}
+	status, ok := err.(errors.APIStatus)
+	if !ok {
+		return false
+	}
+	d := status.Status().Details
+	return d != nil && d.Kind == "namespaces"
+}
@@ -0,0 +1,95 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/operator/pkg/name"
+)
+
+const dryRunScopeManifest = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: istio-system
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: gateways.networking.istio.io
+spec:
+  group: networking.istio.io
+  names:
+    kind: Gateway
+`
+
+func TestDryRunScopeSkipPending(t *testing.T) {
+	scope, err := newDryRunScope(name.ManifestMap{name.IstioBaseComponentName: {dryRunScopeManifest}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	noMatch := func(group, kind string) error {
+		return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: group, Kind: kind}}
+	}
+	nsNotFound := func(ns string) error {
+		return errors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, ns)
+	}
+	tests := []struct {
+		desc string
+		in   *ObjectApplyResult
+		want ApplyResult
+	}{
+		{
+			desc: "CRD in installation",
+			in:   &ObjectApplyResult{Group: "networking.istio.io", Kind: "Gateway", Err: noMatch("networking.istio.io", "Gateway")},
+			want: ApplyResultSkipped,
+		},
+		{
+			desc: "missing CRD",
+			in:   &ObjectApplyResult{Group: "networking.istio.io", Kind: "Sidecar", Err: noMatch("networking.istio.io", "Sidecar")},
+			want: ApplyResultFailed,
+		},
+		{
+			desc: "namespace in installation",
+			in:   &ObjectApplyResult{Kind: "ConfigMap", Namespace: "istio-system", Err: nsNotFound("istio-system")},
+			want: ApplyResultSkipped,
+		},
+		{
+			desc: "missing namespace",
+			in:   &ObjectApplyResult{Kind: "ConfigMap", Namespace: "other", Err: nsNotFound("other")},
+			want: ApplyResultFailed,
+		},
+		{
+			desc: "admission rejection",
+			in:   &ObjectApplyResult{Kind: "ConfigMap", Namespace: "istio-system", Err: fmt.Errorf("denied by webhook")},
+			want: ApplyResultFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			tt.in.Result = ApplyResultFailed
+			scope.skipPending(ObjectApplyResults{tt.in})
+			if tt.in.Result != tt.want {
+				t.Errorf("got %s, want %s", tt.in, tt.want)
+			}
+		})
+	}
+}
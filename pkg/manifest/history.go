@@ -0,0 +1,364 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+	"istio.io/pkg/log"
+)
+
+const (
+	// historyLabelStr marks a Secret as holding an install history snapshot.
+	historyLabelStr = name.OperatorAPINamespace + "/install-history"
+	// historyRevisionLabelStr holds the revision number of a snapshot.
+	historyRevisionLabelStr = name.OperatorAPINamespace + "/history-revision"
+	// historyTimestampAnnotationStr holds the time a snapshot was taken.
+	historyTimestampAnnotationStr = name.OperatorAPINamespace + "/history-timestamp"
+	// historySelectorsAnnotationStr holds the label selector used for each component in a snapshot.
+	historySelectorsAnnotationStr = name.OperatorAPINamespace + "/history-selectors"
+	// historySecretType is the Secret type used for snapshots.
+	historySecretType = v1.SecretType(name.OperatorAPINamespace + "/install-history")
+	// historySecretPrefix is the name prefix for snapshot Secrets.
+	historySecretPrefix = "istio-install-history-"
+	// maxHistory is the number of snapshots kept in the cluster.
+	maxHistory = 10
+)
+
+// Snapshot holds the live objects for a set of components at a point in time.
+type Snapshot struct {
+	// Revision is the history revision of the snapshot, 0 if it has not been saved.
+	Revision int
+	// Timestamp is the time the snapshot was taken.
+	Timestamp time.Time
+	// Components maps each component to its live objects. A component with no objects did not exist.
+	Components map[name.ComponentName]object.K8sObjects
+	// Selectors maps each component to the label selector for its objects.
+	Selectors map[name.ComponentName]string
+}
+
+// History stores and restores install snapshots as Secrets in a namespace.
+type History struct {
+	client    kubernetes.Interface
+	applier   *ServerSideApplier
+	namespace string
+}
+
+// NewHistory creates a History which keeps snapshots in namespace.
+func NewHistory(client kubernetes.Interface, applier *ServerSideApplier, namespace string) *History {
+	return &History{
+		client:    client,
+		applier:   applier,
+		namespace: namespace,
+	}
+}
+
+// NewDefaultHistory creates a History using the client set up by InitK8SRestClient.
+func NewDefaultHistory(namespace string) (*History, error) {
+	a, err := getServerSideApplier()
+	if err != nil {
+		return nil, err
+	}
+	cs, err := kubernetes.NewForConfig(k8sRESTConfig)
+	if err != nil {
+		return nil, fmt.Errorf("k8s client error: %s", err)
+	}
+	return NewHistory(cs, a, namespace), nil
+}
+
+// Snapshot captures the live state of every component in manifests. Both the objects carrying the component
+// label and the live versions of the objects about to be applied are recorded.
+func (h *History) Snapshot(manifests name.ManifestMap) (*Snapshot, error) {
+	s := &Snapshot{
+		Timestamp:  time.Now(),
+		Components: make(map[name.ComponentName]object.K8sObjects),
+		Selectors:  make(map[name.ComponentName]string),
+	}
+	for c, m := range manifests {
+		rendered, err := object.ParseK8sObjectsFromYAMLManifest(strings.Join(m, helm.YAMLSeparator))
+		if err != nil {
+			return nil, err
+		}
+		selector := componentSelector(c, rendered)
+		live, err := h.applier.ListBySelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		liveMap := live.ToMap()
+		for _, o := range rendered {
+			if _, ok := liveMap[o.Hash()]; ok {
+				continue
+			}
+			lo, err := h.applier.Get(o)
+			if err != nil {
+				return nil, err
+			}
+			if lo != nil {
+				live = append(live, lo)
+				liveMap[lo.Hash()] = lo
+			}
+		}
+		for _, o := range live {
+			stripServerFields(o.UnstructuredObject())
+		}
+		s.Components[c] = live
+		s.Selectors[c] = selector
+	}
+	return s, nil
+}
+
+// Save stores s as the next revision and removes revisions older than the last maxHistory.
+func (h *History) Save(s *Snapshot) error {
+	revs, err := h.revisions()
+	if err != nil {
+		return err
+	}
+	rev := 1
+	if len(revs) != 0 {
+		rev = revs[len(revs)-1] + 1
+	}
+
+	selectors, err := json.Marshal(s.Selectors)
+	if err != nil {
+		return err
+	}
+	data := make(map[string][]byte)
+	for c, objs := range s.Components {
+		ym, err := objs.YAMLManifest()
+		if err != nil {
+			return err
+		}
+		gz, err := gzipBytes([]byte(ym))
+		if err != nil {
+			return err
+		}
+		data[string(c)] = gz
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      historySecretName(rev),
+			Namespace: h.namespace,
+			Labels: map[string]string{
+				historyLabelStr:         "true",
+				historyRevisionLabelStr: strconv.Itoa(rev),
+			},
+			Annotations: map[string]string{
+				historyTimestampAnnotationStr: s.Timestamp.UTC().Format(time.RFC3339),
+				historySelectorsAnnotationStr: string(selectors),
+			},
+		},
+		Type: historySecretType,
+		Data: data,
+	}
+	if _, err := h.client.CoreV1().Secrets(h.namespace).Create(secret); err != nil {
+		return fmt.Errorf("failed to save install history revision %d: %s", rev, err)
+	}
+	s.Revision = rev
+
+	revs = append(revs, rev)
+	for len(revs) > maxHistory {
+		if err := h.client.CoreV1().Secrets(h.namespace).Delete(historySecretName(revs[0]), &metav1.DeleteOptions{}); err != nil &&
+			!errors.IsNotFound(err) {
+			log.Warnf("failed to remove install history revision %d: %s", revs[0], err)
+		}
+		revs = revs[1:]
+	}
+	return nil
+}
+
+// Load returns the snapshot with the given revision, or the latest one if rev is 0.
+func (h *History) Load(rev int) (*Snapshot, error) {
+	if rev == 0 {
+		revs, err := h.revisions()
+		if err != nil {
+			return nil, err
+		}
+		if len(revs) == 0 {
+			return nil, fmt.Errorf("no install history found in namespace %s", h.namespace)
+		}
+		rev = revs[len(revs)-1]
+	}
+	secret, err := h.client.CoreV1().Secrets(h.namespace).Get(historySecretName(rev), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load install history revision %d: %s", rev, err)
+	}
+	return snapshotFromSecret(secret)
+}
+
+// List returns all stored snapshots, oldest first.
+func (h *History) List() ([]*Snapshot, error) {
+	revs, err := h.revisions()
+	if err != nil {
+		return nil, err
+	}
+	var out []*Snapshot
+	for _, rev := range revs {
+		s, err := h.Load(rev)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// Restore returns every component in s to its snapshotted state. Snapshotted objects are re-applied and any
+// objects with the component label which are not part of the snapshot are pruned, except for the base component.
+func (h *History) Restore(s *Snapshot) (CompositeOutput, error) {
+	var components []string
+	for c := range s.Components {
+		if c != name.IstioBaseComponentName {
+			components = append(components, string(c))
+		}
+	}
+	sort.Strings(components)
+	if _, ok := s.Components[name.IstioBaseComponentName]; ok {
+		// Base holds namespaces and CRDs that other components depend on.
+		components = append([]string{string(name.IstioBaseComponentName)}, components...)
+	}
+
+	out := CompositeOutput{}
+	var errs util.Errors
+	for _, cs := range components {
+		c := name.ComponentName(cs)
+		objs := s.Components[c]
+		objs.Sort(defaultObjectOrder())
+		results := h.applier.Apply(objs, false)
+		if c != name.IstioBaseComponentName {
+			selector, ok := s.Selectors[c]
+			if !ok {
+				selector = componentSelector(c, objs)
+			}
+			pruned, err := h.applier.Prune(selector, objs, PruneOptions{})
+			if err != nil {
+				errs = util.AppendErr(errs, err)
+			}
+			results = append(results, pruned...)
+		}
+		ym, _ := objs.YAMLManifest()
+		out[c] = &ComponentApplyOutput{
+			Stdout:   results.String(),
+			Err:      results.Errors().ToError(),
+			Manifest: ym,
+			Objects:  results,
+		}
+		errs = util.AppendErr(errs, out[c].Err)
+	}
+	return out, errs.ToError()
+}
+
+// revisions returns the sorted list of stored revisions.
+func (h *History) revisions() ([]int, error) {
+	secrets, err := h.client.CoreV1().Secrets(h.namespace).List(metav1.ListOptions{LabelSelector: historyLabelStr + "=true"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list install history: %s", err)
+	}
+	var revs []int
+	for _, s := range secrets.Items {
+		rev, err := strconv.Atoi(s.Labels[historyRevisionLabelStr])
+		if err != nil {
+			log.Warnf("ignoring install history Secret %s with bad revision label: %s", s.Name, err)
+			continue
+		}
+		revs = append(revs, rev)
+	}
+	sort.Ints(revs)
+	return revs, nil
+}
+
+func snapshotFromSecret(secret *v1.Secret) (*Snapshot, error) {
+	rev, err := strconv.Atoi(secret.Labels[historyRevisionLabelStr])
+	if err != nil {
+		return nil, fmt.Errorf("bad revision label on install history Secret %s: %s", secret.Name, err)
+	}
+	s := &Snapshot{
+		Revision:   rev,
+		Components: make(map[name.ComponentName]object.K8sObjects),
+		Selectors:  make(map[name.ComponentName]string),
+	}
+	if sel, ok := secret.Annotations[historySelectorsAnnotationStr]; ok {
+		if err := json.Unmarshal([]byte(sel), &s.Selectors); err != nil {
+			return nil, fmt.Errorf("bad selectors on install history Secret %s: %s", secret.Name, err)
+		}
+	}
+	if ts, ok := secret.Annotations[historyTimestampAnnotationStr]; ok {
+		if s.Timestamp, err = time.Parse(time.RFC3339, ts); err != nil {
+			return nil, fmt.Errorf("bad timestamp on install history Secret %s: %s", secret.Name, err)
+		}
+	}
+	for c, gz := range secret.Data {
+		ym, err := gunzipBytes(gz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read component %s from install history Secret %s: %s", c, secret.Name, err)
+		}
+		objs, err := object.ParseK8sObjectsFromYAMLManifest(string(ym))
+		if err != nil {
+			return nil, err
+		}
+		s.Components[name.ComponentName(c)] = objs
+	}
+	return s, nil
+}
+
+// stripServerFields removes fields set by the API server, so that the object can be re-applied.
+func stripServerFields(u *unstructured.Unstructured) {
+	for _, f := range []string{"resourceVersion", "uid", "selfLink", "creationTimestamp", "generation", "managedFields"} {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(u.Object, "status")
+}
+
+func historySecretName(rev int) string {
+	return historySecretPrefix + strconv.Itoa(rev)
+}
+
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipBytes(b []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return ioutil.ReadAll(zr)
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+func TestHistorySnapshotRestore(t *testing.T) {
+	a := newFakeServerSideApplier(t)
+	h := NewHistory(fake.NewSimpleClientset(), a, "istio-system")
+
+	apply := func(yml string) {
+		objs := mustParseObjects(t, yml)
+		for _, o := range objs {
+			o.AddLabels(map[string]string{istioComponentLabelStr: string(name.PilotComponentName)})
+		}
+		if errs := a.Apply(objs, false).Errors(); errs != nil {
+			t.Fatal(errs)
+		}
+	}
+	value := func(n string) string {
+		o, err := a.Get(mustParseObjects(t, configMapYAML(n, ""))[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o == nil {
+			return "<missing>"
+		}
+		return o.UnstructuredObject().Object["data"].(map[string]interface{})["key"].(string)
+	}
+
+	apply(configMapYAML("cm1", "v1"))
+	manifests := name.ManifestMap{
+		name.PilotComponentName: {configMapYAML("cm1", "v2") + object.YAMLSeparator + configMapYAML("cm2", "v2")},
+	}
+	s, err := h.Snapshot(manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Save(s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Revision != 1 {
+		t.Errorf("got revision %d, want 1", s.Revision)
+	}
+
+	apply(configMapYAML("cm1", "v2") + object.YAMLSeparator + configMapYAML("cm2", "v2"))
+
+	loaded, err := h.Load(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(loaded.Components[name.PilotComponentName]); got != 1 {
+		t.Fatalf("got %d objects in snapshot, want 1", got)
+	}
+	if _, err := h.Restore(loaded); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := value("cm1"), "v1"; got != want {
+		t.Errorf("cm1: got %s, want %s", got, want)
+	}
+	if got, want := value("cm2"), "<missing>"; got != want {
+		t.Errorf("cm2: got %s, want %s", got, want)
+	}
+}
@@ -25,13 +25,10 @@ import (
 	"time" // For kubeclient GCP auth
 
 	"github.com/ghodss/yaml"
-	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -42,14 +39,16 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
-	kubectlutil "k8s.io/kubectl/pkg/util/deployment"
 	"k8s.io/utils/pointer"
 
 	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/dag"
 	"istio.io/operator/pkg/helm"
 	"istio.io/operator/pkg/kubectlcmd"
 	"istio.io/operator/pkg/name"
 	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/revision"
+	"istio.io/operator/pkg/translate"
 	"istio.io/operator/pkg/util"
 	pkgversion "istio.io/operator/pkg/version"
 	"istio.io/pkg/log"
@@ -84,55 +83,23 @@ type ComponentApplyOutput struct {
 	Err error
 	// Manifest is the manifest applied to the cluster.
 	Manifest string
+	// Objects holds the result for each object applied or pruned. It is only populated when the built-in
+	// server-side apply client is used.
+	Objects ObjectApplyResults
 }
 
 type CompositeOutput map[name.ComponentName]*ComponentApplyOutput
 
-type componentNameToListMap map[name.ComponentName][]name.ComponentName
-type componentTree map[name.ComponentName]interface{}
-
-// deployment holds associated replicaSets for a deployment
-type deployment struct {
-	replicaSets *appsv1.ReplicaSet
-	deployment  *appsv1.Deployment
-}
-
 var (
-	componentDependencies = componentNameToListMap{
-		name.IstioBaseComponentName: {
-			name.PilotComponentName,
-			name.PolicyComponentName,
-			name.TelemetryComponentName,
-			name.GalleyComponentName,
-			name.CitadelComponentName,
-			name.NodeAgentComponentName,
-			name.CertManagerComponentName,
-			name.SidecarInjectorComponentName,
-			name.CNIComponentName,
-			name.IngressComponentName,
-			name.EgressComponentName,
-			name.AddonComponentName,
-		},
-	}
-
-	installTree      = make(componentTree)
-	dependencyWaitCh = make(map[name.ComponentName]chan struct{})
-	kubectl          = kubectlcmd.New()
+	kubectl = kubectlcmd.New()
 
 	k8sRESTConfig     *rest.Config
 	currentKubeconfig string
 	currentContext    string
-)
 
-func init() {
-	buildInstallTree()
-	for _, parent := range componentDependencies {
-		for _, child := range parent {
-			dependencyWaitCh[child] = make(chan struct{}, 1)
-		}
-	}
-
-}
+	// progressWriter receives the progress output of applies.
+	progressWriter io.Writer = os.Stdout
+)
 
 // ParseK8SYAMLToIstioOperatorSpec parses a IstioOperator CustomResource YAML string and unmarshals in into
 // an IstioOperatorSpec object. It returns the object and an API group/version with it.
@@ -157,20 +124,24 @@ func ParseK8SYAMLToIstioOperatorSpec(yml string) (*v1alpha1.IstioOperatorSpec, *
 	return iop, &gvk, nil
 }
 
-// RenderToDir writes manifests to a local filesystem directory tree.
-func RenderToDir(manifests name.ManifestMap, outputDir string, dryRun bool) error {
-	logAndPrint("Component dependencies tree: \n%s", installTreeString())
+// RenderToDir writes manifests to a local filesystem directory tree. The manifest of each component is written to a
+// directory nested in the directory of its first dependency.
+func RenderToDir(manifests name.ManifestMap, version pkgversion.Version, outputDir string, dryRun bool) error {
+	deps, err := componentDependencies(version)
+	if err != nil {
+		return err
+	}
+	logAndPrint("Component dependencies: \n%s", deps)
 	logAndPrint("Rendering manifests to output dir %s", outputDir)
-	return renderRecursive(manifests, installTree, outputDir, dryRun)
-}
-
-func renderRecursive(manifests name.ManifestMap, installTree componentTree, outputDir string, dryRun bool) error {
-	for k, v := range installTree {
-		componentName := string(k)
+	for c, m := range manifests {
+		componentName := string(c)
 		// In cases (like gateways) where multiple instances can exist, concatenate the manifests and apply as one.
-		ym := strings.Join(manifests[k], helm.YAMLSeparator)
+		ym := strings.Join(m, helm.YAMLSeparator)
 		logAndPrint("Rendering: %s", componentName)
-		dirName := filepath.Join(outputDir, componentName)
+		dirName := outputDir
+		for _, p := range deps.Path(c) {
+			dirName = filepath.Join(dirName, string(p))
+		}
 		if !dryRun {
 			if err := os.MkdirAll(dirName, os.ModePerm); err != nil {
 				return fmt.Errorf("could not create directory %s; %s", outputDir, err)
@@ -183,123 +154,110 @@ func renderRecursive(manifests name.ManifestMap, installTree componentTree, outp
 				return fmt.Errorf("could not write manifest config; %s", err)
 			}
 		}
-
-		kt, ok := v.(componentTree)
-		if !ok {
-			// Leaf
-			return nil
-		}
-		if err := renderRecursive(manifests, kt, dirName, dryRun); err != nil {
-			return err
-		}
 	}
 	return nil
 }
 
-// ApplyAll applies all given manifests using kubectl client.
+// ApplyAll applies all given manifests using server-side apply, or kubectl if opts.UseKubectl is set. Components are
+// applied concurrently in the order of their dependencies. If opts.Wait is set, components which others depend on
+// must be ready before their dependents are applied.
 func ApplyAll(manifests name.ManifestMap, version pkgversion.Version, opts *kubectlcmd.Options) (CompositeOutput, error) {
 	log.Infof("Preparing manifests for these components:")
 	for c := range manifests {
 		log.Infof("- %s", c)
 	}
-	log.Infof("Component dependencies tree: \n%s", installTreeString())
+	deps, err := componentDependencies(version)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Component dependencies: \n%s", deps)
 	if err := InitK8SRestClient(opts.Kubeconfig, opts.Context); err != nil {
 		return nil, err
 	}
-	return applyRecursive(manifests, version, opts)
+	return applyRecursive(manifests, deps, version, opts)
 }
 
-func applyRecursive(manifests name.ManifestMap, version pkgversion.Version, opts *kubectlcmd.Options) (CompositeOutput, error) {
-	var wg sync.WaitGroup
+func applyRecursive(manifests name.ManifestMap, deps dag.Graph, version pkgversion.Version,
+	opts *kubectlcmd.Options) (CompositeOutput, error) {
 	var mu sync.Mutex
 	out := CompositeOutput{}
 	allAppliedObjects := object.K8sObjects{}
-	for c, m := range manifests {
-		c := c
-		m := m
-		wg.Add(1)
-		go func() {
-			if s := dependencyWaitCh[c]; s != nil {
-				log.Infof("%s is waiting on a prerequisite...", c)
-				<-s
-				log.Infof("Prerequisite for %s has completed, proceeding with install.", c)
-			}
-			applyOut, appliedObjects := ApplyManifest(c, strings.Join(m, helm.YAMLSeparator), version.String(), *opts)
-			mu.Lock()
-			out[c] = applyOut
-			allAppliedObjects = append(allAppliedObjects, appliedObjects...)
-			mu.Unlock()
-
-			// Signal all the components that depend on us.
-			for _, ch := range componentDependencies[c] {
-				log.Infof("unblocking child %s.", ch)
-				dependencyWaitCh[ch] <- struct{}{}
+	var scope *dryRunScope
+	if opts.ServerDryRun {
+		var err error
+		if scope, err = newDryRunScope(manifests); err != nil {
+			return nil, err
+		}
+	}
+	var components []name.ComponentName
+	for c := range manifests {
+		components = append(components, c)
+	}
+	err := deps.Run(components, func(c name.ComponentName) {
+		applyOut, appliedObjects := applyManifest(c, strings.Join(manifests[c], helm.YAMLSeparator), version.String(), *opts, scope)
+		if opts.Wait && applyOut.Err == nil && deps.HasDependents(c) {
+			if err := waitForResources(appliedObjects, opts); err != nil {
+				applyOut.Err = fmt.Errorf("component %s is not ready: %s", c, err)
 			}
-			wg.Done()
-		}()
+		}
+		mu.Lock()
+		out[c] = applyOut
+		allAppliedObjects = append(allAppliedObjects, appliedObjects...)
+		mu.Unlock()
+	})
+	if err != nil {
+		return nil, err
 	}
-	wg.Wait()
 	if opts.Wait {
 		return out, waitForResources(allAppliedObjects, opts)
 	}
 	return out, nil
 }
 
+// componentDependencies returns the dependencies between components declared in the translateConfig of version.
+func componentDependencies(version pkgversion.Version) (dag.Graph, error) {
+	t, err := translate.NewTranslator(version.MinorVersion)
+	if err != nil {
+		return nil, err
+	}
+	return t.ComponentDependencies, nil
+}
+
+// ApplyManifest applies the manifest for a single component and returns the output and the objects applied.
+// Objects are applied with server-side apply unless opts.UseKubectl is set, in which case kubectl is used.
 func ApplyManifest(componentName name.ComponentName, manifestStr, version string,
 	opts kubectlcmd.Options) (*ComponentApplyOutput, object.K8sObjects) {
-	stdout, stderr := "", ""
+	return applyManifest(componentName, manifestStr, version, opts, nil)
+}
+
+// applyManifest is ApplyManifest, where scope holds the namespaces and CRDs created by other components of the same
+// installation. It is only used in server dry run mode.
+func applyManifest(componentName name.ComponentName, manifestStr, version string, opts kubectlcmd.Options,
+	scope *dryRunScope) (*ComponentApplyOutput, object.K8sObjects) {
+	out := &ComponentApplyOutput{}
 	appliedObjects := object.K8sObjects{}
 	objects, err := object.ParseK8sObjectsFromYAMLManifest(manifestStr)
 	if err != nil {
-		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+		return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
 	}
-	componentLabel := fmt.Sprintf("%s=%s", istioComponentLabelStr, componentName)
+	if opts.ServerDryRun {
+		if scope, err = scope.with(objects); err != nil {
+			return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
+		}
+	}
+	componentLabel := componentSelector(componentName, objects)
 
-	// TODO: remove this when `kubectl --prune` supports empty objects
-	//  (https://github.com/kubernetes/kubernetes/issues/40635)
 	// Delete all resources for a disabled component
 	if len(objects) == 0 {
-		getOpts := opts
-		getOpts.Output = "yaml"
-		getOpts.ExtraArgs = []string{"--all-namespaces", "--selector", componentLabel}
-		stdoutGet, stderrGet, err := kubectl.GetAll(&getOpts)
-		if err != nil {
-			stdout += "\n" + stdoutGet
-			stderr += "\n" + stderrGet
-			return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
-		}
-		items, err := GetKubectlGetItems(stdoutGet)
-		if err != nil {
-			return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
-		}
-		if len(items) == 0 {
-			return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
-		}
-
-		logAndPrint("- Pruning objects for disabled component %s...", componentName)
-		delObjects, err := object.ParseK8sObjectsFromYAMLManifest(stdoutGet)
+		delObjects, err := deleteComponentObjects(componentName, componentLabel, &opts, out)
 		if err != nil {
-			return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
-		}
-		delOpts := opts
-		delOpts.ExtraArgs = []string{"--selector", componentLabel}
-		stdoutDel, stderrDel, err := kubectl.Delete(stdoutGet, &delOpts)
-		stdout += "\n" + stdoutDel
-		stderr += "\n" + stderrDel
-		if err != nil {
-			logAndPrint("✘ Finished pruning objects for disabled component %s.", componentName)
-			return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+			return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
 		}
 		appliedObjects = append(appliedObjects, delObjects...)
-		logAndPrint("✔ Finished pruning objects for disabled component %s.", componentName)
-		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+		return buildComponentApplyOutput(out, appliedObjects, nil), appliedObjects
 	}
 
-	for _, o := range objects {
-		o.AddLabels(map[string]string{istioComponentLabelStr: string(componentName)})
-		o.AddLabels(map[string]string{operatorLabelStr: operatorReconcileStr})
-		o.AddLabels(map[string]string{istioVersionLabelStr: version})
-	}
+	addComponentLabels(objects, componentName, version)
 
 	opts.ExtraArgs = []string{"--force", "--selector", componentLabel}
 	// Base components include namespaces and CRDs, pruning them will remove user configs, which makes it hard to roll back.
@@ -311,39 +269,99 @@ func ApplyManifest(componentName name.ComponentName, manifestStr, version string
 
 	// Apply namespace resources first, then wait.
 	nsObjects := nsKindObjects(objects)
-	stdout, stderr, err = applyObjects(nsObjects, &opts, stdout, stderr)
-	if err != nil {
-		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+	if err := applyObjects(nsObjects, &opts, scope, out); err != nil {
+		return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
 	}
 	if err := waitForResources(nsObjects, &opts); err != nil {
-		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+		return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
 	}
 	appliedObjects = append(appliedObjects, nsObjects...)
 
 	// Apply CRDs, then wait.
 	crdObjects := cRDKindObjects(objects)
-	stdout, stderr, err = applyObjects(crdObjects, &opts, stdout, stderr)
-	if err != nil {
-		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+	if err := applyObjects(crdObjects, &opts, scope, out); err != nil {
+		return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
 	}
-	if err := waitForCRDs(crdObjects, opts.DryRun); err != nil {
-		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+	if err := waitForCRDs(crdObjects, opts.DryRun || opts.ServerDryRun); err != nil {
+		return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
 	}
 	appliedObjects = append(appliedObjects, crdObjects...)
 
 	// Apply all remaining objects.
 	nonNsCrdObjects := objectsNotInLists(objects, nsObjects, crdObjects)
-	stdout, stderr, err = applyObjects(nonNsCrdObjects, &opts, stdout, stderr)
+	err = applyObjects(nonNsCrdObjects, &opts, scope, out)
+	if err == nil && opts.Prune != nil && *opts.Prune {
+		err = pruneObjects(componentLabel, objects, &opts, out)
+	}
 	mark := "✔"
 	if err != nil {
 		mark = "✘"
 	}
 	logAndPrint("%s Finished applying manifest for component %s.", mark, componentName)
 	if err != nil {
-		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+		return buildComponentApplyOutput(out, appliedObjects, err), appliedObjects
 	}
 	appliedObjects = append(appliedObjects, nonNsCrdObjects...)
-	return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
+	return buildComponentApplyOutput(out, appliedObjects, nil), appliedObjects
+}
+
+// addComponentLabels adds the labels identifying the component and version of an installation to objects.
+func addComponentLabels(objects object.K8sObjects, componentName name.ComponentName, version string) {
+	for _, o := range objects {
+		o.AddLabels(map[string]string{istioComponentLabelStr: string(componentName)})
+		o.AddLabels(map[string]string{operatorLabelStr: operatorReconcileStr})
+		o.AddLabels(map[string]string{istioVersionLabelStr: version})
+	}
+}
+
+// deleteComponentObjects deletes all objects in the cluster with the given component label, except for the ones with
+// the do-not-prune annotation. It returns the list of objects that were deleted.
+func deleteComponentObjects(componentName name.ComponentName, componentLabel string, opts *kubectlcmd.Options,
+	out *ComponentApplyOutput) (object.K8sObjects, error) {
+	if opts.DryRun {
+		log.Infof("dry run mode: not pruning objects for disabled component %s.", componentName)
+		return nil, nil
+	}
+	a, err := getServerSideApplier()
+	if err != nil {
+		return nil, err
+	}
+	live, err := a.ListBySelector(componentLabel)
+	if err != nil || len(live) == 0 {
+		return nil, err
+	}
+	logAndPrint("- Pruning objects for disabled component %s...", componentName)
+	if err := pruneObjects(componentLabel, nil, opts, out); err != nil {
+		logAndPrint("✘ Finished pruning objects for disabled component %s.", componentName)
+		return nil, err
+	}
+	logAndPrint("✔ Finished pruning objects for disabled component %s.", componentName)
+	if opts.PrunePreview {
+		return nil, nil
+	}
+	var delObjects object.K8sObjects
+	for _, o := range live {
+		if !isPruneProtected(o) {
+			delObjects = append(delObjects, o)
+		}
+	}
+	return delObjects, nil
+}
+
+// componentSelector returns a label selector for the objects of the given component in the same control plane
+// revision as objects. Objects of other revisions are never selected, so that they are not pruned.
+func componentSelector(componentName name.ComponentName, objects object.K8sObjects) string {
+	rev := ""
+	for _, o := range objects {
+		if r, ok := o.UnstructuredObject().GetLabels()[revision.Label]; ok {
+			rev = r
+			break
+		}
+	}
+	if rev == "" {
+		return fmt.Sprintf("%s=%s,!%s", istioComponentLabelStr, componentName, revision.Label)
+	}
+	return fmt.Sprintf("%s=%s,%s=%s", istioComponentLabelStr, componentName, revision.Label, rev)
 }
 
 func GetKubectlGetItems(stdoutGet string) ([]interface{}, error) {
@@ -385,33 +403,78 @@ func DeploymentExists(kubeconfig, context, namespace, name string) (bool, error)
 	return d != nil, nil
 }
 
-func applyObjects(objs object.K8sObjects, opts *kubectlcmd.Options, stdout, stderr string) (string, string, error) {
+// applyObjects applies objs to the cluster and records the result in out. In server dry run mode, the results of
+// objects depending on namespaces or CRDs in scope are skipped.
+func applyObjects(objs object.K8sObjects, opts *kubectlcmd.Options, scope *dryRunScope, out *ComponentApplyOutput) error {
 	if len(objs) == 0 {
-		return stdout, stderr, nil
+		return nil
 	}
 
 	objs.Sort(defaultObjectOrder())
 
+	if !opts.UseKubectl {
+		if opts.DryRun {
+			log.Infof("dry run mode: would be applying %d objects.", len(objs))
+			return nil
+		}
+		a, err := getServerSideApplier()
+		if err != nil {
+			return err
+		}
+		results := a.Apply(objs, opts.ServerDryRun)
+		if opts.ServerDryRun {
+			scope.skipPending(results)
+		}
+		out.Objects = append(out.Objects, results...)
+		out.Stdout += "\n" + results.String()
+		return results.Errors().ToError()
+	}
+
 	mns, err := objs.JSONManifest()
 	if err != nil {
-		return stdout, stderr, err
+		return err
 	}
 
-	stdoutApply, stderrApply, err := kubectl.Apply(mns, opts)
-	stdout += "\n" + stdoutApply
-	stderr += "\n" + stderrApply
+	// Objects are pruned by pruneObjects once everything is applied, since kubectl --prune deletes objects with the
+	// do-not-prune annotation too.
+	kubectlOpts := *opts
+	kubectlOpts.Prune = nil
+	stdoutApply, stderrApply, err := kubectl.Apply(mns, &kubectlOpts)
+	out.Stdout += "\n" + stdoutApply
+	out.Stderr += "\n" + stderrApply
 
-	return stdout, stderr, err
+	return err
 }
 
-func buildComponentApplyOutput(stdout string, stderr string, objects object.K8sObjects, err error) *ComponentApplyOutput {
-	manifest, _ := objects.YAMLManifest()
-	return &ComponentApplyOutput{
-		Stdout:   stdout,
-		Stderr:   stderr,
-		Manifest: manifest,
-		Err:      err,
+// pruneObjects deletes all objects with the given component label which are not in keep, and records the result in out.
+// Objects which would be pruned are only reported in prune preview mode, or if there are more than opts.MaxPrune.
+func pruneObjects(componentLabel string, keep object.K8sObjects, opts *kubectlcmd.Options, out *ComponentApplyOutput) error {
+	if opts.DryRun {
+		log.Infof("dry run mode: not pruning objects for %s.", componentLabel)
+		return nil
 	}
+	a, err := getServerSideApplier()
+	if err != nil {
+		return err
+	}
+	results, err := a.Prune(componentLabel, keep, PruneOptions{
+		DryRun:       opts.ServerDryRun,
+		Preview:      opts.PrunePreview,
+		MaxDeletions: opts.MaxPrune,
+	})
+	out.Objects = append(out.Objects, results...)
+	out.Stdout += "\n" + results.String()
+	if err != nil {
+		return err
+	}
+	return results.Errors().ToError()
+}
+
+func buildComponentApplyOutput(out *ComponentApplyOutput, objects object.K8sObjects, err error) *ComponentApplyOutput {
+	manifest, _ := objects.YAMLManifest()
+	out.Manifest = manifest
+	out.Err = err
+	return out
 }
 
 func defaultObjectOrder() func(o *object.K8sObject) int {
@@ -540,216 +603,27 @@ func waitForCRDs(objects object.K8sObjects, dryRun bool) error {
 	return nil
 }
 
-// waitForResources polls to get the current status of all pods, PVCs, and Services
-// until all are ready or a timeout is reached
+// waitForResources watches all objects until the readiness checker of each one reports it as ready or a timeout is
+// reached, printing the changes of their state.
 // TODO - plumb through k8s client and remove global `k8sRESTConfig`
 func waitForResources(objects object.K8sObjects, opts *kubectlcmd.Options) error {
-	if opts.DryRun {
+	if opts.DryRun || opts.ServerDryRun {
 		logAndPrint("Not waiting for resources ready in dry run mode.")
 		return nil
 	}
 
-	cs, err := kubernetes.NewForConfig(k8sRESTConfig)
+	a, err := getServerSideApplier()
 	if err != nil {
-		return fmt.Errorf("k8s client error: %s", err)
+		return err
 	}
-
-	errPoll := wait.Poll(2*time.Second, opts.WaitTimeout, func() (bool, error) {
-		pods := []v1.Pod{}
-		services := []v1.Service{}
-		deployments := []deployment{}
-		namespaces := []v1.Namespace{}
-
-		for _, o := range objects {
-			kind := o.GroupVersionKind().Kind
-			switch kind {
-			case "Namespace":
-				namespace, err := cs.CoreV1().Namespaces().Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				namespaces = append(namespaces, *namespace)
-			case "Pod":
-				pod, err := cs.CoreV1().Pods(o.Namespace).Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				pods = append(pods, *pod)
-			case "ReplicationController":
-				rc, err := cs.CoreV1().ReplicationControllers(o.Namespace).Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				list, err := getPods(cs, rc.Namespace, rc.Spec.Selector)
-				if err != nil {
-					return false, err
-				}
-				pods = append(pods, list...)
-			case "Deployment":
-				currentDeployment, err := cs.AppsV1().Deployments(o.Namespace).Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				_, _, newReplicaSet, err := kubectlutil.GetAllReplicaSets(currentDeployment, cs.AppsV1())
-				if err != nil || newReplicaSet == nil {
-					return false, err
-				}
-				newDeployment := deployment{
-					newReplicaSet,
-					currentDeployment,
-				}
-				deployments = append(deployments, newDeployment)
-			case "DaemonSet":
-				ds, err := cs.AppsV1().DaemonSets(o.Namespace).Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				list, err := getPods(cs, ds.Namespace, ds.Spec.Selector.MatchLabels)
-				if err != nil {
-					return false, err
-				}
-				pods = append(pods, list...)
-			case "StatefulSet":
-				sts, err := cs.AppsV1().StatefulSets(o.Namespace).Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				list, err := getPods(cs, sts.Namespace, sts.Spec.Selector.MatchLabels)
-				if err != nil {
-					return false, err
-				}
-				pods = append(pods, list...)
-			case "ReplicaSet":
-				rs, err := cs.AppsV1().ReplicaSets(o.Namespace).Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				list, err := getPods(cs, rs.Namespace, rs.Spec.Selector.MatchLabels)
-				if err != nil {
-					return false, err
-				}
-				pods = append(pods, list...)
-			case "Service":
-				svc, err := cs.CoreV1().Services(o.Namespace).Get(o.Name, metav1.GetOptions{})
-				if err != nil {
-					return false, err
-				}
-				services = append(services, *svc)
-			}
-		}
-		isReady := namespacesReady(namespaces) && podsReady(pods) && deploymentsReady(deployments) && servicesReady(services)
-		if !isReady {
-			logAndPrint("Waiting for resources ready with timeout of %v", opts.WaitTimeout)
-		}
-		return isReady, nil
-	})
-
-	if errPoll != nil {
-		logAndPrint("Failed to wait for resources ready: %v", errPoll)
-		return fmt.Errorf("failed to wait for resources ready: %s", errPoll)
+	logAndPrint("Waiting for resources ready with timeout of %v", opts.WaitTimeout)
+	if err := newResourceWaiter(a).wait(objects, opts.WaitTimeout); err != nil {
+		logAndPrint("Failed to wait for resources ready: %v", err)
+		return fmt.Errorf("failed to wait for resources ready: %s", err)
 	}
 	return nil
 }
 
-func getPods(client kubernetes.Interface, namespace string, selector map[string]string) ([]v1.Pod, error) {
-	list, err := client.CoreV1().Pods(namespace).List(metav1.ListOptions{
-		FieldSelector: fields.Everything().String(),
-		LabelSelector: labels.Set(selector).AsSelector().String(),
-	})
-	return list.Items, err
-}
-
-func namespacesReady(namespaces []v1.Namespace) bool {
-	for _, namespace := range namespaces {
-		if !isNamespaceReady(&namespace) {
-			logAndPrint("Namespace is not ready: %s/%s", namespace.GetName())
-			return false
-		}
-	}
-	return true
-}
-
-func podsReady(pods []v1.Pod) bool {
-	for _, pod := range pods {
-		if !isPodReady(&pod) {
-			logAndPrint("Pod is not ready: %s/%s", pod.GetNamespace(), pod.GetName())
-			return false
-		}
-	}
-	return true
-}
-
-func isNamespaceReady(namespace *v1.Namespace) bool {
-	return namespace.Status.Phase == v1.NamespaceActive
-}
-
-func isPodReady(pod *v1.Pod) bool {
-	if len(pod.Status.Conditions) > 0 {
-		for _, condition := range pod.Status.Conditions {
-			if condition.Type == v1.PodReady &&
-				condition.Status == v1.ConditionTrue {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func deploymentsReady(deployments []deployment) bool {
-	for _, v := range deployments {
-		if v.replicaSets.Status.ReadyReplicas < *v.deployment.Spec.Replicas {
-			logAndPrint("Deployment is not ready: %s/%s", v.deployment.GetNamespace(), v.deployment.GetName())
-			return false
-		}
-	}
-	return true
-}
-
-func servicesReady(svc []v1.Service) bool {
-	for _, s := range svc {
-		if s.Spec.Type == v1.ServiceTypeExternalName {
-			continue
-		}
-		if s.Spec.ClusterIP != v1.ClusterIPNone && s.Spec.ClusterIP == "" {
-			logAndPrint("Service is not ready: %s/%s", s.GetNamespace(), s.GetName())
-			return false
-		}
-		if s.Spec.Type == v1.ServiceTypeLoadBalancer && s.Status.LoadBalancer.Ingress == nil {
-			logAndPrint("Service is not ready: %s/%s", s.GetNamespace(), s.GetName())
-			return false
-		}
-	}
-	return true
-}
-
-func buildInstallTree() {
-	// Starting with root, recursively insert each first level child into each node.
-	insertChildrenRecursive(name.IstioBaseComponentName, installTree, componentDependencies)
-}
-
-func insertChildrenRecursive(componentName name.ComponentName, tree componentTree, children componentNameToListMap) {
-	tree[componentName] = make(componentTree)
-	for _, child := range children[componentName] {
-		insertChildrenRecursive(child, tree[componentName].(componentTree), children)
-	}
-}
-
-func installTreeString() string {
-	var sb strings.Builder
-	buildInstallTreeString(name.IstioBaseComponentName, "", &sb)
-	return sb.String()
-}
-
-func buildInstallTreeString(componentName name.ComponentName, prefix string, sb io.StringWriter) {
-	_, _ = sb.WriteString(prefix + string(componentName) + "\n")
-	if _, ok := installTree[componentName].(componentTree); !ok {
-		return
-	}
-	for k := range installTree[componentName].(componentTree) {
-		buildInstallTreeString(k, prefix+"  ", sb)
-	}
-}
-
 func InitK8SRestClient(kubeconfig, context string) error {
 	var err error
 	if kubeconfig == currentKubeconfig && context == currentContext && k8sRESTConfig != nil {
@@ -806,8 +680,13 @@ func BuildClientConfig(kubeconfig, context string) (*rest.Config, error) {
 	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides).ClientConfig()
 }
 
+// SetProgressWriter sets the writer which receives the progress output of applies. It is stdout by default.
+func SetProgressWriter(w io.Writer) {
+	progressWriter = w
+}
+
 func logAndPrint(v ...interface{}) {
 	s := fmt.Sprintf(v[0].(string), v[1:]...)
 	log.Infof(s)
-	fmt.Println(s)
+	_, _ = fmt.Fprintln(progressWriter, s)
 }
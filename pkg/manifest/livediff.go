@@ -0,0 +1,150 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+var (
+	// serverMetadataFields are the metadata fields populated by the API server.
+	serverMetadataFields = []string{"uid", "resourceVersion", "generation", "creationTimestamp", "selfLink",
+		"managedFields"}
+	// serverAnnotations are the annotations populated by the API server, controllers or kubectl.
+	serverAnnotations = []string{"kubectl.kubernetes.io/last-applied-configuration", "deployment.kubernetes.io/revision"}
+)
+
+// RenderedObjects returns the objects in manifests with the labels added to them when they are applied.
+func RenderedObjects(manifests name.ManifestMap, version string) (object.K8sObjects, error) {
+	var out object.K8sObjects
+	for c, m := range manifests {
+		objects, err := object.ParseK8sObjectsFromYAMLManifest(strings.Join(m, helm.YAMLSeparator))
+		if err != nil {
+			return nil, err
+		}
+		addComponentLabels(objects, c, version)
+		out = append(out, objects...)
+	}
+	return out, nil
+}
+
+// LiveObjects returns the objects in the cluster selected by kubeconfig and context which correspond to the objects in
+// manifests, and the objects of the same components which would be pruned when manifests are applied. Fields
+// populated by the API server are removed, so that the live objects can be compared with the result of
+// RenderedObjects for the same version.
+func LiveObjects(kubeconfig, context string, manifests name.ManifestMap, version string) (object.K8sObjects, error) {
+	if err := InitK8SRestClient(kubeconfig, context); err != nil {
+		return nil, err
+	}
+	a, err := getServerSideApplier()
+	if err != nil {
+		return nil, err
+	}
+	return liveObjects(a, manifests, version)
+}
+
+func liveObjects(a *ServerSideApplier, manifests name.ManifestMap, version string) (object.K8sObjects, error) {
+	var out object.K8sObjects
+	for c, m := range manifests {
+		objects, err := object.ParseK8sObjectsFromYAMLManifest(strings.Join(m, helm.YAMLSeparator))
+		if err != nil {
+			return nil, err
+		}
+		addComponentLabels(objects, c, version)
+		for _, o := range objects {
+			live, err := a.Get(o)
+			if err != nil {
+				return nil, err
+			}
+			if live != nil {
+				out = append(out, normalizeLiveObject(live, o))
+			}
+		}
+		// Base components are never pruned, see ApplyManifest.
+		if c == name.IstioBaseComponentName {
+			continue
+		}
+		live, err := a.ListBySelector(componentSelector(c, objects))
+		if err != nil {
+			return nil, err
+		}
+		rendered := objects.ToMap()
+		for _, o := range live {
+			if _, ok := rendered[o.Hash()]; !ok {
+				out = append(out, normalizeLiveObject(o, nil))
+			}
+		}
+	}
+	return out, nil
+}
+
+// normalizeLiveObject returns live without the fields populated by the API server. If rendered is set, all fields
+// which are not set in rendered, such as defaulted fields, are removed as well.
+func normalizeLiveObject(live, rendered *object.K8sObject) *object.K8sObject {
+	u := live.UnstructuredObject().DeepCopy()
+	unstructured.RemoveNestedField(u.Object, "status")
+	for _, f := range serverMetadataFields {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+	if annotations := u.GetAnnotations(); annotations != nil {
+		for _, a := range serverAnnotations {
+			delete(annotations, a)
+		}
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		u.SetAnnotations(annotations)
+	}
+	if rendered != nil {
+		u.Object = project(u.Object, rendered.UnstructuredObject().Object).(map[string]interface{})
+	}
+	return object.NewK8sObject(u, nil, nil)
+}
+
+// project returns the parts of live which are set in rendered. Lists are projected element by element if they have the
+// same length, and returned unchanged otherwise.
+func project(live, rendered interface{}) interface{} {
+	switch r := rendered.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		out := make(map[string]interface{}, len(r))
+		for k, rv := range r {
+			if lv, ok := l[k]; ok {
+				out[k] = project(lv, rv)
+			}
+		}
+		return out
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(r) {
+			return live
+		}
+		out := make([]interface{}, len(l))
+		for i := range l {
+			out[i] = project(l[i], r[i])
+		}
+		return out
+	}
+	return live
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+)
+
+func TestLiveObjects(t *testing.T) {
+	a := newFakeServerSideApplier(t)
+	manifests := name.ManifestMap{name.PilotComponentName: {configMapYAML("keep", "v1")}}
+	rendered, err := RenderedObjects(manifests, "1.4.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The live version of keep has server populated and defaulted fields.
+	live := mustParseObjects(t, configMapYAML("keep", "v1")+object.YAMLSeparator+configMapYAML("stale", "v1"))
+	addComponentLabels(live, name.PilotComponentName, "1.4.0")
+	u := live[0].UnstructuredObject()
+	u.SetUID("1234")
+	u.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+	if err := unstructured.SetNestedField(u.Object, "defaulted", "data", "default"); err != nil {
+		t.Fatal(err)
+	}
+	live[0] = object.NewK8sObject(u, nil, nil)
+	if errs := a.Apply(live, false).Errors(); errs != nil {
+		t.Fatal(errs)
+	}
+
+	got, err := liveObjects(a, manifests, "1.4.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotMap := got.ToMap()
+	if len(gotMap) != 2 {
+		t.Fatalf("got live objects %v, want keep and stale", gotMap)
+	}
+	wantYAML, err := rendered[0].YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotYAML, err := gotMap[rendered[0].Hash()].YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := util.YAMLDiff(string(wantYAML), string(gotYAML)); diff != "" {
+		t.Errorf("got normalized live object with diffs:\n%s", diff)
+	}
+	stale := gotMap[live[1].Hash()]
+	if stale == nil {
+		t.Fatal("stale object was not returned")
+	}
+	if rv := stale.UnstructuredObject().GetResourceVersion(); rv != "" {
+		t.Errorf("got resourceVersion %s in stale object, want none", rv)
+	}
+}
+
+func TestProject(t *testing.T) {
+	live := map[string]interface{}{
+		"a": "live",
+		"b": "defaulted",
+		"list": []interface{}{
+			map[string]interface{}{"name": "c1", "defaulted": true},
+		},
+		"other": []interface{}{"x", "y"},
+	}
+	rendered := map[string]interface{}{
+		"a":     "rendered",
+		"list":  []interface{}{map[string]interface{}{"name": "c1"}},
+		"other": []interface{}{"x"},
+	}
+	want := map[string]interface{}{
+		"a":     "live",
+		"list":  []interface{}{map[string]interface{}{"name": "c1"}},
+		"other": []interface{}{"x", "y"},
+	}
+	if got := project(live, rendered); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
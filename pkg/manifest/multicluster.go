@@ -0,0 +1,205 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"istio.io/pkg/log"
+)
+
+const (
+	// MultiClusterSecretLabel marks the secrets holding the kubeconfigs of the remote clusters a control plane
+	// watches.
+	MultiClusterSecretLabel = "istio/multiCluster"
+	// RemoteSecretPrefix is the name prefix of remote secrets.
+	RemoteSecretPrefix = "istio-remote-secret-"
+
+	// readerServiceAccountName is the service account the control plane uses to read remote clusters.
+	readerServiceAccountName = "istio-reader-service-account"
+	// ingressGatewayServiceName is the service of the ingress gateway, which exposes the control plane to remote
+	// clusters on other networks.
+	ingressGatewayServiceName = "istio-ingressgateway"
+)
+
+// remoteAddressServices are the control plane services remote clusters connect to, keyed by the global value holding
+// the service address.
+var remoteAddressServices = map[string]string{
+	"remotePilotAddress":     "istio-pilot",
+	"remotePolicyAddress":    "istio-policy",
+	"remoteTelemetryAddress": "istio-telemetry",
+}
+
+// RemoteAddresses returns the addresses remote clusters reach the control plane services installed in namespace of
+// the primary cluster selected by kubeconfig and context at, keyed by the values.global field which points remote
+// clusters at them. This is the load balancer address of the service if it is exposed with one, or else the load
+// balancer address of the ingress gateway. Pod and cluster IPs are not used, since they change when pods or services
+// are recreated and are usually not reachable from other clusters. Services which are not installed or not exposed
+// are omitted.
+func RemoteAddresses(kubeconfig, context, namespace string) (map[string]string, error) {
+	cs, err := newKubernetesClient(kubeconfig, context)
+	if err != nil {
+		return nil, err
+	}
+	return remoteAddresses(cs, namespace)
+}
+
+func remoteAddresses(cs kubernetes.Interface, namespace string) (map[string]string, error) {
+	gateway, err := loadBalancerAddress(cs, namespace, ingressGatewayServiceName)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]string)
+	for value, name := range remoteAddressServices {
+		svc, err := cs.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		addr := serviceLoadBalancerAddress(svc)
+		if addr == "" {
+			addr = gateway
+		}
+		if addr != "" {
+			out[value] = addr
+		}
+	}
+	return out, nil
+}
+
+// loadBalancerAddress returns the load balancer address of the service namespace/name, or "" if it does not exist
+// or has none.
+func loadBalancerAddress(cs kubernetes.Interface, namespace, name string) (string, error) {
+	svc, err := cs.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return serviceLoadBalancerAddress(svc), nil
+}
+
+// serviceLoadBalancerAddress returns the IP or host name of the load balancer of svc, or "" if it has none.
+func serviceLoadBalancerAddress(svc *corev1.Service) string {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return ""
+	}
+	for _, in := range svc.Status.LoadBalancer.Ingress {
+		if in.IP != "" {
+			return in.IP
+		}
+		if in.Hostname != "" {
+			return in.Hostname
+		}
+	}
+	return ""
+}
+
+// RemoteSecret returns a secret holding a kubeconfig for the cluster selected by kubeconfig and context, which
+// authenticates as the Istio reader service account in namespace. server overrides the API server address of the
+// kubeconfig, which is otherwise taken from the client configuration.
+func RemoteSecret(kubeconfig, context, clusterName, namespace, server string) (*corev1.Secret, error) {
+	cs, err := newKubernetesClient(kubeconfig, context)
+	if err != nil {
+		return nil, err
+	}
+	if server == "" {
+		server = k8sRESTConfig.Host
+	}
+	return remoteSecret(cs, clusterName, namespace, server)
+}
+
+func remoteSecret(cs kubernetes.Interface, clusterName, namespace, server string) (*corev1.Secret, error) {
+	sa, err := cs.CoreV1().ServiceAccounts(namespace).Get(readerServiceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get the reader service account of cluster %s: %s", clusterName, err)
+	}
+	var token *corev1.Secret
+	for _, ref := range sa.Secrets {
+		s, err := cs.CoreV1().Secrets(namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if s.Type == corev1.SecretTypeServiceAccountToken {
+			token = s
+			break
+		}
+	}
+	if token == nil {
+		return nil, fmt.Errorf("no token found for service account %s/%s in cluster %s", namespace,
+			readerServiceAccountName, clusterName)
+	}
+
+	kc := clientcmdapi.NewConfig()
+	kc.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: token.Data[corev1.ServiceAccountRootCAKey],
+	}
+	kc.AuthInfos[clusterName] = &clientcmdapi.AuthInfo{Token: string(token.Data[corev1.ServiceAccountTokenKey])}
+	kc.Contexts[clusterName] = &clientcmdapi.Context{Cluster: clusterName, AuthInfo: clusterName}
+	kc.CurrentContext = clusterName
+	b, err := clientcmd.Write(*kc)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RemoteSecretPrefix + clusterName,
+			Namespace: namespace,
+			Labels:    map[string]string{MultiClusterSecretLabel: "true"},
+		},
+		Data: map[string][]byte{clusterName: b},
+	}, nil
+}
+
+// ApplyRemoteSecret creates or updates secret in the cluster selected by kubeconfig and context.
+func ApplyRemoteSecret(kubeconfig, context string, secret *corev1.Secret, dryRun bool) error {
+	if dryRun {
+		log.Infof("dry run mode: would apply remote secret %s/%s.", secret.Namespace, secret.Name)
+		return nil
+	}
+	cs, err := newKubernetesClient(kubeconfig, context)
+	if err != nil {
+		return err
+	}
+	return applyRemoteSecret(cs, secret)
+}
+
+func applyRemoteSecret(cs kubernetes.Interface, secret *corev1.Secret) error {
+	client := cs.CoreV1().Secrets(secret.Namespace)
+	cur, err := client.Get(secret.Name, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		_, err = client.Create(secret)
+	case err != nil:
+	default:
+		cur.Labels, cur.Data = secret.Labels, secret.Data
+		_, err = client.Update(cur)
+	}
+	if err != nil {
+		return fmt.Errorf("could not apply remote secret %s/%s: %s", secret.Namespace, secret.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestRemoteAddresses(t *testing.T) {
+	service := func(name string, serviceType corev1.ServiceType, ingress ...corev1.LoadBalancerIngress) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "istio-system"},
+			Spec:       corev1.ServiceSpec{Type: serviceType, ClusterIP: "10.96.0.10"},
+			Status:     corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: ingress}},
+		}
+	}
+	pilot := service("istio-pilot", corev1.ServiceTypeClusterIP)
+	policy := service("istio-policy", corev1.ServiceTypeLoadBalancer, corev1.LoadBalancerIngress{Hostname: "policy.example.com"})
+	gateway := service("istio-ingressgateway", corev1.ServiceTypeLoadBalancer, corev1.LoadBalancerIngress{IP: "203.0.113.1"})
+	pendingGateway := service("istio-ingressgateway", corev1.ServiceTypeLoadBalancer)
+
+	tests := []struct {
+		desc    string
+		objects []runtime.Object
+		want    map[string]string
+	}{
+		{
+			desc:    "service and gateway load balancers",
+			objects: []runtime.Object{pilot, policy, gateway},
+			want:    map[string]string{"remotePilotAddress": "203.0.113.1", "remotePolicyAddress": "policy.example.com"},
+		},
+		{
+			desc:    "no load balancer address",
+			objects: []runtime.Object{pilot, pendingGateway},
+			want:    map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := remoteAddresses(fake.NewSimpleClientset(tt.objects...), "istio-system")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoteSecret(t *testing.T) {
+	cs := fake.NewSimpleClientset(
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: readerServiceAccountName, Namespace: "istio-system"},
+			Secrets:    []corev1.ObjectReference{{Name: "dockercfg"}, {Name: "reader-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "dockercfg", Namespace: "istio-system"},
+			Type:       corev1.SecretTypeDockercfg,
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "reader-token", Namespace: "istio-system"},
+			Type:       corev1.SecretTypeServiceAccountToken,
+			Data: map[string][]byte{
+				corev1.ServiceAccountTokenKey:  []byte("token"),
+				corev1.ServiceAccountRootCAKey: []byte("ca"),
+			},
+		},
+	)
+	s, err := remoteSecret(cs, "cluster2", "istio-system", "https://cluster2:6443")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != RemoteSecretPrefix+"cluster2" || s.Labels[MultiClusterSecretLabel] != "true" {
+		t.Errorf("got secret %s with labels %v", s.Name, s.Labels)
+	}
+	kc, err := clientcmd.Load(s.Data["cluster2"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := kc.Clusters["cluster2"]; c.Server != "https://cluster2:6443" || string(c.CertificateAuthorityData) != "ca" {
+		t.Errorf("got cluster %+v", c)
+	}
+	if a := kc.AuthInfos["cluster2"]; a.Token != "token" {
+		t.Errorf("got token %s, want token", a.Token)
+	}
+
+	// The secret is created in the primary and updated on later applies.
+	primary := fake.NewSimpleClientset()
+	for _, token := range []string{"token", "rotated"} {
+		s.Data["cluster2"] = []byte(token)
+		if err := applyRemoteSecret(primary, s.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+		got, err := primary.CoreV1().Secrets("istio-system").Get(s.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got.Data["cluster2"]) != token {
+			t.Errorf("got secret data %s, want %s", got.Data["cluster2"], token)
+		}
+	}
+
+	if _, err := remoteSecret(fake.NewSimpleClientset(), "cluster3", "istio-system", ""); err == nil {
+		t.Error("got no error for a cluster without reader service account")
+	}
+}
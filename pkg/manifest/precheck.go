@@ -0,0 +1,54 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/precheck"
+)
+
+// Precheck runs the pre-flight checks against the cluster selected by kubeconfig and context, for the install
+// rendered to manifests. p holds the other parameters of the checks; its objects, components and clients are set from
+// manifests and the cluster.
+func Precheck(kubeconfig, context string, manifests name.ManifestMap, version string, p *precheck.Params) (precheck.Results, error) {
+	if err := InitK8SRestClient(kubeconfig, context); err != nil {
+		return nil, err
+	}
+	cs, err := kubernetes.NewForConfig(k8sRESTConfig)
+	if err != nil {
+		return nil, fmt.Errorf("k8s client error: %s", err)
+	}
+	a, err := getServerSideApplier()
+	if err != nil {
+		return nil, err
+	}
+	if p.Objects, err = RenderedObjects(manifests, version); err != nil {
+		return nil, err
+	}
+	p.Components = nil
+	for c, m := range manifests {
+		if len(m) != 0 {
+			p.Components = append(p.Components, c)
+		}
+	}
+	sort.Slice(p.Components, func(i, j int) bool { return p.Components[i] < p.Components[j] })
+	p.Client, p.Mapper, p.Get = cs, a.mapper, a.readinessGetter()
+	return precheck.Run(p), nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"strings"
+
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/operator/pkg/revision"
+	"istio.io/pkg/log"
+)
+
+const (
+	// defaultInjectionLabelStr is the namespace label selected by the default sidecar injector webhook.
+	defaultInjectionLabelStr = "istio-injection"
+)
+
+// PromoteRevision makes control plane revision rev the default by pointing the default sidecar injector webhook,
+// which injects namespaces labeled istio-injection=enabled, at the injector of that revision.
+func PromoteRevision(kubeconfig, context, rev string, dryRun bool) error {
+	cs, err := newKubernetesClient(kubeconfig, context)
+	if err != nil {
+		return err
+	}
+	return promoteRevision(cs, rev, dryRun)
+}
+
+// RetireRevision deletes all objects belonging to control plane revision rev. The current default revision can
+// only be retired with force.
+func RetireRevision(kubeconfig, context, rev string, force, dryRun bool) (ObjectApplyResults, error) {
+	cs, err := newKubernetesClient(kubeconfig, context)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRetireRevision(cs, rev, force); err != nil {
+		return nil, err
+	}
+	a, err := getServerSideApplier()
+	if err != nil {
+		return nil, err
+	}
+	objs, err := a.ListBySelector(revisionSelector(rev))
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("no objects found for revision %s", rev)
+	}
+	return a.Delete(objs, dryRun), nil
+}
+
+// DefaultRevision returns the revision the default sidecar injector webhook currently points at.
+func DefaultRevision(cs kubernetes.Interface) (string, error) {
+	wh, err := cs.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(revision.InjectorWebhookName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if rev := wh.Labels[revision.Label]; rev != "" {
+		return rev, nil
+	}
+	return revision.Default, nil
+}
+
+func promoteRevision(cs kubernetes.Interface, rev string, dryRun bool) error {
+	if rev == "" || rev == revision.Default {
+		return fmt.Errorf("the default revision cannot be promoted, re-apply the default installation to make it the default again")
+	}
+	if err := revision.Validate(rev); err != nil {
+		return err
+	}
+	client := cs.AdmissionregistrationV1beta1().MutatingWebhookConfigurations()
+	src, err := client.Get(revision.Name(revision.InjectorWebhookName, rev), metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("could not get sidecar injector webhook for revision %s: %s", rev, err)
+	}
+
+	dst, err := client.Get(revision.InjectorWebhookName, metav1.GetOptions{})
+	create := errors.IsNotFound(err)
+	switch {
+	case create:
+		dst = &admissionv1beta1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: revision.InjectorWebhookName},
+			Webhooks:   src.DeepCopy().Webhooks,
+		}
+		for i := range dst.Webhooks {
+			dst.Webhooks[i].NamespaceSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{defaultInjectionLabelStr: "enabled"},
+			}
+		}
+	case err != nil:
+		return fmt.Errorf("could not get default sidecar injector webhook: %s", err)
+	default:
+		if err := copyWebhookClientConfigs(dst, src); err != nil {
+			return err
+		}
+	}
+	if dst.Labels == nil {
+		dst.Labels = make(map[string]string)
+	}
+	dst.Labels[revision.Label] = rev
+
+	if dryRun {
+		log.Infof("dry run mode: would point the default sidecar injector webhook at revision %s.", rev)
+		return nil
+	}
+	if create {
+		_, err = client.Create(dst)
+	} else {
+		_, err = client.Update(dst)
+	}
+	if err != nil {
+		return fmt.Errorf("could not update default sidecar injector webhook: %s", err)
+	}
+	return nil
+}
+
+// copyWebhookClientConfigs points each webhook in dst at the service of the webhook with the same name in src.
+func copyWebhookClientConfigs(dst, src *admissionv1beta1.MutatingWebhookConfiguration) error {
+	srcConfigs := make(map[string]admissionv1beta1.WebhookClientConfig)
+	for _, wh := range src.Webhooks {
+		srcConfigs[wh.Name] = wh.ClientConfig
+	}
+	for i, wh := range dst.Webhooks {
+		cc, ok := srcConfigs[wh.Name]
+		if !ok {
+			return fmt.Errorf("webhook %s not found in %s", wh.Name, src.Name)
+		}
+		dst.Webhooks[i].ClientConfig = *cc.DeepCopy()
+	}
+	return nil
+}
+
+func checkRetireRevision(cs kubernetes.Interface, rev string, force bool) error {
+	if err := revision.Validate(rev); err != nil {
+		return err
+	}
+	if rev == "" {
+		rev = revision.Default
+	}
+	def, err := DefaultRevision(cs)
+	if err != nil {
+		return err
+	}
+	if def == rev && !force {
+		return fmt.Errorf("revision %s is the default revision, promote another revision first or use --force", rev)
+	}
+	nss, err := cs.CoreV1().Namespaces().List(metav1.ListOptions{LabelSelector: revision.Label + "=" + rev})
+	if err != nil {
+		return err
+	}
+	if len(nss.Items) != 0 && !force {
+		var names []string
+		for _, ns := range nss.Items {
+			names = append(names, ns.Name)
+		}
+		return fmt.Errorf("namespaces %s are still injected by revision %s, relabel them or use --force",
+			strings.Join(names, ","), rev)
+	}
+	return nil
+}
+
+// revisionSelector returns a label selector matching all objects of control plane revision rev.
+func revisionSelector(rev string) string {
+	if rev == "" || rev == revision.Default {
+		return fmt.Sprintf("%s in (%s),!%s", istioComponentLabelStr,
+			strings.Join(revision.RevisionedComponentNames(), ","), revision.Label)
+	}
+	return revision.Label + "=" + rev
+}
+
+func newKubernetesClient(kubeconfig, context string) (kubernetes.Interface, error) {
+	if err := InitK8SRestClient(kubeconfig, context); err != nil {
+		return nil, err
+	}
+	cs, err := kubernetes.NewForConfig(k8sRESTConfig)
+	if err != nil {
+		return nil, fmt.Errorf("k8s client error: %s", err)
+	}
+	return cs, nil
+}
@@ -0,0 +1,288 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/readiness"
+	"istio.io/pkg/log"
+)
+
+// stateWaitingForWatch is the state of objects whose informer is not synced yet.
+const stateWaitingForWatch = "waiting for watch"
+
+// waiterResync is how often all objects are checked again without any watch event, since the readiness of some
+// objects depends on objects which are not watched, e.g. the endpoints of the Services called by webhooks.
+var waiterResync = 10 * time.Second
+
+// waiterPollInterval is how often objects are checked again while some objects with a readiness checker are polled
+// instead of watched.
+var waiterPollInterval = 2 * time.Second
+
+// watchKey identifies the objects of a type in a namespace, which are watched by a single informer.
+type watchKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+}
+
+// resourceWaiter waits for objects to become ready. Each type and namespace of the objects which has a readiness
+// checker is watched once, and the readiness of the objects is checked again whenever a watch event is received.
+// Objects of other types, or of types which may not be listed and watched, are polled with GET requests instead.
+type resourceWaiter struct {
+	applier   *ServerSideApplier
+	informers map[watchKey]cache.SharedIndexInformer
+	// polled holds the keys which are polled instead of watched.
+	polled map[watchKey]bool
+	// settled holds the polled objects which were found ready, and are not read again.
+	settled map[string]bool
+	// changed receives a value after any watch event.
+	changed chan struct{}
+	// last holds the last reported state of each object.
+	last map[string]string
+}
+
+func newResourceWaiter(a *ServerSideApplier) *resourceWaiter {
+	return &resourceWaiter{
+		applier:   a,
+		informers: make(map[watchKey]cache.SharedIndexInformer),
+		polled:    make(map[watchKey]bool),
+		settled:   make(map[string]bool),
+		changed:   make(chan struct{}, 1),
+		last:      make(map[string]string),
+	}
+}
+
+// wait waits up to timeout for all objects to become ready, printing the changes of their state. If the timeout is
+// reached, the returned error lists the objects which are not ready.
+func (w *resourceWaiter) wait(objects object.K8sObjects, timeout time.Duration) error {
+	stop := make(chan struct{})
+	defer close(stop)
+	for _, o := range objects {
+		if err := w.watch(o, stop); err != nil {
+			return err
+		}
+	}
+
+	interval := waiterResync
+	for key := range w.polled {
+		if readiness.HasChecker(key.gvk) {
+			interval = waiterPollInterval
+			break
+		}
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		notReady, err := w.check(objects)
+		if err != nil {
+			return err
+		}
+		if len(notReady) == 0 {
+			return nil
+		}
+		select {
+		case <-w.changed:
+		case <-ticker.C:
+		case <-timer.C:
+			return fmt.Errorf("timed out after %s, resources not ready: %s", timeout, strings.Join(notReady, ", "))
+		}
+	}
+}
+
+// watch starts an informer for the type and namespace of o, unless one was already started. Types without a readiness
+// checker are not watched, since their objects are usually ready once they exist, and types which may not be listed
+// or watched are polled, since their informer would never sync.
+func (w *resourceWaiter) watch(o *object.K8sObject, stop <-chan struct{}) error {
+	key := w.key(o)
+	if _, ok := w.informers[key]; ok || w.polled[key] {
+		return nil
+	}
+	if !readiness.HasChecker(key.gvk) {
+		w.polled[key] = true
+		return nil
+	}
+	mapping, err := w.applier.mapper.RESTMapping(key.gvk.GroupKind(), key.gvk.Version)
+	if err != nil {
+		return fmt.Errorf("could not watch %s: %s", key.gvk.Kind, err)
+	}
+	namespace := key.namespace
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		namespace = metav1.NamespaceAll
+	}
+	if !w.canWatch(mapping.Resource, namespace) {
+		log.Infof("not allowed to watch %s, polling instead", key.gvk.Kind)
+		w.polled[key] = true
+		return nil
+	}
+	informer := dynamicinformer.NewFilteredDynamicInformer(w.applier.client, mapping.Resource, namespace, 0,
+		cache.Indexers{}, nil).Informer()
+	notify := func() {
+		select {
+		case w.changed <- struct{}{}:
+		default:
+		}
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify() },
+		UpdateFunc: func(interface{}, interface{}) { notify() },
+		DeleteFunc: func(interface{}) { notify() },
+	})
+	go informer.Run(stop)
+	w.informers[key] = informer
+	return nil
+}
+
+// canWatch reports whether resource may be listed and watched in namespace. Errors other than a forbidden request are
+// left to the informer, which retries them.
+func (w *resourceWaiter) canWatch(resource schema.GroupVersionResource, namespace string) bool {
+	ri := w.applier.client.Resource(resource).Namespace(namespace)
+	list, err := ri.List(metav1.ListOptions{Limit: 1})
+	if err == nil {
+		wi, werr := ri.Watch(metav1.ListOptions{ResourceVersion: list.GetResourceVersion()})
+		if werr == nil {
+			wi.Stop()
+		}
+		err = werr
+	}
+	return !errors.IsForbidden(err)
+}
+
+// check returns the objects which are not ready, with their state, and prints the state of each object which
+// changed since the last check. An error is returned if an object can never become ready.
+func (w *resourceWaiter) check(objects object.K8sObjects) ([]string, error) {
+	var notReady []string
+	for _, o := range objects {
+		id := fmt.Sprintf("%s %s", o.Kind, o.Name)
+		if o.Namespace != "" {
+			id = fmt.Sprintf("%s %s/%s", o.Kind, o.Namespace, o.Name)
+		}
+		if w.settled[id] {
+			continue
+		}
+		state, ready, err := w.state(o)
+		if err != nil {
+			return nil, err
+		}
+		if ready && w.polled[w.key(o)] {
+			w.settled[id] = true
+		}
+		if state != stateWaitingForWatch {
+			// Objects which are ready when first seen are not reported.
+			if last, ok := w.last[id]; state != last && (ok || !ready) {
+				logAndPrint("%s: %s", id, state)
+			}
+			w.last[id] = state
+		}
+		if !ready {
+			notReady = append(notReady, fmt.Sprintf("%s (%s)", id, state))
+		}
+	}
+	return notReady, nil
+}
+
+// state returns a description of the state of o, e.g. "1/2 ready", and whether o is ready.
+func (w *resourceWaiter) state(o *object.K8sObject) (string, bool, error) {
+	key := w.key(o)
+	live, synced := w.cached(key, o.Name)
+	if w.polled[key] {
+		var err error
+		if live, err = w.applier.readinessGetter()(key.gvk, key.namespace, o.Name); errors.IsNotFound(err) {
+			live = nil
+		} else if err != nil {
+			return "", false, err
+		}
+	} else if !synced {
+		return stateWaitingForWatch, false, nil
+	}
+	if live == nil {
+		return "not found", false, nil
+	}
+	ready, err := readiness.IsReady(w.get, live)
+	if err != nil {
+		return "", false, err
+	}
+	state := "not ready"
+	if ready {
+		state = "ready"
+	}
+	if r, d, ok := readiness.Replicas(live); ok {
+		state = fmt.Sprintf("%d/%d %s", r, d, state)
+	}
+	return state, ready, nil
+}
+
+// get implements readiness.Getter, reading watched objects from the informer caches and other objects from the
+// API server.
+func (w *resourceWaiter) get(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	key := watchKey{gvk: gvk, namespace: namespace}
+	if _, ok := w.informers[key]; !ok {
+		// Cluster scoped objects are watched without a namespace.
+		key.namespace = ""
+	}
+	if live, synced := w.cached(key, name); synced && live != nil {
+		return live, nil
+	}
+	return w.applier.readinessGetter()(gvk, namespace, name)
+}
+
+// cached returns the object with the given name from the informer cache of key, or nil if it does not exist. synced
+// is false if key is not watched, or its informer is not synced yet.
+func (w *resourceWaiter) cached(key watchKey, name string) (live *unstructured.Unstructured, synced bool) {
+	informer, ok := w.informers[key]
+	if !ok || !informer.HasSynced() {
+		return nil, false
+	}
+	storeKey := name
+	if key.namespace != "" {
+		storeKey = key.namespace + "/" + name
+	}
+	item, exists, err := informer.GetStore().GetByKey(storeKey)
+	if err != nil || !exists {
+		return nil, true
+	}
+	u, ok := item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, true
+	}
+	return u, true
+}
+
+// key returns the watch key of o. Cluster scoped objects have no namespace, and namespaced objects without a
+// namespace are in the default namespace.
+func (w *resourceWaiter) key(o *object.K8sObject) watchKey {
+	gvk := o.GroupVersionKind()
+	mapping, err := w.applier.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err == nil && mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return watchKey{gvk: gvk}
+	}
+	if o.Namespace == "" {
+		return watchKey{gvk: gvk, namespace: metav1.NamespaceDefault}
+	}
+	return watchKey{gvk: gvk, namespace: o.Namespace}
+}
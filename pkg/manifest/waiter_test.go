@@ -0,0 +1,125 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const pilotDeploymentYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  replicas: 2
+status:
+  updatedReplicas: 2
+  readyReplicas: 1
+`
+
+func TestResourceWaiter(t *testing.T) {
+	var out bytes.Buffer
+	SetProgressWriter(&out)
+	defer SetProgressWriter(os.Stdout)
+
+	objects := mustParseObjects(t, pilotDeploymentYAML)
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), objects[0].UnstructuredObject().DeepCopy())
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(deploymentGVK, meta.RESTScopeNamespace)
+	a := NewServerSideApplier(client, mapper)
+
+	err := newResourceWaiter(a).wait(objects, time.Second)
+	want := "resources not ready: Deployment istio-system/istio-pilot (1/2 not ready)"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("got error %v, want %q", err, want)
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		u := objects[0].UnstructuredObject().DeepCopy()
+		if err := unstructured.SetNestedField(u.Object, int64(2), "status", "readyReplicas"); err != nil {
+			t.Error(err)
+			return
+		}
+		ri, _ := a.resourceInterface(deploymentGVK, "istio-system")
+		if _, err := ri.Update(u, metav1.UpdateOptions{}); err != nil {
+			t.Error(err)
+		}
+	}()
+	if err := newResourceWaiter(a).wait(objects, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	for _, progress := range []string{"Deployment istio-system/istio-pilot: 1/2 not ready", "Deployment istio-system/istio-pilot: 2/2 ready"} {
+		if !strings.Contains(out.String(), progress) {
+			t.Errorf("got progress output %q, want %q", out.String(), progress)
+		}
+	}
+}
+
+func TestResourceWaiterPolling(t *testing.T) {
+	SetProgressWriter(&bytes.Buffer{})
+	defer SetProgressWriter(os.Stdout)
+	defer func(d time.Duration) { waiterPollInterval = d }(waiterPollInterval)
+	waiterPollInterval = 50 * time.Millisecond
+
+	objects := mustParseObjects(t, pilotDeploymentYAML+"---"+configMapYAML("cm1", "v1"))
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), objects[0].UnstructuredObject().DeepCopy(),
+		objects[1].UnstructuredObject().DeepCopy())
+	client.PrependReactor("list", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "",
+			fmt.Errorf("list not allowed"))
+	})
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(deploymentGVK, meta.RESTScopeNamespace)
+	mapper.Add(configMapGVK, meta.RESTScopeNamespace)
+	a := NewServerSideApplier(client, mapper)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		u := objects[0].UnstructuredObject().DeepCopy()
+		if err := unstructured.SetNestedField(u.Object, int64(2), "status", "readyReplicas"); err != nil {
+			t.Error(err)
+			return
+		}
+		ri, _ := a.resourceInterface(deploymentGVK, "istio-system")
+		if _, err := ri.Update(u, metav1.UpdateOptions{}); err != nil {
+			t.Error(err)
+		}
+	}()
+	if err := newResourceWaiter(a).wait(objects, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	for _, action := range client.Actions() {
+		if verb := action.GetVerb(); verb == "watch" || verb == "list" && action.GetResource().Resource == "configmaps" {
+			t.Errorf("got %s of %s, want only GET requests", verb, action.GetResource().Resource)
+		}
+	}
+}
@@ -27,6 +27,10 @@ const (
 	// OperatorAPINamespace is the API namespace for operator config.
 	// TODO: move this to a base definitions file when one is created.
 	OperatorAPINamespace = "operator.istio.io"
+
+	// DoNotPruneAnnotation is the annotation which protects a resource from being pruned or deleted by the operator,
+	// both in the CLI and in the controller, if set to "true".
+	DoNotPruneAnnotation = OperatorAPINamespace + "/do-not-prune"
 )
 
 // ComponentName is a component name string, typed to constrain allowed values.
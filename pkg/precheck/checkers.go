@@ -0,0 +1,293 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package precheck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+var (
+	// managedLabel is set on the objects applied by the operator CLI.
+	managedLabel = name.OperatorAPINamespace + "/managed"
+	// controllerLabelPrefix is the prefix of the owner labels set on the objects applied by the operator controller.
+	controllerLabelPrefix = "install." + name.OperatorAPINamespace + "/"
+
+	// requiredVerbs are the verbs needed on each resource type of an install to apply and prune it.
+	requiredVerbs = []string{"get", "create", "patch", "delete"}
+
+	// sharedKinds are the kinds of cluster-wide objects which conflict with an install if another install owns them.
+	sharedKinds = map[string]bool{
+		"CustomResourceDefinition":       true,
+		"MutatingWebhookConfiguration":   true,
+		"ValidatingWebhookConfiguration": true,
+	}
+
+	// cniPlugins are the name prefixes of the DaemonSets of common CNI network plugins, which Istio CNI chains to.
+	cniPlugins = []string{"calico-node", "canal", "cilium", "weave-net", "kube-flannel", "aws-node", "kube-router",
+		"antrea-agent", "azure-cni"}
+)
+
+// checkKubernetesVersion checks that the version of the cluster is supported by the operator.
+func checkKubernetesVersion(p *Params) Results {
+	info, err := p.Client.Discovery().ServerVersion()
+	if err != nil {
+		return Fail("could not get the Kubernetes version: %s", err)
+	}
+	ver, err := goversion.NewVersion(info.GitVersion)
+	if err != nil {
+		return Fail("could not parse the Kubernetes version %q: %s", info.GitVersion, err)
+	}
+	// Vendor suffixes such as -gke.1 are dropped, since a constraint never matches pre-release versions.
+	s := ver.Segments()
+	if ver, err = goversion.NewVersion(fmt.Sprintf("%d.%d.%d", s[0], s[1], s[2])); err != nil {
+		return Fail("could not parse the Kubernetes version %q: %s", info.GitVersion, err)
+	}
+	switch {
+	case p.KubernetesVersions == nil:
+		return Warn("the versions of Kubernetes supported by this operator are not known, found %s", ver)
+	case !p.KubernetesVersions.Check(ver):
+		return Fail("Kubernetes %s is not supported, supported versions are %s", ver, p.KubernetesVersions)
+	}
+	return Pass("Kubernetes %s is supported", ver)
+}
+
+// resourceKey identifies the objects of a resource type in a namespace, which share the same permissions.
+type resourceKey struct {
+	group     string
+	resource  string
+	namespace string
+}
+
+func (k resourceKey) String() string {
+	r := k.resource
+	if k.group != "" {
+		r += "." + k.group
+	}
+	if k.namespace == "" {
+		return r
+	}
+	return fmt.Sprintf("%s in namespace %s", r, k.namespace)
+}
+
+// checkPermissions checks that the user can manage every resource type of the install, using
+// SelfSubjectAccessReviews.
+func checkPermissions(p *Params) Results {
+	crds := crdResources(p.Objects)
+	var keys []resourceKey
+	seen := make(map[resourceKey]bool)
+	unknown := make(map[string]bool)
+	for _, o := range p.Objects {
+		k, err := p.resourceKey(o, crds)
+		if err != nil {
+			unknown[o.Kind] = true
+			continue
+		}
+		if !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+
+	var out Results
+	for _, k := range keys {
+		var denied []string
+		for _, verb := range requiredVerbs {
+			sar := &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Namespace: k.namespace,
+						Verb:      verb,
+						Group:     k.group,
+						Resource:  k.resource,
+					},
+				},
+			}
+			resp, err := p.Client.AuthorizationV1().SelfSubjectAccessReviews().Create(sar)
+			if err != nil {
+				return Fail("could not check permissions: %s", err)
+			}
+			if !resp.Status.Allowed {
+				denied = append(denied, verb)
+			}
+		}
+		if len(denied) != 0 {
+			out = append(out, Fail("missing permissions to %s %s", strings.Join(denied, ", "), k)...)
+		}
+	}
+	if len(unknown) != 0 {
+		out = append(out, Warn("could not check permissions for kinds unknown to the cluster: %s", sortedKeys(unknown))...)
+	}
+	if len(out) == 0 {
+		return Pass("all %d resource types of the install can be managed", len(keys))
+	}
+	return out
+}
+
+// crdResource is a resource type defined by a CustomResourceDefinition.
+type crdResource struct {
+	resource   string
+	namespaced bool
+}
+
+// crdResources returns the resource types defined by the CustomResourceDefinitions in objects, which are not known to
+// the cluster before they are applied.
+func crdResources(objects object.K8sObjects) map[schema.GroupKind]crdResource {
+	out := make(map[schema.GroupKind]crdResource)
+	for _, o := range objects {
+		if o.Kind != "CustomResourceDefinition" {
+			continue
+		}
+		u := o.UnstructuredObject()
+		group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(u.Object, "spec", "names", "kind")
+		plural, _, _ := unstructured.NestedString(u.Object, "spec", "names", "plural")
+		scope, _, _ := unstructured.NestedString(u.Object, "spec", "scope")
+		out[schema.GroupKind{Group: group, Kind: kind}] = crdResource{resource: plural, namespaced: scope != "Cluster"}
+	}
+	return out
+}
+
+// resourceKey returns the resource key of o, using crds for the kinds which are not known to the cluster yet.
+func (p *Params) resourceKey(o *object.K8sObject, crds map[schema.GroupKind]crdResource) (resourceKey, error) {
+	gvk := o.GroupVersionKind()
+	namespace := o.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	if crd, ok := crds[gvk.GroupKind()]; ok {
+		k := resourceKey{group: gvk.Group, resource: crd.resource}
+		if crd.namespaced {
+			k.namespace = namespace
+		}
+		return k, nil
+	}
+	mapping, err := p.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return resourceKey{}, err
+	}
+	k := resourceKey{group: gvk.Group, resource: mapping.Resource.Resource}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		k.namespace = namespace
+	}
+	return k, nil
+}
+
+// checkCNI checks that the cluster runs a CNI network plugin which Istio CNI can chain to, if Istio CNI is enabled.
+func checkCNI(p *Params) Results {
+	if !p.enabled(name.CNIComponentName) {
+		return nil
+	}
+	dsl, err := p.Client.AppsV1().DaemonSets(metav1.NamespaceSystem).List(metav1.ListOptions{})
+	if err != nil {
+		return Fail("could not list the DaemonSets in %s: %s", metav1.NamespaceSystem, err)
+	}
+	for _, ds := range dsl.Items {
+		for _, plugin := range cniPlugins {
+			if strings.HasPrefix(ds.Name, plugin) {
+				return Pass("found CNI network plugin %s", ds.Name)
+			}
+		}
+	}
+	return Warn("no known CNI network plugin found in %s. Istio CNI chains to the network plugin of the cluster, "+
+		"and does not work with kubenet", metav1.NamespaceSystem)
+}
+
+// checkConflicts checks that the CRDs and webhook configurations of the install do not exist already, or are managed
+// by the operator.
+func checkConflicts(p *Params) Results {
+	var out Results
+	shared := 0
+	for _, o := range p.Objects {
+		if !sharedKinds[o.Kind] {
+			continue
+		}
+		shared++
+		live, err := p.Get(o.GroupVersionKind(), o.Namespace, o.Name)
+		switch {
+		case errors.IsNotFound(err):
+			continue
+		case err != nil:
+			out = append(out, Fail("could not get %s %s: %s", o.Kind, o.Name, err)...)
+			continue
+		}
+		if !managedByOperator(live) {
+			out = append(out, Fail("%s %s exists and is not managed by the operator. Istio was installed with "+
+				"non-operator methods, please migrate to operator installation first", o.Kind, o.Name)...)
+		}
+	}
+	if len(out) == 0 && shared != 0 {
+		return Pass("none of the %d CRDs and webhook configurations of the install conflict with other installs", shared)
+	}
+	return out
+}
+
+// managedByOperator reports whether u was applied by the operator CLI or controller.
+func managedByOperator(u *unstructured.Unstructured) bool {
+	for k := range u.GetLabels() {
+		if k == managedLabel || strings.HasPrefix(k, controllerLabelPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkInitCRDJobs checks that Istio was not installed with the istio-init chart, whose CRD jobs must be migrated
+// before the operator can manage the CRDs.
+func checkInitCRDJobs(p *Params) Results {
+	pl, err := p.Client.CoreV1().Pods(p.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return Fail("failed to list pods: %s", err)
+	}
+	return CheckInitCRDPods(pl, p.Namespace)
+}
+
+// CheckInitCRDPods fails if pl, the pods of namespace, holds pods of the CRD jobs of the istio-init chart.
+func CheckInitCRDPods(pl *corev1.PodList, namespace string) Results {
+	for _, pod := range pl.Items {
+		if strings.Contains(pod.Name, "istio-init-crd") {
+			return Fail("istio-init-crd pods exist: %s. Istio was installed with non-operator methods, "+
+				"please migrate to operator installation first", pod.Name)
+		}
+	}
+	return Pass("no istio-init-crd pods found in %s", namespace)
+}
+
+// NewResult returns a result with the given status and a message formatted from format and args.
+func NewResult(status Status, format string, args ...interface{}) *Result {
+	return &Result{Status: status, Message: fmt.Sprintf(format, args...)}
+}
+
+func sortedKeys(m map[string]bool) string {
+	var out []string
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,157 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package precheck holds a registry of pre-flight checks, which validate that a cluster is compatible with an Istio
+// install before it is applied, e.g. by manifest precheck.
+package precheck
+
+import (
+	"sync"
+
+	goversion "github.com/hashicorp/go-version"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/readiness"
+)
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	// StatusPass means the cluster passed the check.
+	StatusPass Status = "Pass"
+	// StatusWarn means the install may not work as expected, but can proceed.
+	StatusWarn Status = "Warn"
+	// StatusFail means the install cannot proceed.
+	StatusFail Status = "Fail"
+)
+
+// Result is a single finding of a check.
+type Result struct {
+	// Check is the name of the check which produced the result.
+	Check   string `json:"check"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+// Results is a list of check results.
+type Results []*Result
+
+// Failed reports whether any of the results failed.
+func (r Results) Failed() bool {
+	for _, rr := range r {
+		if rr.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Params holds the cluster and the install to check.
+type Params struct {
+	// Client is a client for the cluster.
+	Client kubernetes.Interface
+	// Mapper maps the kinds of Objects to their resources.
+	Mapper meta.RESTMapper
+	// Get returns the live state of an object in the cluster, or a NotFound error if it does not exist.
+	Get readiness.Getter
+	// Objects are the rendered objects of the install.
+	Objects object.K8sObjects
+	// Namespace is the namespace Istio is installed to.
+	Namespace string
+	// Components are the enabled components of the install.
+	Components []name.ComponentName
+	// KubernetesVersions are the versions of Kubernetes supported by the operator, if known.
+	KubernetesVersions goversion.Constraints
+}
+
+// enabled reports whether component c is enabled in the install.
+func (p *Params) enabled(c name.ComponentName) bool {
+	for _, cc := range p.Components {
+		if cc == c {
+			return true
+		}
+	}
+	return false
+}
+
+// Checker checks the cluster and install in p and returns its findings. A checker which does not apply to the
+// install returns no results.
+type Checker func(p *Params) Results
+
+type namedChecker struct {
+	name    string
+	checker Checker
+}
+
+var (
+	// checkers holds the registered checkers in the order they are run.
+	checkers   []namedChecker
+	checkersMu sync.RWMutex
+)
+
+func init() {
+	Register("KubernetesVersion", checkKubernetesVersion)
+	Register("Permissions", checkPermissions)
+	Register("CNI", checkCNI)
+	Register("Conflicts", checkConflicts)
+	Register("InitCRDJobs", checkInitCRDJobs)
+}
+
+// Register adds checker c with the given name, replacing any checker already registered with that name.
+func Register(checkName string, c Checker) {
+	checkersMu.Lock()
+	defer checkersMu.Unlock()
+	for i := range checkers {
+		if checkers[i].name == checkName {
+			checkers[i].checker = c
+			return
+		}
+	}
+	checkers = append(checkers, namedChecker{name: checkName, checker: c})
+}
+
+// Run runs all registered checkers against p and returns their results.
+func Run(p *Params) Results {
+	checkersMu.RLock()
+	defer checkersMu.RUnlock()
+	var out Results
+	for _, c := range checkers {
+		for _, r := range c.checker(p) {
+			if r.Check == "" {
+				r.Check = c.name
+			}
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// Pass returns a single passed result with a message formatted from format and args.
+func Pass(format string, args ...interface{}) Results {
+	return Results{NewResult(StatusPass, format, args...)}
+}
+
+// Warn returns a single warning result with a message formatted from format and args.
+func Warn(format string, args ...interface{}) Results {
+	return Results{NewResult(StatusWarn, format, args...)}
+}
+
+// Fail returns a single failed result with a message formatted from format and args.
+func Fail(format string, args ...interface{}) Results {
+	return Results{NewResult(StatusFail, format, args...)}
+}
@@ -0,0 +1,269 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package precheck
+
+import (
+	"testing"
+
+	goversion "github.com/hashicorp/go-version"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+func TestCheckKubernetesVersion(t *testing.T) {
+	tests := []struct {
+		desc        string
+		gitVersion  string
+		constraints string
+		want        Status
+	}{
+		{
+			desc:        "supported",
+			gitVersion:  "v1.16.3",
+			constraints: ">=1.14, <1.18",
+			want:        StatusPass,
+		},
+		{
+			desc:        "supported with vendor suffix",
+			gitVersion:  "v1.15.7-gke.23",
+			constraints: ">=1.14, <1.18",
+			want:        StatusPass,
+		},
+		{
+			desc:        "too old",
+			gitVersion:  "v1.13.12",
+			constraints: ">=1.14, <1.18",
+			want:        StatusFail,
+		},
+		{
+			desc:       "no supported versions",
+			gitVersion: "v1.16.3",
+			want:       StatusWarn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cs := fake.NewSimpleClientset()
+			cs.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: tt.gitVersion}
+			p := &Params{Client: cs}
+			if tt.constraints != "" {
+				c, err := goversion.NewConstraint(tt.constraints)
+				if err != nil {
+					t.Fatal(err)
+				}
+				p.KubernetesVersions = c
+			}
+			got := checkKubernetesVersion(p)
+			if len(got) != 1 || got[0].Status != tt.want {
+				t.Errorf("got %v, want a single %s result", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	objects := mustParseObjects(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: gateways.networking.istio.io
+spec:
+  group: networking.istio.io
+  scope: Namespaced
+  names:
+    kind: Gateway
+    plural: gateways
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: Gateway
+metadata:
+  name: ingressgateway
+  namespace: istio-system
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: unknown
+`)
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"},
+		meta.RESTScopeRoot)
+
+	cs := fake.NewSimpleClientset()
+	var reviewed []string
+	cs.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		ra := sar.Spec.ResourceAttributes
+		reviewed = append(reviewed, ra.Verb+" "+ra.Resource+"."+ra.Group+" "+ra.Namespace)
+		sar.Status.Allowed = !(ra.Resource == "gateways" && ra.Verb == "delete")
+		return true, sar, nil
+	})
+
+	got := checkPermissions(&Params{Client: cs, Mapper: mapper, Objects: objects})
+	want := Results{
+		{Status: StatusFail, Message: "missing permissions to delete gateways.networking.istio.io in namespace istio-system"},
+		{Status: StatusWarn, Message: "could not check permissions for kinds unknown to the cluster: Widget"},
+	}
+	assertResults(t, got, want)
+	if len(reviewed) != 3*len(requiredVerbs) {
+		t.Errorf("got reviews %v, want %d reviews for 3 resource types", reviewed, 3*len(requiredVerbs))
+	}
+}
+
+func TestCheckCNI(t *testing.T) {
+	calico := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "calico-node", Namespace: metav1.NamespaceSystem}}
+	tests := []struct {
+		desc       string
+		components []name.ComponentName
+		objects    []runtime.Object
+		want       Results
+	}{
+		{
+			desc: "cni disabled",
+		},
+		{
+			desc:       "plugin found",
+			components: []name.ComponentName{name.CNIComponentName},
+			objects:    []runtime.Object{calico},
+			want:       Results{{Status: StatusPass, Message: "found CNI network plugin calico-node"}},
+		},
+		{
+			desc:       "no plugin",
+			components: []name.ComponentName{name.CNIComponentName},
+			want: Results{{Status: StatusWarn, Message: "no known CNI network plugin found in kube-system. " +
+				"Istio CNI chains to the network plugin of the cluster, and does not work with kubenet"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p := &Params{Client: fake.NewSimpleClientset(tt.objects...), Components: tt.components}
+			assertResults(t, checkCNI(p), tt.want)
+		})
+	}
+}
+
+func TestCheckConflicts(t *testing.T) {
+	objects := mustParseObjects(t, `
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: istio-sidecar-injector
+---
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: istio-galley
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: gateways.networking.istio.io
+`)
+	live := map[string]map[string]string{
+		"istio-sidecar-injector":       {"app": "sidecarInjectorWebhook"},
+		"gateways.networking.istio.io": {managedLabel: "Reconcile"},
+	}
+	get := func(gvk schema.GroupVersionKind, namespace, n string) (*unstructured.Unstructured, error) {
+		labels, ok := live[n]
+		if !ok {
+			return nil, errors.NewNotFound(schema.GroupResource{Resource: gvk.Kind}, n)
+		}
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		u.SetName(n)
+		u.SetLabels(labels)
+		return u, nil
+	}
+
+	got := checkConflicts(&Params{Get: get, Objects: objects})
+	want := Results{{Status: StatusFail, Message: "MutatingWebhookConfiguration istio-sidecar-injector exists and is " +
+		"not managed by the operator. Istio was installed with non-operator methods, please migrate to operator " +
+		"installation first"}}
+	assertResults(t, got, want)
+
+	delete(live, "istio-sidecar-injector")
+	got = checkConflicts(&Params{Get: get, Objects: objects})
+	want = Results{{Status: StatusPass, Message: "none of the 3 CRDs and webhook configurations of the install " +
+		"conflict with other installs"}}
+	assertResults(t, got, want)
+}
+
+func TestRun(t *testing.T) {
+	checkersMu.RLock()
+	saved := append([]namedChecker(nil), checkers...)
+	checkersMu.RUnlock()
+	defer func() {
+		checkersMu.Lock()
+		defer checkersMu.Unlock()
+		checkers = saved
+	}()
+
+	checkersMu.Lock()
+	checkers = nil
+	checkersMu.Unlock()
+	Register("First", func(*Params) Results { return Pass("first") })
+	Register("Second", func(*Params) Results { return Fail("second") })
+	Register("First", func(*Params) Results { return Warn("replaced") })
+
+	got := Run(&Params{})
+	want := Results{
+		{Check: "First", Status: StatusWarn, Message: "replaced"},
+		{Check: "Second", Status: StatusFail, Message: "second"},
+	}
+	assertResults(t, got, want)
+	if !got.Failed() {
+		t.Error("got not failed, want failed")
+	}
+}
+
+func assertResults(t *testing.T, got, want Results) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d results %v, want %d", len(got), got, len(want))
+	}
+	for i := range got {
+		if *got[i] != *want[i] {
+			t.Errorf("result %d: got %+v, want %+v", i, *got[i], *want[i])
+		}
+	}
+}
+
+func mustParseObjects(t *testing.T, y string) object.K8sObjects {
+	objects, err := object.ParseK8sObjectsFromYAMLManifest(y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return objects
+}
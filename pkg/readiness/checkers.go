@@ -0,0 +1,162 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var endpointsGVK = schema.GroupVersionKind{Version: "v1", Kind: "Endpoints"}
+
+func namespaceReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	return phase == "Active", nil
+}
+
+func podReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	return conditionStatus(obj, "Ready") == "True", nil
+}
+
+// serviceReady reports whether a Service has a cluster IP, unless it is headless, and whether a LoadBalancer Service
+// has an ingress point. ExternalName Services are external to the cluster so they are always ready.
+func serviceReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+	if serviceType == "ExternalName" {
+		return true, nil
+	}
+	if clusterIP, _, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP"); clusterIP == "" {
+		return false, nil
+	}
+	if serviceType == "LoadBalancer" {
+		ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+		return len(ingress) != 0, nil
+	}
+	return true, nil
+}
+
+// deploymentReady reports whether a Deployment is rolled out: all desired replicas are updated and ready, and it is
+// not reported as unavailable.
+func deploymentReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	if !observed(obj) || conditionStatus(obj, "Available") == "False" {
+		return false, nil
+	}
+	desired := specReplicas(obj)
+	return nestedInt64(obj, "status", "updatedReplicas") >= desired && nestedInt64(obj, "status", "readyReplicas") >= desired, nil
+}
+
+// replicasReady reports whether all desired replicas of a StatefulSet, ReplicaSet or ReplicationController are ready.
+func replicasReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	return observed(obj) && nestedInt64(obj, "status", "readyReplicas") >= specReplicas(obj), nil
+}
+
+func daemonSetReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	desired := nestedInt64(obj, "status", "desiredNumberScheduled")
+	return observed(obj) && nestedInt64(obj, "status", "updatedNumberScheduled") >= desired &&
+		nestedInt64(obj, "status", "numberReady") >= desired, nil
+}
+
+// jobReady reports whether a Job completed. A failed Job never becomes ready.
+func jobReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	if conditionStatus(obj, "Failed") == "True" {
+		return false, fmt.Errorf("job failed")
+	}
+	return conditionStatus(obj, "Complete") == "True", nil
+}
+
+// webhookReady reports whether the Services called by a Mutating or ValidatingWebhookConfiguration have ready
+// endpoints, since the API server rejects the requests that the webhooks fail to handle.
+func webhookReady(get Getter, obj *unstructured.Unstructured) (bool, error) {
+	webhooks, _, _ := unstructured.NestedSlice(obj.Object, "webhooks")
+	for _, w := range webhooks {
+		wm, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		namespace, _, _ := unstructured.NestedString(wm, "clientConfig", "service", "namespace")
+		name, found, _ := unstructured.NestedString(wm, "clientConfig", "service", "name")
+		if !found {
+			continue
+		}
+		endpoints, err := get(endpointsGVK, namespace, name)
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !hasReadyAddresses(endpoints) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// conditionsReady is the checker of types without a registered checker, e.g. custom resources. They are ready once
+// their Ready and Available conditions, if any, are true.
+func conditionsReady(_ Getter, obj *unstructured.Unstructured) (bool, error) {
+	for _, t := range []string{"Ready", "Available"} {
+		if s := conditionStatus(obj, t); s != "" && s != "True" {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func hasReadyAddresses(endpoints *unstructured.Unstructured) bool {
+	subsets, _, _ := unstructured.NestedSlice(endpoints.Object, "subsets")
+	for _, s := range subsets {
+		if sm, ok := s.(map[string]interface{}); ok {
+			if addresses, _, _ := unstructured.NestedSlice(sm, "addresses"); len(addresses) != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// conditionStatus returns the status of the status condition of obj with the given type, or "" if there is none.
+func conditionStatus(obj *unstructured.Unstructured, conditionType string) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		if cm, ok := c.(map[string]interface{}); ok && cm["type"] == conditionType {
+			s, _ := cm["status"].(string)
+			return s
+		}
+	}
+	return ""
+}
+
+// observed reports whether the controller of obj has observed its latest spec.
+func observed(obj *unstructured.Unstructured) bool {
+	return nestedInt64(obj, "status", "observedGeneration") >= obj.GetGeneration()
+}
+
+// specReplicas returns spec.replicas of obj, which defaults to 1.
+func specReplicas(obj *unstructured.Unstructured) int64 {
+	r, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		return 1
+	}
+	return r
+}
+
+func nestedInt64(obj *unstructured.Unstructured, fields ...string) int64 {
+	v, _, _ := unstructured.NestedInt64(obj.Object, fields...)
+	return v
+}
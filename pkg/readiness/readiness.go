@@ -0,0 +1,111 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package readiness holds a registry of checkers telling whether a live object is ready, keyed by the type of the
+// object. It is shared by the CLI waiting for applied resources and by the controller readiness gates.
+package readiness
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Getter returns the live state of the object with the given type, namespace and name.
+type Getter func(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error)
+
+// Checker reports whether obj, the live state of an object, is ready. get can be used to look up related objects.
+// An error means obj will never become ready, e.g. a failed Job, and stops any wait for it.
+type Checker func(get Getter, obj *unstructured.Unstructured) (bool, error)
+
+var (
+	// checkers holds the registered checkers. A key without a version matches all versions of the kind.
+	checkers   = make(map[schema.GroupVersionKind]Checker)
+	checkersMu sync.RWMutex
+)
+
+func init() {
+	core := func(kind string) schema.GroupVersionKind { return schema.GroupVersionKind{Kind: kind} }
+	apps := func(kind string) schema.GroupVersionKind { return schema.GroupVersionKind{Group: "apps", Kind: kind} }
+	admission := func(kind string) schema.GroupVersionKind {
+		return schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Kind: kind}
+	}
+	Register(core("Namespace"), namespaceReady)
+	Register(core("Pod"), podReady)
+	Register(core("Service"), serviceReady)
+	Register(core("ReplicationController"), replicasReady)
+	Register(apps("Deployment"), deploymentReady)
+	Register(apps("StatefulSet"), replicasReady)
+	Register(apps("ReplicaSet"), replicasReady)
+	Register(apps("DaemonSet"), daemonSetReady)
+	Register(schema.GroupVersionKind{Group: "batch", Kind: "Job"}, jobReady)
+	Register(admission("MutatingWebhookConfiguration"), webhookReady)
+	Register(admission("ValidatingWebhookConfiguration"), webhookReady)
+}
+
+// Register sets the checker for objects of type gvk, replacing any checker already registered for it. If the
+// version of gvk is empty, c is used for all versions of the kind which have no checker of their own.
+func Register(gvk schema.GroupVersionKind, c Checker) {
+	checkersMu.Lock()
+	defer checkersMu.Unlock()
+	checkers[gvk] = c
+}
+
+// CheckerFor returns the checker for objects of type gvk. Types without a registered checker are ready once all
+// their Ready and Available status conditions, if any, are true.
+func CheckerFor(gvk schema.GroupVersionKind) Checker {
+	checkersMu.RLock()
+	defer checkersMu.RUnlock()
+	if c, ok := checkers[gvk]; ok {
+		return c
+	}
+	if c, ok := checkers[schema.GroupVersionKind{Group: gvk.Group, Kind: gvk.Kind}]; ok {
+		return c
+	}
+	return conditionsReady
+}
+
+// HasChecker reports whether a checker is registered for objects of type gvk.
+func HasChecker(gvk schema.GroupVersionKind) bool {
+	checkersMu.RLock()
+	defer checkersMu.RUnlock()
+	if _, ok := checkers[gvk]; ok {
+		return true
+	}
+	_, ok := checkers[schema.GroupVersionKind{Group: gvk.Group, Kind: gvk.Kind}]
+	return ok
+}
+
+// IsReady reports whether obj, the live state of an object, is ready using the checker registered for its type.
+func IsReady(get Getter, obj *unstructured.Unstructured) (bool, error) {
+	ready, err := CheckerFor(obj.GroupVersionKind())(get, obj)
+	if err != nil {
+		return false, fmt.Errorf("%s %s/%s: %s", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+	return ready, nil
+}
+
+// Replicas returns the number of ready and desired replicas of obj if it is a Deployment, StatefulSet, ReplicaSet,
+// ReplicationController or DaemonSet. ok is false for other types.
+func Replicas(obj *unstructured.Unstructured) (ready, desired int64, ok bool) {
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "ReplicaSet", "ReplicationController":
+		return nestedInt64(obj, "status", "readyReplicas"), specReplicas(obj), true
+	case "DaemonSet":
+		return nestedInt64(obj, "status", "numberReady"), nestedInt64(obj, "status", "desiredNumberScheduled"), true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,252 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+func TestIsReady(t *testing.T) {
+	endpoints := map[string]string{
+		"istio-system/ready": `
+apiVersion: v1
+kind: Endpoints
+subsets:
+- addresses:
+  - ip: 10.0.0.1
+`,
+		"istio-system/not-ready": `
+apiVersion: v1
+kind: Endpoints
+subsets:
+- notReadyAddresses:
+  - ip: 10.0.0.1
+`,
+	}
+	get := func(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+		y, ok := endpoints[namespace+"/"+name]
+		if !ok || gvk != endpointsGVK {
+			return nil, errors.NewNotFound(schema.GroupResource{Resource: "endpoints"}, name)
+		}
+		return mustParse(t, y), nil
+	}
+
+	tests := []struct {
+		desc    string
+		obj     string
+		want    bool
+		wantErr string
+	}{
+		{
+			desc: "deployment rolled out",
+			obj: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  updatedReplicas: 2
+  readyReplicas: 2
+`,
+			want: true,
+		},
+		{
+			desc: "deployment not observed",
+			obj: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  generation: 2
+status:
+  observedGeneration: 1
+  updatedReplicas: 1
+  readyReplicas: 1
+`,
+		},
+		{
+			desc: "daemonset not ready",
+			obj: `
+apiVersion: apps/v1
+kind: DaemonSet
+status:
+  desiredNumberScheduled: 3
+  updatedNumberScheduled: 3
+  numberReady: 1
+`,
+		},
+		{
+			desc: "load balancer service without ingress",
+			obj: `
+apiVersion: v1
+kind: Service
+spec:
+  type: LoadBalancer
+  clusterIP: 10.0.0.2
+`,
+		},
+		{
+			desc: "headless service",
+			obj: `
+apiVersion: v1
+kind: Service
+spec:
+  clusterIP: None
+`,
+			want: true,
+		},
+		{
+			desc: "job complete",
+			obj: `
+apiVersion: batch/v1
+kind: Job
+status:
+  conditions:
+  - type: Complete
+    status: "True"
+`,
+			want: true,
+		},
+		{
+			desc: "job failed",
+			obj: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  namespace: istio-system
+status:
+  conditions:
+  - type: Failed
+    status: "True"
+`,
+			wantErr: "Job istio-system/migrate: job failed",
+		},
+		{
+			desc: "webhook with ready endpoints",
+			obj: `
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingWebhookConfiguration
+webhooks:
+- name: sidecar-injector.istio.io
+  clientConfig:
+    service:
+      namespace: istio-system
+      name: ready
+`,
+			want: true,
+		},
+		{
+			desc: "webhook without ready endpoints",
+			obj: `
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+webhooks:
+- name: ready.istio.io
+  clientConfig:
+    service:
+      namespace: istio-system
+      name: ready
+- name: not-ready.istio.io
+  clientConfig:
+    service:
+      namespace: istio-system
+      name: not-ready
+`,
+		},
+		{
+			desc: "custom resource not ready",
+			obj: `
+apiVersion: example.com/v1
+kind: Certificate
+status:
+  conditions:
+  - type: Ready
+    status: "False"
+`,
+		},
+		{
+			desc: "custom resource without conditions",
+			obj: `
+apiVersion: example.com/v1
+kind: Certificate
+`,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := IsReady(get, mustParse(t, tt.obj))
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tt.wantErr {
+				t.Fatalf("got error %q, want %q", gotErr, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got ready %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	never := func(Getter, *unstructured.Unstructured) (bool, error) { return false, nil }
+	if HasChecker(gvk) {
+		t.Error("got a checker before registering one")
+	}
+	Register(schema.GroupVersionKind{Group: gvk.Group, Kind: gvk.Kind}, never)
+	defer func() {
+		checkersMu.Lock()
+		defer checkersMu.Unlock()
+		delete(checkers, schema.GroupVersionKind{Group: gvk.Group, Kind: gvk.Kind})
+		delete(checkers, gvk)
+	}()
+
+	if !HasChecker(gvk) {
+		t.Error("got no checker with the checker registered for all versions")
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if ready, _ := IsReady(nil, obj); ready {
+		t.Error("got ready with the checker registered for all versions")
+	}
+	Register(gvk, conditionsReady)
+	if ready, _ := IsReady(nil, obj); !ready {
+		t.Error("got not ready with the checker registered for v1")
+	}
+}
+
+func mustParse(t *testing.T, y string) *unstructured.Unstructured {
+	j, err := yaml.YAMLToJSON([]byte(y))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(j); err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
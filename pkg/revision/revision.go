@@ -0,0 +1,297 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package revision transforms rendered manifests so that several revisions of the Istio control plane can be
+// installed side by side in the same namespace.
+package revision
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/pkg/log"
+)
+
+const (
+	// Label is the label carrying the control plane revision. It is set on all revisioned objects and pod
+	// templates, and on namespaces which should be injected by a given revision.
+	Label = "istio.io/rev"
+	// Default is the name used on the command line for the default, unrevisioned control plane.
+	Default = "default"
+	// InjectorWebhookName is the name of the default sidecar injector MutatingWebhookConfiguration.
+	InjectorWebhookName = "istio-sidecar-injector"
+
+	// injectionLabel is the namespace label used by the default sidecar injector webhook.
+	injectionLabel = "istio-injection"
+)
+
+var (
+	// revisionedComponents are the components installed once per revision. All other components are shared
+	// between revisions and owned by the default installation.
+	revisionedComponents = map[name.ComponentName]bool{
+		name.PilotComponentName:           true,
+		name.GalleyComponentName:          true,
+		name.SidecarInjectorComponentName: true,
+	}
+
+	// renamedKinds are the kinds which get a revision suffix. Objects of other kinds, such as Istio config, are
+	// shared between revisions and are dropped from revisioned manifests.
+	renamedKinds = map[string]bool{
+		"ConfigMap":                      true,
+		"Secret":                         true,
+		"Service":                        true,
+		"ServiceAccount":                 true,
+		"Deployment":                     true,
+		"DaemonSet":                      true,
+		"StatefulSet":                    true,
+		"HorizontalPodAutoscaler":        true,
+		"PodDisruptionBudget":            true,
+		"ClusterRole":                    true,
+		"ClusterRoleBinding":             true,
+		"Role":                           true,
+		"RoleBinding":                    true,
+		"MutatingWebhookConfiguration":   true,
+		"ValidatingWebhookConfiguration": true,
+	}
+
+	// referenceSkipKeys are keys whose values are never treated as object references.
+	referenceSkipKeys = map[string]bool{
+		"metadata":    true,
+		"labels":      true,
+		"matchLabels": true,
+		"selector":    true,
+	}
+
+	validRevision = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+)
+
+// FromSpec returns the revision set in values.revision of the given spec, or "" for the default revision.
+func FromSpec(iops *v1alpha1.IstioOperatorSpec) string {
+	if iops == nil || iops.Values == nil {
+		return ""
+	}
+	rev, _ := iops.Values["revision"].(string)
+	if rev == Default {
+		return ""
+	}
+	return rev
+}
+
+// Validate returns an error if rev cannot be used as a revision name.
+func Validate(rev string) error {
+	if rev == "" || rev == Default {
+		return nil
+	}
+	if !validRevision.MatchString(rev) || len(rev) > 30 {
+		return fmt.Errorf("invalid revision %q: must be a DNS label of at most 30 characters", rev)
+	}
+	return nil
+}
+
+// IsRevisioned reports whether a separate instance of component c is installed for each revision.
+func IsRevisioned(c name.ComponentName) bool {
+	return revisionedComponents[c]
+}
+
+// RevisionedComponentNames returns the names of all revisioned components.
+func RevisionedComponentNames() []string {
+	var out []string
+	for c := range revisionedComponents {
+		out = append(out, string(c))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Name returns the name of the object called base for revision rev.
+func Name(base, rev string) string {
+	if rev == "" || rev == Default {
+		return base
+	}
+	return base + "-" + rev
+}
+
+// Apply transforms manifests rendered for the given revision so that they can coexist with other revisions.
+// Only revisioned components are kept and disabled ones are dropped, since shared components belong to the
+// default installation. Objects are renamed with a revision suffix, references between them are updated,
+// the revision label is added to objects, selectors and pod templates, and the injector webhook is restricted
+// to namespaces labeled with the revision.
+func Apply(manifests name.ManifestMap, rev string) (name.ManifestMap, error) {
+	if rev == "" {
+		return manifests, nil
+	}
+	if err := Validate(rev); err != nil {
+		return nil, err
+	}
+
+	componentObjects := make(map[name.ComponentName]object.K8sObjects)
+	renames := make(map[string]string)
+	for c, ms := range manifests {
+		if !IsRevisioned(c) {
+			log.Infof("Skipping shared component %s for revision %s.", c, rev)
+			continue
+		}
+		objs, err := object.ParseK8sObjectsFromYAMLManifest(strings.Join(ms, object.YAMLSeparator))
+		if err != nil {
+			return nil, err
+		}
+		var kept object.K8sObjects
+		for _, o := range objs {
+			if !renamedKinds[o.Kind] {
+				log.Infof("Skipping shared %s %s/%s for revision %s.", o.Kind, o.Namespace, o.Name, rev)
+				continue
+			}
+			renames[o.Name] = Name(o.Name, rev)
+			kept = append(kept, o)
+		}
+		if len(kept) != 0 {
+			componentObjects[c] = kept
+		}
+	}
+
+	out := make(name.ManifestMap)
+	for c, objs := range componentObjects {
+		var transformed object.K8sObjects
+		for _, o := range objs {
+			u := o.UnstructuredObject()
+			u.Object = transform(u.Object, rev, renames)
+			transformed = append(transformed, object.NewK8sObject(u, nil, nil))
+		}
+		ym, err := transformed.YAMLManifest()
+		if err != nil {
+			return nil, err
+		}
+		out[c] = []string{ym}
+	}
+	return out, nil
+}
+
+// transform renames the object in tree and updates its references, labels and selectors for revision rev.
+func transform(tree map[string]interface{}, rev string, renames map[string]string) map[string]interface{} {
+	tree = replaceReferences(tree, renames).(map[string]interface{})
+
+	md, _ := tree["metadata"].(map[string]interface{})
+	if md != nil {
+		if n, ok := md["name"].(string); ok {
+			md["name"] = Name(n, rev)
+		}
+		addLabel(md, "labels", rev)
+	}
+
+	spec, _ := tree["spec"].(map[string]interface{})
+	switch tree["kind"] {
+	case "Deployment", "DaemonSet", "StatefulSet":
+		if sel, ok := spec["selector"].(map[string]interface{}); ok {
+			addLabel(sel, "matchLabels", rev)
+		}
+		if tmpl, ok := spec["template"].(map[string]interface{}); ok {
+			if tmd, ok := tmpl["metadata"].(map[string]interface{}); ok {
+				addLabel(tmd, "labels", rev)
+			}
+		}
+	case "PodDisruptionBudget":
+		if sel, ok := spec["selector"].(map[string]interface{}); ok {
+			addLabel(sel, "matchLabels", rev)
+		}
+	case "Service":
+		if _, ok := spec["selector"]; ok {
+			addLabel(spec, "selector", rev)
+		}
+	case "MutatingWebhookConfiguration":
+		whs, _ := tree["webhooks"].([]interface{})
+		for _, wh := range whs {
+			whm, ok := wh.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			whm["namespaceSelector"] = map[string]interface{}{
+				"matchLabels": map[string]interface{}{Label: rev},
+				"matchExpressions": []interface{}{
+					map[string]interface{}{"key": injectionLabel, "operator": "DoesNotExist"},
+				},
+			}
+		}
+	}
+	return tree
+}
+
+// replaceReferences returns node with references to renamed objects replaced. Strings that are exactly an old
+// name are replaced. Names containing a '-' are also replaced when they appear as a token inside a string, e.g.
+// in a flag, a service host name or a derived secret name. Single word names are too ambiguous for that.
+func replaceReferences(node interface{}, renames map[string]string) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			if referenceSkipKeys[k] {
+				continue
+			}
+			n[k] = replaceReferences(v, renames)
+		}
+		return n
+	case []interface{}:
+		for i, v := range n {
+			n[i] = replaceReferences(v, renames)
+		}
+		return n
+	case string:
+		if nn, ok := renames[n]; ok {
+			return nn
+		}
+		for old, nn := range renames {
+			if strings.Contains(old, "-") {
+				n = replaceToken(n, old, nn)
+			}
+		}
+		return n
+	default:
+		return node
+	}
+}
+
+// replaceToken replaces all occurrences of old in s which are not part of a longer name.
+func replaceToken(s, old, nn string) string {
+	var sb strings.Builder
+	for {
+		idx := strings.Index(s, old)
+		if idx < 0 {
+			sb.WriteString(s)
+			return sb.String()
+		}
+		end := idx + len(old)
+		if (idx == 0 || !isNameChar(s[idx-1])) && (end == len(s) || !isNameChar(s[end])) {
+			sb.WriteString(s[:idx] + nn)
+		} else {
+			sb.WriteString(s[:end])
+		}
+		s = s[end:]
+	}
+}
+
+func isNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-'
+}
+
+func addLabel(parent map[string]interface{}, key, rev string) {
+	labels, _ := parent[key].(map[string]interface{})
+	if labels == nil {
+		labels = make(map[string]interface{})
+	}
+	labels[Label] = rev
+	parent[key] = labels
+}
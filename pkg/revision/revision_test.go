@@ -0,0 +1,188 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+const pilotManifest = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: istio-pilot-service-account
+  namespace: istio-system
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+  labels:
+    app: pilot
+spec:
+  selector:
+    matchLabels:
+      istio: pilot
+  template:
+    metadata:
+      labels:
+        istio: pilot
+    spec:
+      serviceAccountName: istio-pilot-service-account
+      containers:
+      - name: discovery
+        args:
+        - --configmap=istio
+        - --secret=istio.istio-pilot-service-account
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: DestinationRule
+metadata:
+  name: istio-policy
+  namespace: istio-system
+`
+
+const injectorManifest = `
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: istio-sidecar-injector
+webhooks:
+- name: sidecar-injector.istio.io
+  clientConfig:
+    service:
+      name: istio-sidecar-injector
+      namespace: istio-system
+  namespaceSelector:
+    matchLabels:
+      istio-injection: enabled
+`
+
+func TestApply(t *testing.T) {
+	manifests := name.ManifestMap{
+		name.PilotComponentName:           {pilotManifest},
+		name.SidecarInjectorComponentName: {injectorManifest},
+		name.IstioBaseComponentName:       {"apiVersion: v1\nkind: Namespace\nmetadata:\n  name: istio-system\n"},
+	}
+	got, err := Apply(manifests, "canary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got[name.IstioBaseComponentName]; ok {
+		t.Errorf("shared component %s should be dropped", name.IstioBaseComponentName)
+	}
+
+	objs := parse(t, got[name.PilotComponentName])
+	if len(objs) != 2 {
+		t.Fatalf("got %d pilot objects, want 2 (shared Istio config dropped)", len(objs))
+	}
+	d := findObject(t, objs, "Deployment", "istio-pilot-canary")
+	if got := d.UnstructuredObject().GetLabels()[Label]; got != "canary" {
+		t.Errorf("deployment label %s: got %q, want canary", Label, got)
+	}
+	dyml := mustYAML(t, d)
+	for _, want := range []string{
+		"serviceAccountName: istio-pilot-service-account-canary",
+		"--secret=istio.istio-pilot-service-account-canary",
+		"--configmap=istio\n",
+		"istio.io/rev: canary",
+	} {
+		if !strings.Contains(dyml, want) {
+			t.Errorf("deployment does not contain %q:\n%s", want, dyml)
+		}
+	}
+	findObject(t, objs, "ServiceAccount", "istio-pilot-service-account-canary")
+
+	wh := findObject(t, parse(t, got[name.SidecarInjectorComponentName]), "MutatingWebhookConfiguration",
+		"istio-sidecar-injector-canary")
+	svc, _, _ := unstructured.NestedString(wh.UnstructuredObject().Object["webhooks"].([]interface{})[0].(map[string]interface{}),
+		"clientConfig", "service", "name")
+	if svc != "istio-sidecar-injector-canary" {
+		t.Errorf("webhook service: got %q, want istio-sidecar-injector-canary", svc)
+	}
+	whyml := mustYAML(t, wh)
+	for _, want := range []string{"istio.io/rev: canary", "DoesNotExist"} {
+		if !strings.Contains(whyml, want) {
+			t.Errorf("webhook does not contain %q:\n%s", want, whyml)
+		}
+	}
+	if strings.Contains(whyml, "istio-injection: enabled") {
+		t.Errorf("webhook should not select istio-injection=enabled namespaces:\n%s", whyml)
+	}
+}
+
+func TestApplyDefault(t *testing.T) {
+	manifests := name.ManifestMap{name.PilotComponentName: {pilotManifest}}
+	got, err := Apply(manifests, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[name.PilotComponentName][0] != pilotManifest {
+		t.Errorf("default revision manifests should not be changed")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		rev     string
+		wantErr bool
+	}{
+		{rev: ""},
+		{rev: Default},
+		{rev: "canary"},
+		{rev: "1-5-0"},
+		{rev: "Canary", wantErr: true},
+		{rev: "-canary", wantErr: true},
+		{rev: "1.5", wantErr: true},
+		{rev: strings.Repeat("a", 31), wantErr: true},
+	} {
+		if err := Validate(tt.rev); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%q): got error %v, want error %v", tt.rev, err, tt.wantErr)
+		}
+	}
+}
+
+func parse(t *testing.T, ms []string) object.K8sObjects {
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(strings.Join(ms, object.YAMLSeparator))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return objs
+}
+
+func findObject(t *testing.T, objs object.K8sObjects, kind, objName string) *object.K8sObject {
+	for _, o := range objs {
+		if o.Kind == kind && o.Name == objName {
+			return o
+		}
+	}
+	t.Fatalf("%s %s not found in %v", kind, objName, objs)
+	return nil
+}
+
+func mustYAML(t *testing.T, o *object.K8sObject) string {
+	y, err := o.YAMLDebugString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return y
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/dag"
 	"istio.io/operator/pkg/name"
 	"istio.io/operator/pkg/object"
 	"istio.io/operator/pkg/tpath"
@@ -67,6 +68,8 @@ type Translator struct {
 	GlobalNamespaces map[name.ComponentName]string `yaml:"globalNamespaces"`
 	// ComponentMaps is a set of mappings for each Istio component.
 	ComponentMaps map[name.ComponentName]*ComponentMaps `yaml:"componentMaps"`
+	// ComponentDependencies lists the components each component depends on.
+	ComponentDependencies dag.Graph `yaml:"componentDependencies"`
 }
 
 // FeatureMaps is a set of mappings for an Istio feature.
@@ -112,6 +115,9 @@ func NewTranslator(minorVersion version.MinorVersion) (*Translator, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not Unmarshal translateConfig file %s: %s", f, err)
 	}
+	if err := t.ComponentDependencies.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid componentDependencies in translateConfig file %s: %s", f, err)
+	}
 	t.Version = minorVersion
 	return t, nil
 }
@@ -0,0 +1,199 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package schedule parses maintenance windows, which open on a cron schedule and stay open for a fixed duration.
+
+A window is written as the five fields of a cron schedule followed by a duration, e.g. "0 2 * * Sat 4h" for
+Saturdays from 02:00 to 06:00 UTC. The cron fields are minute (0-59), hour (0-23), day of month (1-31), month (1-12
+or Jan-Dec) and day of week (0-7 or Sun-Sat, 0 and 7 being Sunday). Each field is "*" or a comma separated list of
+values and ranges like "1-5", optionally with a step like "0-30/10". A step applies to the whole range of the field
+when it follows "*", and to the values from the one given otherwise. As with cron, a time matches if either
+the day of month or the day of week matches when both are restricted. Times are in UTC.
+*/
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxDuration is the longest window duration.
+	MaxDuration = 7 * 24 * time.Hour
+
+	// searchLimit bounds the search for the next opening of a window, e.g. for Feb 30, which never matches.
+	searchLimit = 5 * 366 * 24 * time.Hour
+)
+
+var (
+	monthNames = map[string]int{"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8,
+		"sep": 9, "oct": 10, "nov": 11, "dec": 12}
+	dayNames = map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+)
+
+// Window is a recurring maintenance window.
+type Window struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record whether the day of month and day of week fields are "*".
+	domStar, dowStar bool
+	// Duration is how long the window stays open.
+	Duration time.Duration
+	spec     string
+}
+
+// ParseWindow parses a window of the form "<minute> <hour> <day of month> <month> <day of week> <duration>".
+func ParseWindow(spec string) (*Window, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("invalid window %q: want 5 cron fields and a duration, e.g. \"0 2 * * Sat 4h\"", spec)
+	}
+	w := &Window{spec: strings.Join(fields, " ")}
+	var err error
+	if w.minute, err = parseField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute in window %q: %s", spec, err)
+	}
+	if w.hour, err = parseField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour in window %q: %s", spec, err)
+	}
+	if w.dom, err = parseField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid day of month in window %q: %s", spec, err)
+	}
+	if w.month, err = parseField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, fmt.Errorf("invalid month in window %q: %s", spec, err)
+	}
+	if w.dow, err = parseField(fields[4], 0, 7, dayNames); err != nil {
+		return nil, fmt.Errorf("invalid day of week in window %q: %s", spec, err)
+	}
+	// 7 is also Sunday.
+	if w.dow&(1<<7) != 0 {
+		w.dow |= 1
+	}
+	w.domStar, w.dowStar = fields[2] == "*", fields[4] == "*"
+
+	if w.Duration, err = time.ParseDuration(fields[5]); err != nil {
+		return nil, fmt.Errorf("invalid duration in window %q: %s", spec, err)
+	}
+	if w.Duration < time.Minute || w.Duration > MaxDuration {
+		return nil, fmt.Errorf("invalid duration in window %q: must be between 1m and %s", spec, MaxDuration)
+	}
+	return w, nil
+}
+
+// String returns the window as parsed.
+func (w *Window) String() string {
+	return w.spec
+}
+
+// Contains reports whether the window is open at t.
+func (w *Window) Contains(t time.Time) bool {
+	t = t.UTC()
+	// The latest possible opening is the minute of t, the earliest a duration before.
+	for start := t.Truncate(time.Minute); t.Sub(start) < w.Duration; start = start.Add(-time.Minute) {
+		if w.matches(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the next time after t the window opens, or the zero time if it never opens.
+func (w *Window) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(searchLimit)
+	for t.Before(limit) {
+		switch {
+		case w.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !w.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case w.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case w.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// matches reports whether the window opens at the minute of t, which is in UTC.
+func (w *Window) matches(t time.Time) bool {
+	return w.minute&(1<<uint(t.Minute())) != 0 && w.hour&(1<<uint(t.Hour())) != 0 &&
+		w.month&(1<<uint(t.Month())) != 0 && w.dayMatches(t)
+}
+
+// dayMatches reports whether the day of t matches the day of month and day of week fields.
+func (w *Window) dayMatches(t time.Time) bool {
+	dom := w.dom&(1<<uint(t.Day())) != 0
+	dow := w.dow&(1<<uint(t.Weekday())) != 0
+	if w.domStar || w.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// parseField returns the bit set of the values matched by field, which must be between min and max. names maps
+// lower case names to values.
+func parseField(field string, min, max int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			rangePart, step = part[:i], s
+		}
+		lo, hi := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseValue(bounds[0], names); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = parseValue(bounds[1], names); err != nil {
+					return 0, err
+				}
+			} else if step != 1 {
+				// "a/n" is short for "a-max/n".
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%q is out of range %d-%d", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	return v, nil
+}
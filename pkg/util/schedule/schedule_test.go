@@ -0,0 +1,117 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr string
+	}{
+		{spec: "0 2 * * Sat 4h"},
+		{spec: "*/15 0-6,22,23 1-7 jan-mar,dec 1-5 30m"},
+		{spec: "0 0 * * 7 24h"},
+		{spec: "0 2 * * Sat", wantErr: "want 5 cron fields and a duration"},
+		{spec: "60 2 * * * 1h", wantErr: "invalid minute"},
+		{spec: "0 2 * * Sun-Foo 1h", wantErr: "invalid day of week"},
+		{spec: "0 2 * 13 * 1h", wantErr: "invalid month"},
+		{spec: "0 5-2 * * * 1h", wantErr: "invalid hour"},
+		{spec: "*/0 * * * * 1h", wantErr: "invalid step"},
+		{spec: "0 2 * * * forever", wantErr: "invalid duration"},
+		{spec: "0 2 * * * 8d", wantErr: "invalid duration"},
+		{spec: "0 2 * * * 200h", wantErr: "must be between"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := ParseWindow(tt.spec)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("got error %q, want none", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("got error %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		spec         string
+		at           string
+		wantContains bool
+		wantNext     string
+	}{
+		{
+			// Saturday 2020-02-01 03:00 is in the window.
+			spec:         "0 2 * * Sat 4h",
+			at:           "2020-02-01T03:00:00Z",
+			wantContains: true,
+			wantNext:     "2020-02-08T02:00:00Z",
+		},
+		{
+			// The window closes at 06:00.
+			spec:     "0 2 * * Sat 4h",
+			at:       "2020-02-01T06:00:00Z",
+			wantNext: "2020-02-08T02:00:00Z",
+		},
+		{
+			// A window opening on Friday night stays open on Saturday.
+			spec:         "0 22 * * Fri 8h",
+			at:           "2020-02-01T05:59:00Z",
+			wantContains: true,
+			wantNext:     "2020-02-07T22:00:00Z",
+		},
+		{
+			spec:     "30 1 15 * * 1h",
+			at:       "2020-01-31T12:00:00+01:00",
+			wantNext: "2020-02-15T01:30:00Z",
+		},
+		{
+			// Either the day of month or the day of week matches.
+			spec:         "0 0 1 * Mon 24h",
+			at:           "2020-02-03T12:00:00Z",
+			wantContains: true,
+			wantNext:     "2020-02-10T00:00:00Z",
+		},
+		{
+			spec:     "0 0 30 2 * 1h",
+			at:       "2020-02-01T00:00:00Z",
+			wantNext: "0001-01-01T00:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec+" at "+tt.at, func(t *testing.T) {
+			w, err := ParseWindow(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			at, err := time.Parse(time.RFC3339, tt.at)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := w.Contains(at); got != tt.wantContains {
+				t.Errorf("Contains: got %v, want %v", got, tt.wantContains)
+			}
+			if got := w.Next(at).Format(time.RFC3339); got != tt.wantNext {
+				t.Errorf("Next: got %s, want %s", got, tt.wantNext)
+			}
+		})
+	}
+}
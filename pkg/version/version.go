@@ -23,12 +23,13 @@ import (
 )
 
 // CompatibilityMapping is a mapping from an Istio operator version and the corresponding recommended and
-// supported versions of Istio.
+// supported versions of Istio, and the versions of Kubernetes it can install to.
 type CompatibilityMapping struct {
-	OperatorVersion          *goversion.Version    `json:"operatorVersion,omitempty"`
-	OperatorVersionRange     goversion.Constraints `json:"operatorVersionRange,omitempty"`
-	SupportedIstioVersions   goversion.Constraints `json:"supportedIstioVersions,omitempty"`
-	RecommendedIstioVersions goversion.Constraints `json:"recommendedIstioVersions,omitempty"`
+	OperatorVersion             *goversion.Version    `json:"operatorVersion,omitempty"`
+	OperatorVersionRange        goversion.Constraints `json:"operatorVersionRange,omitempty"`
+	SupportedIstioVersions      goversion.Constraints `json:"supportedIstioVersions,omitempty"`
+	RecommendedIstioVersions    goversion.Constraints `json:"recommendedIstioVersions,omitempty"`
+	SupportedKubernetesVersions goversion.Constraints `json:"supportedKubernetesVersions,omitempty"`
 }
 
 // NewVersionFromString creates a new Version from the provided SemVer formatted string and returns a pointer to it.
@@ -73,6 +74,9 @@ func (v *CompatibilityMapping) MarshalYAML() (interface{}, error) {
 	if v.RecommendedIstioVersions != nil {
 		out["recommendedIstioVersions"] = v.RecommendedIstioVersions.String()
 	}
+	if v.SupportedKubernetesVersions != nil {
+		out["supportedKubernetesVersions"] = v.SupportedKubernetesVersions.String()
+	}
 	if len(out) == 0 {
 		return nil, nil
 	}
@@ -82,10 +86,11 @@ func (v *CompatibilityMapping) MarshalYAML() (interface{}, error) {
 // UnmarshalYAML implements the Unmarshaler interface.
 func (v *CompatibilityMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	type inStruct struct {
-		OperatorVersion          string `yaml:"operatorVersion"`
-		OperatorVersionRange     string `yaml:"operatorVersionRange"`
-		SupportedIstioVersions   string `yaml:"supportedIstioVersions"`
-		RecommendedIstioVersions string `yaml:"recommendedIstioVersions"`
+		OperatorVersion             string `yaml:"operatorVersion"`
+		OperatorVersionRange        string `yaml:"operatorVersionRange"`
+		SupportedIstioVersions      string `yaml:"supportedIstioVersions"`
+		RecommendedIstioVersions    string `yaml:"recommendedIstioVersions"`
+		SupportedKubernetesVersions string `yaml:"supportedKubernetesVersions"`
 	}
 	tmp := inStruct{}
 	if err := unmarshal(&tmp); err != nil {
@@ -122,6 +127,11 @@ func (v *CompatibilityMapping) UnmarshalYAML(unmarshal func(interface{}) error)
 			return err
 		}
 	}
+	if tmp.SupportedKubernetesVersions != "" {
+		if v.SupportedKubernetesVersions, err = goversion.NewConstraint(tmp.SupportedKubernetesVersions); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
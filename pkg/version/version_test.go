@@ -120,6 +120,7 @@ operatorVersion: 1.3.0
 operatorVersionRange: 1.3.0
 recommendedIstioVersions: '>= 1, < 1.4'
 supportedIstioVersions: '> 1.1, < 1.4.0, = 1.5.2'
+supportedKubernetesVersions: '>= 1.14, < 1.18'
 `,
 		},
 		{
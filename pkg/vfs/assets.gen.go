@@ -230,6 +230,7 @@
 // ../../data/operator/templates/namespace.yaml
 // ../../data/operator/templates/service.yaml
 // ../../data/operator/templates/service_account.yaml
+// ../../data/operator/templates/webhook.yaml
 // ../../data/profiles/default.yaml
 // ../../data/profiles/demo.yaml
 // ../../data/profiles/empty.yaml
@@ -241,6 +242,7 @@
 // ../../data/translateConfig/translateConfig-1.3.yaml
 // ../../data/translateConfig/translateConfig-1.4.yaml
 // ../../data/translateConfig/translateConfig-1.5.yaml
+// ../../data/upgrade-hooks.yaml
 // ../../data/versions.yaml
 package vfs
 
@@ -38952,6 +38954,9 @@ spec:
           - istio-operator
           - server
           imagePullPolicy: IfNotPresent
+          ports:
+          - name: https-webhook
+            containerPort: 9443
           resources:
             limits:
               cpu: 200m
@@ -38968,6 +38973,10 @@ spec:
               valueFrom:
                 fieldRef:
                   fieldPath: metadata.name
+            - name: POD_NAMESPACE
+              valueFrom:
+                fieldRef:
+                  fieldPath: metadata.namespace
             - name: OPERATOR_NAME
               value: {{.Values.operatorNamespace}}
 ---
@@ -39025,6 +39034,9 @@ spec:
   - name: http-metrics
     port: 8383
     targetPort: 8383
+  - name: https-webhook
+    port: 443
+    targetPort: 9443
   selector:
     name: istio-operator
 ---
@@ -39068,6 +39080,73 @@ func operatorTemplatesService_accountYaml() (*asset, error) {
 	return a, nil
 }
 
+var _operatorTemplatesWebhookYaml = []byte(`# The operator sets the caBundle of the webhooks from the certificates it generates.
+# The failure policy is Ignore, so that IstioOperator resources can be applied before the operator is running.
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: istio-operator
+webhooks:
+  - name: mutate.istiooperator.install.istio.io
+    clientConfig:
+      service:
+        name: istio-operator
+        namespace: {{.Values.operatorNamespace}}
+        path: /mutate-istiooperator
+    rules:
+      - operations:
+        - CREATE
+        - UPDATE
+        apiGroups:
+        - install.istio.io
+        apiVersions:
+        - v1alpha1
+        resources:
+        - istiooperators
+    failurePolicy: Ignore
+    sideEffects: None
+---
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: istio-operator
+webhooks:
+  - name: validate.istiooperator.install.istio.io
+    clientConfig:
+      service:
+        name: istio-operator
+        namespace: {{.Values.operatorNamespace}}
+        path: /validate-istiooperator
+    rules:
+      - operations:
+        - CREATE
+        - UPDATE
+        apiGroups:
+        - install.istio.io
+        apiVersions:
+        - v1alpha1
+        resources:
+        - istiooperators
+    failurePolicy: Ignore
+    sideEffects: None
+---
+`)
+
+func operatorTemplatesWebhookYamlBytes() ([]byte, error) {
+	return _operatorTemplatesWebhookYaml, nil
+}
+
+func operatorTemplatesWebhookYaml() (*asset, error) {
+	bytes, err := operatorTemplatesWebhookYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "operator/templates/webhook.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _profilesDefaultYaml = []byte(`apiVersion: operator.istio.io/v1alpha1
 kind: IstioOperator
 spec:
@@ -40702,6 +40781,22 @@ globalNamespaces:
   Prometheus: "prometheusNamespace"
   Citadel:    "securityNamespace"
 
+# componentDependencies lists the components each component depends on. A component is only applied once all of its
+# dependencies have been applied and, when waiting for resources, are ready.
+componentDependencies:
+  Pilot:           ["Base"]
+  Galley:          ["Base"]
+  SidecarInjector: ["Base"]
+  Policy:          ["Base"]
+  Telemetry:       ["Base"]
+  Citadel:         ["Base"]
+  NodeAgent:       ["Base"]
+  CertManager:     ["Base"]
+  Cni:             ["Base"]
+  IngressGateways: ["Pilot"]
+  EgressGateways:  ["Pilot"]
+  Addon:           ["Base"]
+
 componentMaps:
   Base:
     ToHelmValuesTreeRoot: "global"
@@ -40825,6 +40920,72 @@ func translateconfigTranslateconfig15Yaml() (*asset, error) {
 	return a, nil
 }
 
+var _upgradeHooksYaml = []byte(`# Hooks run by upgrade before and after the control plane is upgraded. Each hook runs a built-in action of pkg/hooks
+# if the source and target versions of the upgrade match its constraints. Hooks in a file passed with --hooks are run
+# after these.
+preUpgrade:
+- name: check-init-crd-jobs
+  sourceVersions: ">=1.3"
+  targetVersions: ">=1.3"
+  action: checkInitCRDJobs
+- name: check-sidecar-skew
+  sourceVersions: ">=1.3"
+  targetVersions: ">=1.3"
+  action: verifySidecarVersions
+  params:
+    maxMinorSkew: 2
+postUpgrade:
+- name: convert-mixer-config
+  sourceVersions: ">=1.3, <1.5"
+  targetVersions: ">=1.5"
+  action: convertMixerConfig
+  params:
+    adapters: [bypass, circonus, cloudwatch, denier, dogstatsd, fluentd, kubernetesenv, list, listchecker, memquota,
+      noop, opa, prometheus, rbac, redisquota, signalfx, solarwinds, stackdriver, statsd, stdio, zipkin]
+    templates: [apikey, authorization, checknothing, edge, kubernetes, listentry, logentry, metric, quota,
+      reportnothing, tracespan]
+- name: remove-node-agent
+  sourceVersions: ">=1.3, <1.5"
+  targetVersions: ">=1.5"
+  action: removeDeprecatedResources
+  params:
+    resources:
+    - apiVersion: apps/v1
+      kind: DaemonSet
+      name: istio-nodeagent
+    - apiVersion: v1
+      kind: ServiceAccount
+      name: istio-nodeagent-service-account
+- name: migrate-crd-storage
+  sourceVersions: ">=1.3, <1.5"
+  targetVersions: ">=1.5"
+  action: migrateCRDStorageVersion
+  params:
+    crds:
+    - authorizationpolicies.security.istio.io
+    - meshpolicies.authentication.istio.io
+    - policies.authentication.istio.io
+- name: verify-sidecar-versions
+  sourceVersions: ">=1.3"
+  targetVersions: ">=1.3"
+  action: verifySidecarVersions
+`)
+
+func upgradeHooksYamlBytes() ([]byte, error) {
+	return _upgradeHooksYaml, nil
+}
+
+func upgradeHooksYaml() (*asset, error) {
+	bytes, err := upgradeHooksYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "upgrade-hooks.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _versionsYaml = []byte(`- operatorVersion: 1.3.0
   supportedIstioVersions: 1.3.0
   recommendedIstioVersions: 1.3.0
@@ -40863,10 +41024,12 @@ var _versionsYaml = []byte(`- operatorVersion: 1.3.0
   operatorVersionRange: ">=1.4.3,<1.5.0"
   supportedIstioVersions: ">=1.3.3, <1.6"
   recommendedIstioVersions: 1.4.3
+  supportedKubernetesVersions: ">=1.13, <1.17"
 - operatorVersion: 1.5.0
   operatorVersionRange: ">=1.5.0,<1.6.0"
   supportedIstioVersions: ">=1.5.0, <1.6"
   recommendedIstioVersions: 1.5.0
+  supportedKubernetesVersions: ">=1.14, <1.18"
 `)
 
 func versionsYamlBytes() ([]byte, error) {
@@ -41166,6 +41329,7 @@ var _bindata = map[string]func() (*asset, error){
 	"operator/templates/namespace.yaml":                                                   operatorTemplatesNamespaceYaml,
 	"operator/templates/service.yaml":                                                     operatorTemplatesServiceYaml,
 	"operator/templates/service_account.yaml":                                             operatorTemplatesService_accountYaml,
+	"operator/templates/webhook.yaml":                                                     operatorTemplatesWebhookYaml,
 	"profiles/default.yaml":                                                               profilesDefaultYaml,
 	"profiles/demo.yaml":                                                                  profilesDemoYaml,
 	"profiles/empty.yaml":                                                                 profilesEmptyYaml,
@@ -41177,6 +41341,7 @@ var _bindata = map[string]func() (*asset, error){
 	"translateConfig/translateConfig-1.3.yaml":                                            translateconfigTranslateconfig13Yaml,
 	"translateConfig/translateConfig-1.4.yaml":                                            translateconfigTranslateconfig14Yaml,
 	"translateConfig/translateConfig-1.5.yaml":                                            translateconfigTranslateconfig15Yaml,
+	"upgrade-hooks.yaml":                                                                  upgradeHooksYaml,
 	"versions.yaml":                                                                       versionsYaml,
 }
 
@@ -41557,6 +41722,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"namespace.yaml":           &bintree{operatorTemplatesNamespaceYaml, map[string]*bintree{}},
 			"service.yaml":             &bintree{operatorTemplatesServiceYaml, map[string]*bintree{}},
 			"service_account.yaml":     &bintree{operatorTemplatesService_accountYaml, map[string]*bintree{}},
+			"webhook.yaml":             &bintree{operatorTemplatesWebhookYaml, map[string]*bintree{}},
 		}},
 	}},
 	"profiles": &bintree{nil, map[string]*bintree{
@@ -41574,7 +41740,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"translateConfig-1.4.yaml":        &bintree{translateconfigTranslateconfig14Yaml, map[string]*bintree{}},
 		"translateConfig-1.5.yaml":        &bintree{translateconfigTranslateconfig15Yaml, map[string]*bintree{}},
 	}},
-	"versions.yaml": &bintree{versionsYaml, map[string]*bintree{}},
+	"upgrade-hooks.yaml": &bintree{upgradeHooksYaml, map[string]*bintree{}},
+	"versions.yaml":      &bintree{versionsYaml, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
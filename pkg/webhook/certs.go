@@ -0,0 +1,305 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	certutil "k8s.io/client-go/util/cert"
+
+	"istio.io/pkg/log"
+)
+
+const (
+	// serviceName is the name of the operator Service, which the webhook configurations point to.
+	serviceName = "istio-operator"
+	// configName is the name of the MutatingWebhookConfiguration and ValidatingWebhookConfiguration of the operator.
+	configName = "istio-operator"
+	// secretName is the name of the Secret the webhook certificates are stored in.
+	secretName = "istio-operator-webhook-certs"
+	// caCertKey is the key of the CA certificate in the Secret.
+	caCertKey = "ca.crt"
+
+	// certRenewBefore is how long before they expire the certificates are replaced.
+	certRenewBefore = 30 * 24 * time.Hour
+	// certCheckInterval is how often the certificates and the CA bundles of the webhook configurations are checked.
+	certCheckInterval = 10 * time.Minute
+	// maxStoreAttempts is the number of attempts to store new certificates, when other replicas store theirs first.
+	maxStoreAttempts = 3
+)
+
+// certManager generates the self-signed certificates of the webhooks and keeps them, the certificate files of the
+// webhook server and the CA bundles of the webhook configurations in sync.
+type certManager struct {
+	client    kubernetes.Interface
+	namespace string
+	// certDir is the directory the webhook server reads the certificate and key from.
+	certDir string
+}
+
+// certs are the certificates of the webhooks.
+type certs struct {
+	// cert is the PEM encoded serving certificate, followed by the CA certificate.
+	cert []byte
+	// key is the PEM encoded private key of the serving certificate.
+	key []byte
+	// ca is the PEM encoded CA certificate.
+	ca []byte
+}
+
+// Start checks the certificates every certCheckInterval until stop is closed.
+func (m *certManager) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(certCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+			if err := m.ensure(); err != nil {
+				log.Errorf("Failed to check the webhook certificates: %s", err)
+			}
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica serves the webhooks, so each keeps its
+// certificate files up to date.
+func (m *certManager) NeedLeaderElection() bool {
+	return false
+}
+
+// ensure makes sure valid certificates are stored in the Secret and written to certDir, and that the webhook
+// configurations trust their CA. Certificates which expire within certRenewBefore are replaced.
+func (m *certManager) ensure() error {
+	c, err := m.loadOrCreate()
+	if err != nil {
+		return err
+	}
+	if err := m.writeFiles(c); err != nil {
+		return err
+	}
+	return m.patchCABundles(c.ca)
+}
+
+// loadOrCreate returns the certificates in the Secret, or new certificates if they are missing or about to expire.
+func (m *certManager) loadOrCreate() (*certs, error) {
+	for attempt := 0; attempt < maxStoreAttempts; attempt++ {
+		secret, err := m.client.CoreV1().Secrets(m.namespace).Get(secretName, metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+			secret = nil
+		case err != nil:
+			return nil, fmt.Errorf("could not get the webhook certificates: %s", err)
+		}
+		if c := certsFromSecret(secret); c != nil && c.validFor(m.dnsName(), time.Now().Add(certRenewBefore)) {
+			return c, nil
+		}
+
+		log.Infof("Generating the webhook certificates for %s", m.dnsName())
+		c, err := generateCerts(m.dnsName(), m.namespace)
+		if err != nil {
+			return nil, err
+		}
+		err = m.store(secret, c)
+		if err == nil {
+			return c, nil
+		}
+		// Another replica stored its certificates first, use those.
+		if !errors.IsAlreadyExists(err) && !errors.IsConflict(err) {
+			return nil, fmt.Errorf("could not store the webhook certificates: %s", err)
+		}
+	}
+	return nil, fmt.Errorf("could not store the webhook certificates after %d attempts", maxStoreAttempts)
+}
+
+// store stores c in the Secret, creating the Secret if existing is nil.
+func (m *certManager) store(existing *corev1.Secret, c *certs) error {
+	data := map[string][]byte{
+		corev1.TLSCertKey:       c.cert,
+		corev1.TLSPrivateKeyKey: c.key,
+		caCertKey:               c.ca,
+	}
+	if existing == nil {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: m.namespace},
+			Type:       corev1.SecretTypeTLS,
+			Data:       data,
+		}
+		_, err := m.client.CoreV1().Secrets(m.namespace).Create(secret)
+		return err
+	}
+	secret := existing.DeepCopy()
+	secret.Data = data
+	_, err := m.client.CoreV1().Secrets(m.namespace).Update(secret)
+	return err
+}
+
+// writeFiles writes the certificate and key in c to certDir, if they changed. The webhook server reloads them when
+// they are written. The key and certificate are written to temporary files first, and then renamed in that order, so
+// that the server never reads a partially written file and sees a mismatched pair for as short as possible.
+func (m *certManager) writeFiles(c *certs) error {
+	if err := os.MkdirAll(m.certDir, 0700); err != nil {
+		return fmt.Errorf("could not create the webhook certificate directory %s: %s", m.certDir, err)
+	}
+	type certFile struct {
+		path string
+		data []byte
+		tmp  string
+	}
+	var changed []*certFile
+	defer func() {
+		for _, f := range changed {
+			// Temporary files which were renamed do not exist anymore.
+			_ = os.Remove(f.tmp)
+		}
+	}()
+	for _, f := range []*certFile{
+		{path: filepath.Join(m.certDir, corev1.TLSPrivateKeyKey), data: c.key},
+		{path: filepath.Join(m.certDir, corev1.TLSCertKey), data: c.cert},
+	} {
+		if old, err := ioutil.ReadFile(f.path); err == nil && bytes.Equal(old, f.data) {
+			continue
+		}
+		tmp, err := ioutil.TempFile(m.certDir, "."+filepath.Base(f.path))
+		if err != nil {
+			return fmt.Errorf("could not write the webhook certificate file %s: %s", f.path, err)
+		}
+		f.tmp = tmp.Name()
+		changed = append(changed, f)
+		_, err = tmp.Write(f.data)
+		if cerr := tmp.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return fmt.Errorf("could not write the webhook certificate file %s: %s", f.path, err)
+		}
+	}
+	for _, f := range changed {
+		if err := os.Rename(f.tmp, f.path); err != nil {
+			return fmt.Errorf("could not write the webhook certificate file %s: %s", f.path, err)
+		}
+	}
+	return nil
+}
+
+// patchCABundles sets the CA bundle of the operator webhook configurations to ca. Missing webhook configurations are
+// skipped, since the operator manifest may not include them.
+func (m *certManager) patchCABundles(ca []byte) error {
+	admissionClient := m.client.AdmissionregistrationV1beta1()
+	mwc, err := admissionClient.MutatingWebhookConfigurations().Get(configName, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		log.Warnf("MutatingWebhookConfiguration %s not found, skipping its CA bundle", configName)
+	case err != nil:
+		return fmt.Errorf("could not get MutatingWebhookConfiguration %s: %s", configName, err)
+	default:
+		changed := false
+		for i := range mwc.Webhooks {
+			if !bytes.Equal(mwc.Webhooks[i].ClientConfig.CABundle, ca) {
+				mwc.Webhooks[i].ClientConfig.CABundle = ca
+				changed = true
+			}
+		}
+		if changed {
+			if _, err := admissionClient.MutatingWebhookConfigurations().Update(mwc); err != nil {
+				return fmt.Errorf("could not update the CA bundle of MutatingWebhookConfiguration %s: %s", configName, err)
+			}
+		}
+	}
+
+	vwc, err := admissionClient.ValidatingWebhookConfigurations().Get(configName, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		log.Warnf("ValidatingWebhookConfiguration %s not found, skipping its CA bundle", configName)
+	case err != nil:
+		return fmt.Errorf("could not get ValidatingWebhookConfiguration %s: %s", configName, err)
+	default:
+		changed := false
+		for i := range vwc.Webhooks {
+			if !bytes.Equal(vwc.Webhooks[i].ClientConfig.CABundle, ca) {
+				vwc.Webhooks[i].ClientConfig.CABundle = ca
+				changed = true
+			}
+		}
+		if changed {
+			if _, err := admissionClient.ValidatingWebhookConfigurations().Update(vwc); err != nil {
+				return fmt.Errorf("could not update the CA bundle of ValidatingWebhookConfiguration %s: %s", configName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// dnsName is the name the API server uses to call the webhooks.
+func (m *certManager) dnsName() string {
+	return fmt.Sprintf("%s.%s.svc", serviceName, m.namespace)
+}
+
+// generateCerts returns a new self-signed CA and a serving certificate for dnsName signed by it, valid for a year.
+func generateCerts(dnsName, namespace string) (*certs, error) {
+	cert, key, err := certutil.GenerateSelfSignedCertKey(dnsName, nil, []string{
+		fmt.Sprintf("%s.%s", serviceName, namespace),
+		fmt.Sprintf("%s.cluster.local", dnsName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not generate the webhook certificates: %s", err)
+	}
+	chain, err := certutil.ParseCertsPEM(cert)
+	if err != nil || len(chain) != 2 {
+		return nil, fmt.Errorf("could not parse the generated webhook certificates: %v", err)
+	}
+	ca, err := certutil.EncodeCertificates(chain[1])
+	if err != nil {
+		return nil, err
+	}
+	return &certs{cert: cert, key: key, ca: ca}, nil
+}
+
+// certsFromSecret returns the certificates in secret, or nil if secret is nil or incomplete.
+func certsFromSecret(secret *corev1.Secret) *certs {
+	if secret == nil {
+		return nil
+	}
+	c := &certs{cert: secret.Data[corev1.TLSCertKey], key: secret.Data[corev1.TLSPrivateKeyKey], ca: secret.Data[caCertKey]}
+	if len(c.cert) == 0 || len(c.key) == 0 || len(c.ca) == 0 {
+		return nil
+	}
+	return c
+}
+
+// validFor reports whether the serving certificate in c is signed by the CA in c and valid for dnsName at notAfter.
+func (c *certs) validFor(dnsName string, notAfter time.Time) bool {
+	chain, err := certutil.ParseCertsPEM(c.cert)
+	if err != nil || len(chain) == 0 {
+		return false
+	}
+	roots, err := certutil.NewPoolFromBytes(c.ca)
+	if err != nil {
+		return false
+	}
+	_, err = chain[0].Verify(x509.VerifyOptions{DNSName: dnsName, Roots: roots, CurrentTime: notAfter})
+	return err == nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package webhook serves the admission webhooks for IstioOperator resources. The validating webhook rejects an
+IstioOperator which the controller would fail to reconcile, so that the error is returned by kubectl apply rather
+than logged by the controller. The mutating webhook sets the profile and root namespace, the only defaults which the
+controller reads from the resource itself; the other profile values are merged by the controller when it reconciles.
+The certificates of the webhooks are generated and rotated by the operator itself.
+*/
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/ghodss/yaml"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"istio.io/api/operator/v1alpha1"
+	valuesv1alpha1 "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/apis/istio/v1alpha1/validation"
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/helmreconciler"
+	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/validate"
+	"istio.io/pkg/log"
+)
+
+const (
+	// Port is the port the webhook server listens on, which the istio-operator Service targets.
+	Port = 9443
+
+	// validatePath and mutatePath are the paths of the webhooks in the webhook configurations in data/operator.
+	validatePath = "/validate-istiooperator"
+	mutatePath   = "/mutate-istiooperator"
+)
+
+// AddToManager serves the IstioOperator admission webhooks from the webhook server of mgr. The certificates of the
+// webhooks are stored in a Secret in namespace, the namespace of the operator.
+func AddToManager(mgr manager.Manager, namespace string) error {
+	client, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("could not create the webhook certificate client: %s", err)
+	}
+	server := mgr.GetWebhookServer()
+	cm := &certManager{client: client, namespace: namespace, certDir: server.CertDir}
+	// The certificates must be in place before the manager starts the webhook server, which fails without them.
+	if err := cm.ensure(); err != nil {
+		return err
+	}
+	if err := mgr.Add(cm); err != nil {
+		return err
+	}
+
+	server.Register(validatePath, &admission.Webhook{Handler: admission.HandlerFunc(handleValidate)})
+	server.Register(mutatePath, &admission.Webhook{Handler: admission.HandlerFunc(handleMutate)})
+	log.Infof("Serving IstioOperator admission webhooks on port %d", server.Port)
+	return nil
+}
+
+// handleValidate denies an IstioOperator which fails validation.
+func handleValidate(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update {
+		return admission.Allowed("")
+	}
+	if skipValidation(req) {
+		return admission.Allowed("")
+	}
+	if errs := validateIstioOperator(req.Object.Raw); len(errs) != 0 {
+		return admission.Denied(fmt.Sprintf("IstioOperator %s failed validation: %s", req.Name, errs))
+	}
+	return admission.Allowed("")
+}
+
+// skipValidation reports whether req does not change anything the controller reconciles: an update of an
+// IstioOperator being deleted, like the removal of its finalizer, or an update which leaves the spec unchanged, like a
+// metadata update. Denying these would leave an IstioOperator which became invalid, e.g. after an operator upgrade,
+// impossible to delete or annotate.
+func skipValidation(req admission.Request) bool {
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(req.Object.Raw, &u.Object); err != nil {
+		return false
+	}
+	if u.GetDeletionTimestamp() != nil {
+		return true
+	}
+	if req.Operation != admissionv1beta1.Update || len(req.OldObject.Raw) == 0 {
+		return false
+	}
+	old := &unstructured.Unstructured{}
+	if err := json.Unmarshal(req.OldObject.Raw, &old.Object); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(u.Object["spec"], old.Object["spec"])
+}
+
+// validateIstioOperator validates the IstioOperator in raw, both as written and merged with its profile, which is
+// what the controller reconciles.
+func validateIstioOperator(raw []byte) util.Errors {
+	iops, _, err := manifest.ParseK8SYAMLToIstioOperatorSpec(string(raw))
+	if err != nil {
+		return util.NewErrs(fmt.Errorf("could not parse the IstioOperator: %s", err))
+	}
+	// CheckIstioOperatorSpec also checks the values with validate.CheckValues.
+	errs := validate.CheckIstioOperatorSpec(iops, false)
+	values, err := unmarshalValues(iops)
+	if err != nil {
+		// The unmarshal error is already returned by CheckValues.
+		return errs
+	}
+	errs = util.AppendErrs(errs, validation.ValidateConfig(false, values, iops))
+	if len(errs) != 0 {
+		return errs
+	}
+	if _, err := helmreconciler.MergeIOPSWithProfile(iops); err != nil {
+		return util.NewErrs(err)
+	}
+	return nil
+}
+
+// unmarshalValues returns the values of iops as a Values struct.
+func unmarshalValues(iops *v1alpha1.IstioOperatorSpec) (*valuesv1alpha1.Values, error) {
+	values := &valuesv1alpha1.Values{}
+	if iops.Values == nil {
+		return values, nil
+	}
+	y, err := yaml.Marshal(iops.Values)
+	if err != nil {
+		return nil, err
+	}
+	if err := util.UnmarshalValuesWithJSONPB(string(y), values, false); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// handleMutate sets the profile and root namespace of an IstioOperator if they are not set.
+func handleMutate(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update {
+		return admission.Allowed("")
+	}
+	mutated, err := setDefaults(req.Object.Raw)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, mutated)
+}
+
+// setDefaults returns the IstioOperator in raw with the profile set, and with the root namespace of the profile if it
+// is not set. The controller reads the root namespace directly from the resource. The hub and tag are left unset, so
+// that an upgrade of the operator also upgrades the control plane. An IstioOperator which cannot be merged with its
+// profile is returned as is, and rejected by the validating webhook.
+func setDefaults(raw []byte) ([]byte, error) {
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(raw, &u.Object); err != nil {
+		return nil, fmt.Errorf("could not decode the IstioOperator: %s", err)
+	}
+	profile, _, _ := unstructured.NestedString(u.Object, "spec", "profile")
+	if profile == "" {
+		if err := unstructured.SetNestedField(u.Object, helm.DefaultProfileString, "spec", "profile"); err != nil {
+			return nil, err
+		}
+	}
+
+	rootNamespace, _, _ := unstructured.NestedString(u.Object, "spec", "meshConfig", "rootNamespace")
+	if rootNamespace == "" {
+		iops, _, err := manifest.ParseK8SYAMLToIstioOperatorSpec(string(raw))
+		if err != nil {
+			return raw, nil
+		}
+		merged, err := helmreconciler.MergeIOPSWithProfile(iops)
+		if err != nil || merged.MeshConfig == nil {
+			return raw, nil
+		}
+		if err := unstructured.SetNestedField(u.Object, merged.MeshConfig.RootNamespace, "spec", "meshConfig", "rootNamespace"); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(u.Object)
+}
@@ -0,0 +1,332 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestValidateIstioOperator(t *testing.T) {
+	tests := []struct {
+		desc    string
+		spec    string
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			spec: `
+profile: demo
+values:
+  global:
+    proxy:
+      includeIPRanges: 10.0.0.0/8
+`,
+		},
+		{
+			desc: "invalid value",
+			spec: `
+values:
+  global:
+    proxy:
+      includeIPRanges: 10.0.0.0/99
+`,
+			wantErr: "global.proxy.includeIPRanges",
+		},
+		{
+			desc: "unknown value",
+			spec: `
+values:
+  global:
+    notAValue: true
+`,
+			wantErr: "notAValue",
+		},
+		{
+			desc: "invalid feature combination",
+			spec: `
+values:
+  global:
+    controlPlaneSecurityEnabled: false
+    mtls:
+      auto: true
+`,
+			wantErr: "auto mtls is enabled, but control plane security is not enabled",
+		},
+		{
+			desc:    "unknown profile",
+			spec:    "profile: does-not-exist",
+			wantErr: "does-not-exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			errs := validateIstioOperator(mustIstioOperatorJSON(t, tt.spec))
+			switch {
+			case tt.wantErr == "" && len(errs) != 0:
+				t.Errorf("got errors %s, want none", errs)
+			case tt.wantErr != "" && !strings.Contains(errs.Error(), tt.wantErr):
+				t.Errorf("got errors %q, want an error containing %q", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandleValidate(t *testing.T) {
+	valid := mustIstioOperatorJSON(t, "profile: demo")
+	invalid := mustIstioOperatorJSON(t, "profile: does-not-exist")
+	invalidAnnotated := withObjectMeta(t, invalid, func(u *unstructured.Unstructured) {
+		u.SetAnnotations(map[string]string{"example.com/note": "annotated"})
+	})
+	invalidDeleting := withObjectMeta(t, invalid, func(u *unstructured.Unstructured) {
+		now := metav1.Now()
+		u.SetDeletionTimestamp(&now)
+	})
+
+	tests := []struct {
+		desc        string
+		operation   admissionv1beta1.Operation
+		object      []byte
+		oldObject   []byte
+		wantAllowed bool
+	}{
+		{desc: "valid create", operation: admissionv1beta1.Create, object: valid, wantAllowed: true},
+		{desc: "invalid create", operation: admissionv1beta1.Create, object: invalid},
+		{desc: "invalid spec update", operation: admissionv1beta1.Update, object: invalid, oldObject: valid},
+		{desc: "unchanged spec update", operation: admissionv1beta1.Update, object: invalidAnnotated, oldObject: invalid, wantAllowed: true},
+		{desc: "update while deleting", operation: admissionv1beta1.Update, object: invalidDeleting, oldObject: invalid, wantAllowed: true},
+		{desc: "delete", operation: admissionv1beta1.Delete, oldObject: invalid, wantAllowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			req := admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+				Name:      "test",
+				Operation: tt.operation,
+				Object:    runtime.RawExtension{Raw: tt.object},
+				OldObject: runtime.RawExtension{Raw: tt.oldObject},
+			}}
+			if got := handleValidate(context.Background(), req); got.Allowed != tt.wantAllowed {
+				t.Errorf("got allowed %v (%v), want %v", got.Allowed, got.Result, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	tests := []struct {
+		desc string
+		spec string
+		want string
+	}{
+		{
+			desc: "empty",
+			spec: "{}",
+			want: `
+profile: default
+meshConfig:
+  rootNamespace: istio-system
+`,
+		},
+		{
+			desc: "set by user",
+			spec: `
+profile: minimal
+meshConfig:
+  rootNamespace: istio-control
+`,
+			want: `
+profile: minimal
+meshConfig:
+  rootNamespace: istio-control
+`,
+		},
+		{
+			desc: "unknown profile",
+			spec: "profile: does-not-exist",
+			want: "profile: does-not-exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := setDefaults(mustIstioOperatorJSON(t, tt.spec))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := mustIstioOperatorJSON(t, tt.want); !jsonEqual(t, got, want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestCertManager(t *testing.T) {
+	certDir, err := ioutil.TempDir("", "webhook-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(certDir)
+
+	client := fake.NewSimpleClientset(
+		&v1beta1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: configName},
+			Webhooks:   []v1beta1.MutatingWebhook{{Name: "mutate.istiooperator.install.istio.io"}},
+		},
+		&v1beta1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: configName},
+			Webhooks:   []v1beta1.ValidatingWebhook{{Name: "validate.istiooperator.install.istio.io"}},
+		},
+	)
+	m := &certManager{client: client, namespace: "istio-operator", certDir: certDir}
+
+	if err := m.ensure(); err != nil {
+		t.Fatal(err)
+	}
+	first := assertCerts(t, m)
+
+	// Valid certificates are reused.
+	if err := m.ensure(); err != nil {
+		t.Fatal(err)
+	}
+	if second := assertCerts(t, m); !bytes.Equal(second.cert, first.cert) {
+		t.Error("got new certificates, want the stored certificates to be reused")
+	}
+
+	// Certificates for another service are replaced.
+	m.namespace = "other-namespace"
+	other, err := generateCerts("istio-operator.istio-operator.svc", "istio-operator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: m.namespace},
+		Data:       map[string][]byte{corev1.TLSCertKey: other.cert, corev1.TLSPrivateKeyKey: other.key, caCertKey: other.ca},
+	}
+	if _, err := client.CoreV1().Secrets(m.namespace).Create(secret); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ensure(); err != nil {
+		t.Fatal(err)
+	}
+	if third := assertCerts(t, m); bytes.Equal(third.cert, other.cert) {
+		t.Error("got the stored certificates, want new certificates for the service")
+	}
+}
+
+// assertCerts checks that valid certificates are stored in the Secret and the files of m, and that the webhook
+// configurations trust their CA. It returns the certificates.
+func assertCerts(t *testing.T, m *certManager) *certs {
+	t.Helper()
+	secret, err := m.client.CoreV1().Secrets(m.namespace).Get(secretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := certsFromSecret(secret)
+	if c == nil || !c.validFor(m.dnsName(), time.Now().Add(certRenewBefore)) {
+		t.Fatalf("got invalid certificates in Secret %s", secretName)
+	}
+	for name, want := range map[string][]byte{corev1.TLSCertKey: c.cert, corev1.TLSPrivateKeyKey: c.key} {
+		got, err := ioutil.ReadFile(filepath.Join(m.certDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("file %s does not match the Secret", name)
+		}
+	}
+	if files, err := ioutil.ReadDir(m.certDir); err != nil || len(files) != 2 {
+		t.Errorf("got %d files in the certificate directory (%v), want only the certificate and key", len(files), err)
+	}
+	admissionClient := m.client.AdmissionregistrationV1beta1()
+	mwc, err := admissionClient.MutatingWebhookConfigurations().Get(configName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(mwc.Webhooks[0].ClientConfig.CABundle, c.ca) {
+		t.Error("got wrong CA bundle in MutatingWebhookConfiguration")
+	}
+	vwc, err := admissionClient.ValidatingWebhookConfigurations().Get(configName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(vwc.Webhooks[0].ClientConfig.CABundle, c.ca) {
+		t.Error("got wrong CA bundle in ValidatingWebhookConfiguration")
+	}
+	return c
+}
+
+// mustIstioOperatorJSON returns the JSON of an IstioOperator with the given spec YAML.
+func mustIstioOperatorJSON(t *testing.T, spec string) []byte {
+	t.Helper()
+	s := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(spec), &s); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "install.istio.io/v1alpha1",
+		"kind":       "IstioOperator",
+		"metadata":   map[string]interface{}{"name": "test", "namespace": "istio-system"},
+		"spec":       s,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// withObjectMeta returns the JSON of the object in raw after applying set to it.
+func withObjectMeta(t *testing.T, raw []byte, set func(u *unstructured.Unstructured)) []byte {
+	t.Helper()
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(raw, &u.Object); err != nil {
+		t.Fatal(err)
+	}
+	set(u)
+	b, err := json.Marshal(u.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+	var ao, bo interface{}
+	if err := json.Unmarshal(a, &ao); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, &bo); err != nil {
+		t.Fatal(err)
+	}
+	ab, _ := json.Marshal(ao)
+	bb, _ := json.Marshal(bo)
+	return bytes.Equal(ab, bb)
+}